@@ -2,6 +2,7 @@ package bridges
 
 import (
 	"crypto/subtle"
+	"crypto/tls"
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
@@ -10,6 +11,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -17,10 +21,20 @@ import (
 
 // BridgeTypeRequest is the incoming record used to create a BridgeType
 type BridgeTypeRequest struct {
-	Name                   BridgeName    `json:"name"`
-	URL                    models.WebURL `json:"url"`
-	Confirmations          uint32        `json:"confirmations"`
-	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	Name                    BridgeName      `json:"name"`
+	URL                     models.WebURL   `json:"url"`
+	Confirmations           uint32          `json:"confirmations"`
+	MinimumContractPayment  *assets.Link    `json:"minimumContractPayment"`
+	RateLimit               float64         `json:"rateLimit"`
+	RateLimitBurst          uint32          `json:"rateLimitBurst"`
+	CircuitBreakerThreshold uint32          `json:"circuitBreakerThreshold"`
+	CircuitBreakerCooldown  models.Interval `json:"circuitBreakerCooldown"`
+	CacheTTL                models.Interval `json:"cacheTTL"`
+	// Credentials are left unchanged if they are nil, and removed if they
+	// are empty. ClientCertificate and ClientKey are PEM encoded.
+	SigningSecret     *string `json:"signingSecret,omitempty"`
+	ClientCertificate *string `json:"clientCertificate,omitempty"`
+	ClientKey         *string `json:"clientKey,omitempty"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -52,16 +66,34 @@ type BridgeTypeAuthentication struct {
 
 // BridgeType is used for external adapters and has fields for
 // the name of the adapter and its URL.
+//
+// Requests to the adapter can be limited to RateLimit per second, with bursts
+// of up to RateLimitBurst requests. After CircuitBreakerThreshold consecutive
+// failures, requests fail without being sent until CircuitBreakerCooldown has
+// passed. Successful responses can be cached for CacheTTL, keyed on the
+// request body. Zero values disable each of these.
+//
+// Requests are signed with the signing secret, and the client certificate is
+// presented to the adapter, if they are set. Secrets are stored encrypted with
+// the keystore password, see SetCredentials.
 type BridgeType struct {
-	Name                   BridgeName
-	URL                    models.WebURL
-	Confirmations          uint32
-	IncomingTokenHash      string
-	Salt                   string
-	OutgoingToken          string
-	MinimumContractPayment *assets.Link
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
+	Name                    BridgeName
+	URL                     models.WebURL
+	Confirmations           uint32
+	IncomingTokenHash       string
+	Salt                    string
+	OutgoingToken           string
+	MinimumContractPayment  *assets.Link
+	RateLimit               float64
+	RateLimitBurst          uint32
+	CircuitBreakerThreshold uint32
+	CircuitBreakerCooldown  models.Interval
+	CacheTTL                models.Interval
+	EncryptedSigningSecret  []byte
+	ClientCertificate       null.String
+	EncryptedClientKey      []byte
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
 }
 
 // NewBridgeType returns a bridge type authentication (with plaintext
@@ -85,16 +117,67 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
 		}, &BridgeType{
-			Name:                   btr.Name,
-			URL:                    btr.URL,
-			Confirmations:          btr.Confirmations,
-			IncomingTokenHash:      hash,
-			Salt:                   salt,
-			OutgoingToken:          outgoingToken,
-			MinimumContractPayment: btr.MinimumContractPayment,
+			Name:                    btr.Name,
+			URL:                     btr.URL,
+			Confirmations:           btr.Confirmations,
+			IncomingTokenHash:       hash,
+			Salt:                    salt,
+			OutgoingToken:           outgoingToken,
+			MinimumContractPayment:  btr.MinimumContractPayment,
+			RateLimit:               btr.RateLimit,
+			RateLimitBurst:          btr.RateLimitBurst,
+			CircuitBreakerThreshold: btr.CircuitBreakerThreshold,
+			CircuitBreakerCooldown:  btr.CircuitBreakerCooldown,
+			CacheTTL:                btr.CacheTTL,
 		}, nil
 }
 
+// Encrypter encrypts bridge secrets, e.g. keystore.Master.
+type Encrypter interface {
+	Encrypt(data []byte) ([]byte, error)
+}
+
+// SetCredentials updates the credentials of bt from btr, encrypting secrets
+// with enc.
+func (bt *BridgeType) SetCredentials(btr *BridgeTypeRequest, enc Encrypter) error {
+	if btr.SigningSecret != nil {
+		if *btr.SigningSecret == "" {
+			bt.EncryptedSigningSecret = nil
+		} else {
+			encrypted, err := enc.Encrypt([]byte(*btr.SigningSecret))
+			if err != nil {
+				return errors.Wrap(err, "failed to encrypt signing secret")
+			}
+			bt.EncryptedSigningSecret = encrypted
+		}
+	}
+	if btr.ClientCertificate != nil || btr.ClientKey != nil {
+		if btr.ClientCertificate == nil || btr.ClientKey == nil {
+			return errors.New("client certificate and key must be set together")
+		}
+		if *btr.ClientCertificate == "" && *btr.ClientKey == "" {
+			bt.ClientCertificate = null.String{}
+			bt.EncryptedClientKey = nil
+		} else {
+			if _, err := tls.X509KeyPair([]byte(*btr.ClientCertificate), []byte(*btr.ClientKey)); err != nil {
+				return errors.Wrap(err, "invalid client certificate")
+			}
+			encrypted, err := enc.Encrypt([]byte(*btr.ClientKey))
+			if err != nil {
+				return errors.Wrap(err, "failed to encrypt client key")
+			}
+			bt.ClientCertificate = null.StringFrom(*btr.ClientCertificate)
+			bt.EncryptedClientKey = encrypted
+		}
+	}
+	return nil
+}
+
+// SignsRequests returns true if requests to the bridge are signed.
+func (bt BridgeType) SignsRequests() bool {
+	return len(bt.EncryptedSigningSecret) > 0
+}
+
 // AuthenticateBridgeType returns true if the passed token matches its
 // IncomingToken, or returns false with an error.
 func AuthenticateBridgeType(bt *BridgeType, token string) (bool, error) {
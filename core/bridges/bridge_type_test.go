@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 
 	"github.com/stretchr/testify/assert"
@@ -96,6 +97,55 @@ func TestBridgeName_UnmarshalJSON(t *testing.T) {
 	require.Error(t, json.Unmarshal([]byte(`"invalid,.<>/asdf?"`), &b))
 }
 
+// prefixEncrypter "encrypts" by prefixing the data.
+type prefixEncrypter struct{}
+
+func (prefixEncrypter) Encrypt(data []byte) ([]byte, error) {
+	return append([]byte("enc:"), data...), nil
+}
+
+func TestBridgeType_SetCredentials(t *testing.T) {
+	t.Parallel()
+
+	certPEM, keyPEM := testutils.MustNewCertificate(t)
+	_, otherKeyPEM := testutils.MustNewCertificate(t)
+	str := func(s string) *string { return &s }
+
+	var bt bridges.BridgeType
+	require.NoError(t, bt.SetCredentials(&bridges.BridgeTypeRequest{}, prefixEncrypter{}))
+	assert.False(t, bt.SignsRequests())
+	assert.False(t, bt.ClientCertificate.Valid)
+
+	require.NoError(t, bt.SetCredentials(&bridges.BridgeTypeRequest{
+		SigningSecret:     str("0123456789abcdef"),
+		ClientCertificate: str(certPEM),
+		ClientKey:         str(keyPEM),
+	}, prefixEncrypter{}))
+	assert.True(t, bt.SignsRequests())
+	assert.Equal(t, "enc:0123456789abcdef", string(bt.EncryptedSigningSecret))
+	assert.Equal(t, certPEM, bt.ClientCertificate.String)
+	assert.Equal(t, "enc:"+keyPEM, string(bt.EncryptedClientKey))
+
+	// Unset credentials are left unchanged.
+	require.NoError(t, bt.SetCredentials(&bridges.BridgeTypeRequest{}, prefixEncrypter{}))
+	assert.True(t, bt.SignsRequests())
+	assert.True(t, bt.ClientCertificate.Valid)
+
+	require.Error(t, bt.SetCredentials(&bridges.BridgeTypeRequest{ClientCertificate: str(certPEM)}, prefixEncrypter{}))
+	require.Error(t, bt.SetCredentials(&bridges.BridgeTypeRequest{ClientCertificate: str(certPEM), ClientKey: str(otherKeyPEM)}, prefixEncrypter{}))
+	assert.Equal(t, "enc:"+keyPEM, string(bt.EncryptedClientKey))
+
+	// Empty credentials are removed.
+	require.NoError(t, bt.SetCredentials(&bridges.BridgeTypeRequest{
+		SigningSecret:     str(""),
+		ClientCertificate: str(""),
+		ClientKey:         str(""),
+	}, prefixEncrypter{}))
+	assert.False(t, bt.SignsRequests())
+	assert.False(t, bt.ClientCertificate.Valid)
+	assert.Nil(t, bt.EncryptedClientKey)
+}
+
 func TestMarshalBridgeMetaData(t *testing.T) {
 	t.Parallel()
 
@@ -7,6 +7,8 @@ import (
 	bridges "github.com/smartcontractkit/chainlink/core/bridges"
 
 	mock "github.com/stretchr/testify/mock"
+
+	pg "github.com/smartcontractkit/chainlink/core/services/pg"
 )
 
 // ORM is an autogenerated mock type for the ORM type
@@ -44,13 +46,20 @@ func (_m *ORM) BridgeTypes(offset int, limit int) ([]bridges.BridgeType, int, er
 	return r0, r1, r2
 }
 
-// CreateBridgeType provides a mock function with given fields: bt
-func (_m *ORM) CreateBridgeType(bt *bridges.BridgeType) error {
-	ret := _m.Called(bt)
+// CreateBridgeType provides a mock function with given fields: bt, qopts
+func (_m *ORM) CreateBridgeType(bt *bridges.BridgeType, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, bt)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*bridges.BridgeType) error); ok {
-		r0 = rf(bt)
+	if rf, ok := ret.Get(0).(func(*bridges.BridgeType, ...pg.QOpt) error); ok {
+		r0 = rf(bt, qopts...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -151,13 +160,20 @@ func (_m *ORM) FindBridge(name bridges.BridgeName) (bridges.BridgeType, error) {
 	return r0, r1
 }
 
-// FindBridges provides a mock function with given fields: name
-func (_m *ORM) FindBridges(name []bridges.BridgeName) ([]bridges.BridgeType, error) {
-	ret := _m.Called(name)
+// FindBridges provides a mock function with given fields: name, qopts
+func (_m *ORM) FindBridges(name []bridges.BridgeName, qopts ...pg.QOpt) ([]bridges.BridgeType, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	var r0 []bridges.BridgeType
-	if rf, ok := ret.Get(0).(func([]bridges.BridgeName) []bridges.BridgeType); ok {
-		r0 = rf(name)
+	if rf, ok := ret.Get(0).(func([]bridges.BridgeName, ...pg.QOpt) []bridges.BridgeType); ok {
+		r0 = rf(name, qopts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]bridges.BridgeType)
@@ -165,8 +181,8 @@ func (_m *ORM) FindBridges(name []bridges.BridgeName) ([]bridges.BridgeType, err
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func([]bridges.BridgeName) error); ok {
-		r1 = rf(name)
+	if rf, ok := ret.Get(1).(func([]bridges.BridgeName, ...pg.QOpt) error); ok {
+		r1 = rf(name, qopts...)
 	} else {
 		r1 = ret.Error(1)
 	}
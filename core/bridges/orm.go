@@ -15,10 +15,10 @@ import (
 
 type ORM interface {
 	FindBridge(name BridgeName) (bt BridgeType, err error)
-	FindBridges(name []BridgeName) (bts []BridgeType, err error)
+	FindBridges(name []BridgeName, qopts ...pg.QOpt) (bts []BridgeType, err error)
 	DeleteBridgeType(bt *BridgeType) error
 	BridgeTypes(offset int, limit int) ([]BridgeType, int, error)
-	CreateBridgeType(bt *BridgeType) error
+	CreateBridgeType(bt *BridgeType, qopts ...pg.QOpt) error
 	UpdateBridgeType(bt *BridgeType, btr *BridgeTypeRequest) error
 
 	ExternalInitiators(offset int, limit int) ([]ExternalInitiator, int, error)
@@ -50,13 +50,14 @@ func (o *orm) FindBridge(name BridgeName) (bt BridgeType, err error) {
 // FindBridges looks up multiple bridges in a single query.
 // Errors unless all bridges successfully found. Requires at least one bridge.
 // Expects all bridges to be unique
-func (o *orm) FindBridges(names []BridgeName) (bts []BridgeType, err error) {
+func (o *orm) FindBridges(names []BridgeName, qopts ...pg.QOpt) (bts []BridgeType, err error) {
+	q := o.q.WithOpts(qopts...)
 	sql := "SELECT * FROM bridge_types WHERE name IN (?)"
 	query, args, err := sqlx.In(sql, names)
 	if err != nil {
 		return nil, err
 	}
-	err = o.q.Select(&bts, o.q.Rebind(query), args...)
+	err = q.Select(&bts, q.Rebind(query), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -101,11 +102,12 @@ func (o *orm) BridgeTypes(offset int, limit int) (bridges []BridgeType, count in
 }
 
 // CreateBridgeType saves the bridge type.
-func (o *orm) CreateBridgeType(bt *BridgeType) error {
-	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+func (o *orm) CreateBridgeType(bt *BridgeType, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, rate_limit, rate_limit_burst, circuit_breaker_threshold, circuit_breaker_cooldown, cache_ttl, encrypted_signing_secret, client_certificate, encrypted_client_key, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, :rate_limit, :rate_limit_burst, :circuit_breaker_threshold, :circuit_breaker_cooldown, :cache_ttl, :encrypted_signing_secret, :client_certificate, :encrypted_client_key, now(), now())
 	RETURNING *;`
-	err := o.q.Transaction(func(tx pg.Queryer) error {
+	err := q.Transaction(func(tx pg.Queryer) error {
 		stmt, err := tx.PrepareNamed(stmt)
 		if err != nil {
 			return err
@@ -115,11 +117,17 @@ func (o *orm) CreateBridgeType(bt *BridgeType) error {
 	return errors.Wrap(err, "CreateBridgeType failed")
 }
 
-// UpdateBridgeType updates the bridge type.
+// UpdateBridgeType updates the bridge type. Credentials are not taken from
+// btr, but from bt, see BridgeType.SetCredentials.
 func (o *orm) UpdateBridgeType(bt *BridgeType,
 	btr *BridgeTypeRequest) error {
-	sql := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	return o.q.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	sql := `UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3, rate_limit = $4, rate_limit_burst = $5,
+	circuit_breaker_threshold = $6, circuit_breaker_cooldown = $7, cache_ttl = $8,
+	encrypted_signing_secret = $9, client_certificate = $10, encrypted_client_key = $11
+	WHERE name = $12 RETURNING *`
+	return o.q.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment, btr.RateLimit, btr.RateLimitBurst,
+		btr.CircuitBreakerThreshold, btr.CircuitBreakerCooldown, btr.CacheTTL,
+		bt.EncryptedSigningSecret, bt.ClientCertificate, bt.EncryptedClientKey, bt.Name)
 }
 
 // --- External Initiator
@@ -2,6 +2,7 @@ package bridges_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
@@ -12,6 +13,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 func setupORM(t *testing.T) (*sqlx.DB, bridges.ORM) {
@@ -106,14 +108,27 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 	require.NoError(t, orm.CreateBridgeType(firstBridge))
 
 	updateBridge := &bridges.BridgeTypeRequest{
-		URL: cltest.WebURL(t, "http:/updatedurl.com"),
+		URL:                     cltest.WebURL(t, "http:/updatedurl.com"),
+		RateLimit:               2.5,
+		RateLimitBurst:          5,
+		CircuitBreakerThreshold: 3,
+		CircuitBreakerCooldown:  models.Interval(time.Minute),
+		CacheTTL:                models.Interval(10 * time.Second),
 	}
+	firstBridge.EncryptedSigningSecret = []byte("encrypted")
 
 	require.NoError(t, orm.UpdateBridgeType(firstBridge, updateBridge))
 
 	foundbridge, err := orm.FindBridge("UniqueName")
 	require.NoError(t, err)
 	require.Equal(t, updateBridge.URL, foundbridge.URL)
+	require.Equal(t, 2.5, foundbridge.RateLimit)
+	require.Equal(t, uint32(5), foundbridge.RateLimitBurst)
+	require.Equal(t, uint32(3), foundbridge.CircuitBreakerThreshold)
+	require.Equal(t, models.Interval(time.Minute), foundbridge.CircuitBreakerCooldown)
+	require.Equal(t, models.Interval(10*time.Second), foundbridge.CacheTTL)
+	require.Equal(t, []byte("encrypted"), foundbridge.EncryptedSigningSecret)
+	require.False(t, foundbridge.ClientCertificate.Valid)
 
 	bs, count, err := orm.BridgeTypes(0, 10)
 	require.NoError(t, err)
@@ -7,6 +7,7 @@ import (
 	"net/url"
 
 	"github.com/pkg/errors"
+	"go.uber.org/atomic"
 	"go.uber.org/multierr"
 
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
@@ -29,6 +30,10 @@ import (
 //go:generate mockery --name Chain --output ./mocks/ --case=underscore
 type Chain interface {
 	services.ServiceCtx
+	// StartStandby starts the chain as a warm standby, which dials the
+	// chain's nodes, tracks heads and runs the log poller without writing to
+	// the database. Start then promotes the chain to active.
+	StartStandby(ctx context.Context) error
 	ID() *big.Int
 	Client() evmclient.Client
 	Config() evmconfig.ChainScopedConfig
@@ -40,6 +45,7 @@ type Chain interface {
 	Logger() logger.Logger
 	BalanceMonitor() monitor.BalanceMonitor
 	LogPoller() logpoller.LogPoller
+	KeyPool() txmgr.EthKeyPool
 }
 
 var _ Chain = &chain{}
@@ -55,10 +61,17 @@ type chain struct {
 	logger          logger.Logger
 	headBroadcaster httypes.HeadBroadcaster
 	headTracker     httypes.HeadTracker
+	headSaver       httypes.HeadSaver
 	logBroadcaster  log.Broadcaster
 	logPoller       logpoller.LogPoller
 	balanceMonitor  monitor.BalanceMonitor
+	keyPool         txmgr.EthKeyPool
 	keyStore        keystore.Eth
+
+	// standby is set while the chain runs as a warm standby. Start,
+	// StartStandby and Close are never called concurrently, but Ready may
+	// be.
+	standby atomic.Bool
 }
 
 type errChainDisabled struct {
@@ -132,7 +145,7 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 		headTracker = opts.GenHeadTracker(chainID, headBroadcaster)
 	}
 
-	var logPoller logpoller.LogPoller = logpoller.NewLogPoller(logpoller.NewORM(chainID, db, l, cfg), client, l, cfg.EvmLogPollInterval(), int64(cfg.EvmFinalityDepth()), int64(cfg.EvmLogBackfillBatchSize()), int64(cfg.EvmRPCDefaultBatchSize()))
+	var logPoller logpoller.LogPoller = logpoller.NewLogPoller(logpoller.NewORM(chainID, db, l, cfg), client, l, cfg.EvmLogPollInterval(), int64(cfg.EvmFinalityDepth()), int64(cfg.EvmLogBackfillBatchSize()), int64(cfg.EvmRPCDefaultBatchSize()), int64(cfg.EvmLogKeepBlocksDepth()))
 	if opts.GenLogPoller != nil {
 		logPoller = opts.GenLogPoller(chainID)
 	}
@@ -161,6 +174,8 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 		headBroadcaster.Subscribe(balanceMonitor)
 	}
 
+	keyPool := txmgr.NewEthKeyPool(db, opts.KeyStore, balanceMonitor, *chainID, cfg, l)
+
 	var logBroadcaster log.Broadcaster
 	if !cfg.EVMRPCEnabled() {
 		logBroadcaster = &log.NullBroadcaster{ErrMsg: fmt.Sprintf("Ethereum is disabled for chain %d", chainID)}
@@ -186,14 +201,42 @@ func newChain(ctx context.Context, cfg evmconfig.ChainScopedConfig, nodes []*v2.
 		logger:          l,
 		headBroadcaster: headBroadcaster,
 		headTracker:     headTracker,
+		headSaver:       headSaver,
 		logBroadcaster:  logBroadcaster,
 		logPoller:       logPoller,
 		balanceMonitor:  balanceMonitor,
+		keyPool:         keyPool,
 		keyStore:        opts.KeyStore,
 	}, nil
 }
 
+func (c *chain) StartStandby(ctx context.Context) error {
+	return c.StartOnce("Chain", func() error {
+		c.logger.Debugf("Chain: starting as warm standby with ID %s", c.ID().String())
+		if err := c.client.Dial(ctx); err != nil {
+			return errors.Wrap(err, "failed to dial ethclient")
+		}
+		// The active node saves the heads, we only need them in memory.
+		c.headSaver.SetReadOnly(true)
+		if err := c.headTracker.Start(ctx); err != nil {
+			return err
+		}
+		// The log poller is left read-only until it is started along with
+		// the jobs, which register its filters.
+		if c.cfg.FeatureLogPoller() {
+			if err := c.logPoller.StartReadOnly(ctx); err != nil {
+				return err
+			}
+		}
+		c.standby.Store(true)
+		return nil
+	})
+}
+
 func (c *chain) Start(ctx context.Context) error {
+	if c.standby.Load() {
+		return c.promote(ctx)
+	}
 	return c.StartOnce("Chain", func() error {
 		c.logger.Debugf("Chain: starting with ID %s", c.ID().String())
 		// Must ensure that EthClient is dialed first because subsequent
@@ -217,8 +260,36 @@ func (c *chain) Start(ctx context.Context) error {
 	})
 }
 
+// promote starts the services of a warm standby chain which were not running
+// yet. Heads received since the standby started are handed to the head
+// broadcaster's subscribers once it starts.
+func (c *chain) promote(ctx context.Context) error {
+	c.logger.Debug("Chain: promoting warm standby")
+	c.headSaver.SetReadOnly(false)
+	var ms services.MultiStart
+	if err := ms.Start(ctx, c.txm, c.headBroadcaster, c.logBroadcaster); err != nil {
+		return err
+	}
+	if c.balanceMonitor != nil {
+		if err := ms.Start(ctx, c.balanceMonitor); err != nil {
+			return err
+		}
+	}
+	c.standby.Store(false)
+	return nil
+}
+
 func (c *chain) Close() error {
 	return c.StopOnce("Chain", func() (merr error) {
+		if c.standby.Load() {
+			c.logger.Debug("Chain: stopping warm standby")
+			if c.cfg.FeatureLogPoller() {
+				merr = c.logPoller.Close()
+			}
+			merr = multierr.Combine(merr, c.headTracker.Close())
+			c.client.Close()
+			return merr
+		}
 		c.logger.Debug("Chain: stopping")
 
 		if c.balanceMonitor != nil {
@@ -241,6 +312,13 @@ func (c *chain) Close() error {
 }
 
 func (c *chain) Ready() (merr error) {
+	if c.standby.Load() {
+		merr = multierr.Combine(c.StartStopOnce.Ready(), c.headTracker.Ready())
+		if c.cfg.FeatureLogPoller() {
+			merr = multierr.Combine(merr, c.logPoller.Ready())
+		}
+		return
+	}
 	merr = multierr.Combine(
 		c.StartStopOnce.Ready(),
 		c.txm.Ready(),
@@ -285,6 +363,7 @@ func (c *chain) TxManager() txmgr.TxManager               { return c.txm }
 func (c *chain) HeadTracker() httypes.HeadTracker         { return c.headTracker }
 func (c *chain) Logger() logger.Logger                    { return c.logger }
 func (c *chain) BalanceMonitor() monitor.BalanceMonitor   { return c.balanceMonitor }
+func (c *chain) KeyPool() txmgr.EthKeyPool                { return c.keyPool }
 
 func newEthClientFromChain(cfg evmclient.NodeConfig, lggr logger.Logger, chainID *big.Int, nodes []*v2.Node) (evmclient.Client, error) {
 	var primaries []evmclient.Node
@@ -304,10 +383,18 @@ func newEthClientFromChain(cfg evmclient.NodeConfig, lggr logger.Logger, chainID
 	return evmclient.NewClientWithNodes(lggr, cfg, primaries, sendonlys, chainID)
 }
 
+// defaultNodeOrder is the priority tier of nodes which do not set Order, i.e. the lowest priority
+const defaultNodeOrder int32 = 100
+
 func newPrimary(cfg evmclient.NodeConfig, lggr logger.Logger, n *v2.Node, id int32, chainID *big.Int) (evmclient.Node, error) {
 	if n.SendOnly != nil && *n.SendOnly {
 		return nil, errors.New("cannot cast send-only node to primary")
 	}
 
-	return evmclient.NewNode(cfg, lggr, (url.URL)(*n.WSURL), (*url.URL)(n.HTTPURL), *n.Name, id, chainID), nil
+	order := defaultNodeOrder
+	if n.Order != nil {
+		order = *n.Order
+	}
+
+	return evmclient.NewNode(cfg, lggr, (url.URL)(*n.WSURL), (*url.URL)(n.HTTPURL), *n.Name, id, chainID, order), nil
 }
@@ -39,6 +39,8 @@ type ChainConfigUpdater func(*types.ChainCfg) error
 //go:generate mockery --name ChainSet --output ./mocks/ --case=underscore
 type ChainSet interface {
 	services.ServiceCtx
+	// StartStandby starts all chains as warm standbys, see Chain.StartStandby.
+	StartStandby(ctx context.Context) error
 	Get(id *big.Int) (Chain, error)
 
 	Show(id utils.Big) (types.DBChain, error)
@@ -89,7 +91,7 @@ func (cll *chainSet) Start(ctx context.Context) error {
 			if err := ms.Start(ctx, c); err != nil {
 				return errors.Wrapf(err, "failed to start chain %q", id)
 			}
-			cll.startedChains = append(cll.startedChains, c)
+			cll.markStarted(c)
 		}
 	} else {
 		for id, c := range cll.Chains() {
@@ -97,7 +99,7 @@ func (cll *chainSet) Start(ctx context.Context) error {
 				cll.logger.Criticalw(fmt.Sprintf("EVM: Chain with ID %d failed to start. You will need to fix this issue and restart the Chainlink node before any services that use this chain will work properly. Got error: %v", id, err), "evmChainID", id, "err", err)
 				continue
 			}
-			cll.startedChains = append(cll.startedChains, c)
+			cll.markStarted(c)
 		}
 	}
 	evmChainIDs := make([]*big.Int, len(cll.startedChains))
@@ -111,6 +113,35 @@ func (cll *chainSet) Start(ctx context.Context) error {
 	cll.logger.Infow(fmt.Sprintf("EVM: Started %d/%d chains, default chain ID is %s", len(cll.startedChains), len(cll.Chains()), defChainID), "startedEvmChainIDs", evmChainIDs)
 	return nil
 }
+func (cll *chainSet) StartStandby(ctx context.Context) error {
+	if !cll.opts.Config.EVMEnabled() {
+		return nil
+	}
+	for id, c := range cll.Chains() {
+		if err := c.StartStandby(ctx); err != nil {
+			if cll.immutable {
+				return errors.Wrapf(err, "failed to start chain %q as warm standby", id)
+			}
+			cll.logger.Criticalw(fmt.Sprintf("EVM: Chain with ID %d failed to start as warm standby. You will need to fix this issue and restart the Chainlink node before any services that use this chain will work properly. Got error: %v", id, err), "evmChainID", id, "err", err)
+			continue
+		}
+		cll.markStarted(c)
+	}
+	cll.logger.Infof("EVM: Started %d/%d chains as warm standby", len(cll.startedChains), len(cll.Chains()))
+	return nil
+}
+
+// markStarted records c to be closed, unless it already was started as a warm
+// standby.
+func (cll *chainSet) markStarted(c Chain) {
+	for _, started := range cll.startedChains {
+		if started == c {
+			return
+		}
+	}
+	cll.startedChains = append(cll.startedChains, c)
+}
+
 func (cll *chainSet) Close() (err error) {
 	cll.logger.Debug("EVM: stopping")
 	for _, c := range cll.startedChains {
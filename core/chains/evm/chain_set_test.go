@@ -6,12 +6,15 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	logmocks "github.com/smartcontractkit/chainlink/core/chains/evm/log/mocks"
 	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
@@ -125,3 +128,47 @@ func TestAddClose(t *testing.T) {
 	assert.Error(t, chains[0].Ready())
 	assert.Error(t, chains[1].Ready())
 }
+
+func TestChainSet_StartStandby(t *testing.T) {
+	t.Parallel()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalBalanceMonitorEnabled = null.BoolFrom(false)
+	db := pgtest.NewSqlxDB(t)
+	kst := cltest.NewKeyStore(t, db, cfg)
+	require.NoError(t, kst.Unlock(cltest.Password))
+
+	ethClient := cltest.NewEthMocksWithStartupAssertions(t)
+	txm := txmmocks.NewTxManager(t)
+	txm.On("OnNewLongestChain", mock.Anything, mock.Anything).Maybe()
+	lb := logmocks.NewBroadcaster(t)
+	lb.On("AddDependents", 1).Return()
+	lb.On("OnNewLongestChain", mock.Anything, mock.Anything).Maybe()
+	chainSet := evmtest.NewChainSet(t, evmtest.TestChainOpts{
+		DB:             db,
+		KeyStore:       kst.Eth(),
+		GeneralConfig:  cfg,
+		Client:         ethClient,
+		TxManager:      txm,
+		LogBroadcaster: lb,
+	})
+
+	// Only the client and head tracker run on a warm standby
+	require.NoError(t, chainSet.StartStandby(testutils.Context(t)))
+	require.NoError(t, evmtest.MustGetDefaultChain(t, chainSet).Ready())
+	ethClient.AssertCalled(t, "Dial", mock.Anything)
+
+	// Heads are only kept in memory
+	var count int
+	require.NoError(t, db.Get(&count, `SELECT count(*) FROM evm_heads`))
+	assert.Zero(t, count)
+
+	txm.On("Start", mock.Anything).Return(nil).Once()
+	lb.On("Start", mock.Anything).Return(nil).Once()
+	require.NoError(t, chainSet.Start(testutils.Context(t)))
+
+	txm.On("Close").Return(nil).Once()
+	lb.On("Close").Return(nil).Once()
+	require.NoError(t, chainSet.Close())
+	ethClient.AssertCalled(t, "Close")
+}
@@ -32,6 +32,10 @@ type Client interface {
 	// NodeStates returns a map of node ID->node state
 	// It might be nil or empty, e.g. for mock clients etc
 	NodeStates() map[int32]string
+	// OnNodeStateChange registers hook to be called after every state change
+	// of a node, until unregister is called. Hooks must not block.
+	// It is a noop for clients without nodes, e.g. mock clients etc
+	OnNodeStateChange(hook func(nodeID int32, state string)) (unregister func())
 
 	GetERC20Balance(ctx context.Context, address common.Address, contractAddress common.Address) (*big.Int, error)
 	GetLINKBalance(ctx context.Context, linkAddress common.Address, address common.Address) (*assets.Link, error)
@@ -134,6 +138,12 @@ func (client *client) NodeStates() (states map[int32]string) {
 	return
 }
 
+func (client *client) OnNodeStateChange(hook func(nodeID int32, state string)) (unregister func()) {
+	return client.pool.OnNodeStateChange(func(nodeID int32, state NodeState) {
+		hook(nodeID, state.String())
+	})
+}
+
 // CallArgs represents the data used to call the balance method of a contract.
 // "To" is the address of the ERC contract. "Data" is the message sent
 // to the contract. "From" is the sender address.
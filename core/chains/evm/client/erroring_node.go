@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"math/big"
+	"time"
 
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 
@@ -121,8 +122,10 @@ func (e *erroringNode) StateAndLatestBlockNumber() (NodeState, int64) {
 	return NodeStateUnreachable, -1
 }
 
-func (e *erroringNode) DeclareOutOfSync()            {}
-func (e *erroringNode) DeclareInSync()               {}
-func (e *erroringNode) DeclareUnreachable()          {}
-func (e *erroringNode) ID() int32                    { return 0 }
-func (e *erroringNode) NodeStates() map[int32]string { return nil }
+func (e *erroringNode) DeclareOutOfSync()             {}
+func (e *erroringNode) DeclareInSync()                {}
+func (e *erroringNode) DeclareUnreachable()           {}
+func (e *erroringNode) ID() int32                     { return 0 }
+func (e *erroringNode) Order() int32                  { return 0 }
+func (e *erroringNode) AverageLatency() time.Duration { return 0 }
+func (e *erroringNode) NodeStates() map[int32]string  { return nil }
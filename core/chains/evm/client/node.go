@@ -91,6 +91,10 @@ type Node interface {
 	StateAndLatestBlockNumber() (NodeState, int64)
 	// Unique identifier for node
 	ID() int32
+	// Order() returns the priority tier of the node, lower values are preferred by the PriorityLevel selector
+	Order() int32
+	// AverageLatency() returns the rolling average duration of successful RPC calls, or zero if there were none yet
+	AverageLatency() time.Duration
 	ChainID() *big.Int
 
 	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
@@ -133,6 +137,7 @@ type node struct {
 	rpcLog  logger.Logger
 	name    string
 	id      int32
+	order   int32
 	chainID *big.Int
 	cfg     NodeConfig
 
@@ -142,6 +147,10 @@ type node struct {
 	// Each node is tracking the last received head number
 	latestReceivedBlockNumber int64
 
+	// averageLatency is an exponentially weighted moving average of successful RPC call durations
+	averageLatency   time.Duration
+	averageLatencyMu sync.RWMutex
+
 	// Need to track subscriptions because closing the RPC does not (always?)
 	// close the underlying subscription
 	subs []ethereum.Subscription
@@ -163,6 +172,10 @@ type node struct {
 	// moved to out-of-sync state. It is better to have one out-of-sync node
 	// than no nodes at all.
 	nLiveNodes func() int
+
+	// onStateChange is a passed in function which is called with the new
+	// state after every state transition, with stateMu held.
+	onStateChange func(nodeID int32, state NodeState)
 }
 
 // NodeConfig allows configuration of the node
@@ -171,13 +184,16 @@ type NodeConfig interface {
 	NodePollFailureThreshold() uint32
 	NodePollInterval() time.Duration
 	NodeSelectionMode() string
+	NodeQuorumNodes() uint32
+	NodeQuorumThreshold() uint32
 }
 
 // NewNode returns a new *node as Node
-func NewNode(nodeCfg NodeConfig, lggr logger.Logger, wsuri url.URL, httpuri *url.URL, name string, id int32, chainID *big.Int) Node {
+func NewNode(nodeCfg NodeConfig, lggr logger.Logger, wsuri url.URL, httpuri *url.URL, name string, id int32, chainID *big.Int, nodeOrder int32) Node {
 	n := new(node)
 	n.name = name
 	n.id = id
+	n.order = nodeOrder
 	n.chainID = chainID
 	n.cfg = nodeCfg
 	n.ws.uri = wsuri
@@ -189,6 +205,7 @@ func NewNode(nodeCfg NodeConfig, lggr logger.Logger, wsuri url.URL, httpuri *url
 	lggr = lggr.Named("Node").With(
 		"nodeTier", "primary",
 		"nodeName", name,
+		"nodeOrder", nodeOrder,
 		"node", n.String(),
 		"evmChainID", chainID,
 	)
@@ -351,6 +368,7 @@ func (n *node) Close() {
 		n.cancelNodeCtx()
 		n.cancelInflightRequests()
 		n.state = NodeStateClosed
+		n.stateChanged()
 		if n.ws.rpc != nil {
 			n.ws.rpc.Close()
 		}
@@ -945,6 +963,7 @@ func (n *node) logResult(
 	promEVMPoolRPCNodeCalls.WithLabelValues(n.chainID.String(), n.name).Inc()
 	if err == nil {
 		promEVMPoolRPCNodeCallsSuccess.WithLabelValues(n.chainID.String(), n.name).Inc()
+		n.recordLatency(callDuration)
 		lggr.Debugw(
 			fmt.Sprintf("evmclient.Client#%s RPC call success", callName),
 			results...,
@@ -1047,3 +1066,26 @@ func (n *node) String() string {
 func (n *node) ID() int32 {
 	return n.id
 }
+
+func (n *node) Order() int32 {
+	return n.order
+}
+
+// latencyWeight is the weight given to each new sample of the average latency
+const latencyWeight = 0.1
+
+func (n *node) recordLatency(d time.Duration) {
+	n.averageLatencyMu.Lock()
+	defer n.averageLatencyMu.Unlock()
+	if n.averageLatency == 0 {
+		n.averageLatency = d
+		return
+	}
+	n.averageLatency = time.Duration(latencyWeight*float64(d) + (1-latencyWeight)*float64(n.averageLatency))
+}
+
+func (n *node) AverageLatency() time.Duration {
+	n.averageLatencyMu.RLock()
+	defer n.averageLatencyMu.RUnlock()
+	return n.averageLatency
+}
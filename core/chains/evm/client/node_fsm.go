@@ -116,6 +116,15 @@ func (n *node) setState(s NodeState) {
 	n.stateMu.Lock()
 	defer n.stateMu.Unlock()
 	n.state = s
+	n.stateChanged()
+}
+
+// stateChanged calls the onStateChange hook, if any. It must be called with
+// stateMu held, so hooks must neither block nor call back into the node.
+func (n *node) stateChanged() {
+	if n.onStateChange != nil {
+		n.onStateChange(n.id, n.state)
+	}
 }
 
 // declareXXX methods change the state and pass conrol off the new state
@@ -139,6 +148,7 @@ func (n *node) transitionToAlive(fn func()) {
 	switch n.state {
 	case NodeStateDialed, NodeStateInvalidChainID:
 		n.state = NodeStateAlive
+		n.stateChanged()
 	default:
 		panic(fmt.Sprintf("cannot transition from %#v to %#v", n.state, NodeStateAlive))
 	}
@@ -166,6 +176,7 @@ func (n *node) transitionToInSync(fn func()) {
 	switch n.state {
 	case NodeStateOutOfSync:
 		n.state = NodeStateAlive
+		n.stateChanged()
 	default:
 		panic(fmt.Sprintf("cannot transition from %#v to %#v", n.state, NodeStateAlive))
 	}
@@ -193,6 +204,7 @@ func (n *node) transitionToOutOfSync(fn func()) {
 	case NodeStateAlive:
 		n.disconnectAll()
 		n.state = NodeStateOutOfSync
+		n.stateChanged()
 	default:
 		panic(fmt.Sprintf("cannot transition from %#v to %#v", n.state, NodeStateOutOfSync))
 	}
@@ -218,6 +230,7 @@ func (n *node) transitionToUnreachable(fn func()) {
 	case NodeStateUndialed, NodeStateDialed, NodeStateAlive, NodeStateOutOfSync, NodeStateInvalidChainID:
 		n.disconnectAll()
 		n.state = NodeStateUnreachable
+		n.stateChanged()
 	default:
 		panic(fmt.Sprintf("cannot transition from %#v to %#v", n.state, NodeStateUnreachable))
 	}
@@ -243,6 +256,7 @@ func (n *node) transitionToInvalidChainID(fn func()) {
 	case NodeStateDialed, NodeStateOutOfSync:
 		n.disconnectAll()
 		n.state = NodeStateInvalidChainID
+		n.stateChanged()
 	default:
 		panic(fmt.Sprintf("cannot transition from %#v to %#v", n.state, NodeStateInvalidChainID))
 	}
@@ -45,7 +45,7 @@ func TestUnit_Node_StateTransitions(t *testing.T) {
 	s := testutils.NewWSServer(t, testutils.FixtureChainID, func(method string, params gjson.Result) (string, string) {
 		return "", ""
 	})
-	iN := NewNode(TestNodeConfig{}, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, nil)
+	iN := NewNode(TestNodeConfig{}, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, nil, 1)
 	n := iN.(*node)
 
 	assert.Equal(t, NodeStateUndialed, n.State())
@@ -173,3 +173,27 @@ func TestUnit_Node_StateTransitions(t *testing.T) {
 		assert.Panics(t, n.Close)
 	})
 }
+
+func TestUnit_Node_OnStateChange(t *testing.T) {
+	t.Parallel()
+
+	s := testutils.NewWSServer(t, testutils.FixtureChainID, func(method string, params gjson.Result) (string, string) {
+		return "", ""
+	})
+	iN := NewNode(TestNodeConfig{}, logger.TestLogger(t), *s.WSURL(), nil, "test node", 42, nil, 1)
+	n := iN.(*node)
+	require.NoError(t, n.dial(testutils.Context(t)))
+
+	var changes []NodeState
+	n.onStateChange = func(nodeID int32, state NodeState) {
+		assert.Equal(t, int32(42), nodeID)
+		changes = append(changes, state)
+	}
+
+	m := new(fnMock)
+	n.setState(NodeStateDialed)
+	n.transitionToAlive(m.Fn)
+	n.transitionToOutOfSync(m.Fn)
+	m.AssertNumberOfCalls(t, 2)
+	assert.Equal(t, []NodeState{NodeStateDialed, NodeStateAlive, NodeStateOutOfSync}, changes)
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"time"
+)
+
+type lowestLatencyNodeSelector struct {
+	nodes []Node
+}
+
+// NewLowestLatencyNodeSelector returns a NodeSelector which picks the alive
+// node with the lowest rolling average RPC latency. Nodes which have not
+// completed any call yet report zero latency, so they are tried first and
+// start contributing samples straight away.
+func NewLowestLatencyNodeSelector(nodes []Node) NodeSelector {
+	return &lowestLatencyNodeSelector{
+		nodes: nodes,
+	}
+}
+
+func (s *lowestLatencyNodeSelector) Select() Node {
+	var node Node
+	var lowestLatency time.Duration
+	for _, n := range s.nodes {
+		if n.State() != NodeStateAlive {
+			continue
+		}
+		latency := n.AverageLatency()
+		if node == nil || latency < lowestLatency {
+			node = n
+			lowestLatency = latency
+		}
+	}
+	return node
+}
+
+func (s *lowestLatencyNodeSelector) Name() string {
+	return NodeSelectionMode_LowestLatency
+}
@@ -0,0 +1,70 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLowestLatencyNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for i, tc := range []struct {
+		state   evmclient.NodeState
+		latency time.Duration
+	}{
+		// first node is the fastest, but is out of sync
+		{evmclient.NodeStateOutOfSync, time.Millisecond},
+		{evmclient.NodeStateAlive, 300 * time.Millisecond},
+		// third node is the fastest alive node
+		{evmclient.NodeStateAlive, 20 * time.Millisecond},
+		{evmclient.NodeStateAlive, 100 * time.Millisecond},
+	} {
+		node := evmmocks.NewNode(t)
+		node.On("State").Return(tc.state)
+		if i > 0 {
+			node.On("AverageLatency").Return(tc.latency)
+		}
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewLowestLatencyNodeSelector(nodes)
+	assert.Equal(t, nodes[2], selector.Select())
+
+	t.Run("prefers nodes without latency samples", func(t *testing.T) {
+		node := evmmocks.NewNode(t)
+		node.On("State").Return(evmclient.NodeStateAlive)
+		node.On("AverageLatency").Return(time.Duration(0))
+		nodes := append(nodes, node)
+
+		selector := evmclient.NewLowestLatencyNodeSelector(nodes)
+		assert.Equal(t, nodes[4], selector.Select())
+	})
+}
+
+func TestLowestLatencyNodeSelector_None(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for i := 0; i < 3; i++ {
+		node := evmmocks.NewNode(t)
+		if i == 0 {
+			// first node is out of sync
+			node.On("State").Return(evmclient.NodeStateOutOfSync)
+		} else {
+			// others are unreachable
+			node.On("State").Return(evmclient.NodeStateUnreachable)
+		}
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewLowestLatencyNodeSelector(nodes)
+	assert.Nil(t, selector.Select())
+}
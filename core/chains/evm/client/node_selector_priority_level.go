@@ -0,0 +1,54 @@
+package client
+
+import (
+	"math"
+
+	"go.uber.org/atomic"
+)
+
+type priorityLevelNodeSelector struct {
+	nodes           []Node
+	roundRobinCount atomic.Uint32
+}
+
+// NewPriorityLevelNodeSelector returns a NodeSelector which prefers alive nodes
+// with the lowest Order value (i.e. the highest priority tier), only falling
+// back to the next tier when no node in a higher tier is alive. Nodes sharing
+// the same tier are selected in round-robin fashion.
+func NewPriorityLevelNodeSelector(nodes []Node) NodeSelector {
+	return &priorityLevelNodeSelector{
+		nodes: nodes,
+	}
+}
+
+func (s *priorityLevelNodeSelector) Select() Node {
+	var tier []Node
+	var bestOrder int32 = math.MaxInt32
+	for _, n := range s.nodes {
+		if n.State() != NodeStateAlive {
+			continue
+		}
+		order := n.Order()
+		if order < bestOrder {
+			bestOrder = order
+			tier = []Node{n}
+		} else if order == bestOrder {
+			tier = append(tier, n)
+		}
+	}
+
+	nNodes := len(tier)
+	if nNodes == 0 {
+		return nil
+	}
+
+	// NOTE: Inc returns the number after addition, so we must -1 to get the "current" counter
+	count := s.roundRobinCount.Inc() - 1
+	idx := int(count % uint32(nNodes))
+
+	return tier[idx]
+}
+
+func (s *priorityLevelNodeSelector) Name() string {
+	return NodeSelectionMode_PriorityLevel
+}
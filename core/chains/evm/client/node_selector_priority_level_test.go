@@ -0,0 +1,77 @@
+package client_test
+
+import (
+	"testing"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriorityLevelNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for i, tc := range []struct {
+		state evmclient.NodeState
+		order int32
+	}{
+		// first node has the highest priority, but is out of sync
+		{evmclient.NodeStateOutOfSync, 1},
+		// second & third nodes are alive and share the next priority tier
+		{evmclient.NodeStateAlive, 2},
+		{evmclient.NodeStateAlive, 2},
+		// fourth node is alive, but has the lowest priority
+		{evmclient.NodeStateAlive, 3},
+	} {
+		node := evmmocks.NewNode(t)
+		node.On("State").Return(tc.state)
+		if i > 0 {
+			node.On("Order").Return(tc.order)
+		}
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewPriorityLevelNodeSelector(nodes)
+	assert.Equal(t, nodes[1], selector.Select())
+	assert.Equal(t, nodes[2], selector.Select())
+	assert.Equal(t, nodes[1], selector.Select())
+	assert.Equal(t, nodes[2], selector.Select())
+}
+
+func TestPriorityLevelNodeSelector_FallsBackToLowerTier(t *testing.T) {
+	t.Parallel()
+
+	high := evmmocks.NewNode(t)
+	high.On("State").Return(evmclient.NodeStateUnreachable)
+	low := evmmocks.NewNode(t)
+	low.On("State").Return(evmclient.NodeStateAlive)
+	low.On("Order").Return(int32(100))
+
+	selector := evmclient.NewPriorityLevelNodeSelector([]evmclient.Node{high, low})
+	assert.Equal(t, low, selector.Select())
+	assert.Equal(t, low, selector.Select())
+}
+
+func TestPriorityLevelNodeSelector_None(t *testing.T) {
+	t.Parallel()
+
+	var nodes []evmclient.Node
+
+	for i := 0; i < 3; i++ {
+		node := evmmocks.NewNode(t)
+		if i == 0 {
+			// first node is out of sync
+			node.On("State").Return(evmclient.NodeStateOutOfSync)
+		} else {
+			// others are unreachable
+			node.On("State").Return(evmclient.NodeStateUnreachable)
+		}
+		nodes = append(nodes, node)
+	}
+
+	selector := evmclient.NewPriorityLevelNodeSelector(nodes)
+	assert.Nil(t, selector.Select())
+}
@@ -195,3 +195,8 @@ func (nc *NullClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, er
 
 // NodeStates implements evmclient.Client
 func (nc *NullClient) NodeStates() map[int32]string { return nil }
+
+// OnNodeStateChange implements evmclient.Client
+func (nc *NullClient) OnNodeStateChange(func(int32, string)) (unregister func()) {
+	return func() {}
+}
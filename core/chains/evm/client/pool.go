@@ -29,8 +29,10 @@ var (
 )
 
 const (
-	NodeSelectionMode_HighestHead = "HighestHead"
-	NodeSelectionMode_RoundRobin  = "RoundRobin"
+	NodeSelectionMode_HighestHead   = "HighestHead"
+	NodeSelectionMode_RoundRobin    = "RoundRobin"
+	NodeSelectionMode_PriorityLevel = "PriorityLevel"
+	NodeSelectionMode_LowestLatency = "LowestLatency"
 )
 
 // NodeSelector represents a strategy to select the next node from the pool.
@@ -46,6 +48,8 @@ type NodeSelector interface {
 type PoolConfig interface {
 	NodeSelectionMode() string
 	NodeNoNewHeadsThreshold() time.Duration
+	NodeQuorumNodes() uint32
+	NodeQuorumThreshold() uint32
 }
 
 // Pool represents an abstraction over one or more primary nodes
@@ -61,6 +65,10 @@ type Pool struct {
 
 	chStop chan struct{}
 	wg     sync.WaitGroup
+
+	stateHooksMu  sync.RWMutex
+	stateHooks    map[int]func(nodeID int32, state NodeState)
+	nextStateHook int
 }
 
 func NewPool(logger logger.Logger, cfg PoolConfig, nodes []Node, sendonlys []SendOnlyNode, chainID *big.Int) *Pool {
@@ -74,6 +82,10 @@ func NewPool(logger logger.Logger, cfg PoolConfig, nodes []Node, sendonlys []Sen
 			return NewHighestHeadNodeSelector(nodes)
 		case NodeSelectionMode_RoundRobin:
 			return NewRoundRobinSelector(nodes)
+		case NodeSelectionMode_PriorityLevel:
+			return NewPriorityLevelNodeSelector(nodes)
+		case NodeSelectionMode_LowestLatency:
+			return NewLowestLatencyNodeSelector(nodes)
 		default:
 			panic(fmt.Sprintf("unsupported NodeSelectionMode: %s", cfg.NodeSelectionMode()))
 		}
@@ -96,9 +108,15 @@ func NewPool(logger logger.Logger, cfg PoolConfig, nodes []Node, sendonlys []Sen
 		nodeSelector,
 		make(chan struct{}),
 		sync.WaitGroup{},
+		sync.RWMutex{},
+		make(map[int]func(int32, NodeState)),
+		0,
 	}
 
 	p.logger.Debugf("The pool is configured to use NodeSelectionMode: %s", cfg.NodeSelectionMode())
+	if p.quorumEnabled() {
+		p.logger.Debugf("The pool is configured to use quorum reads across %d nodes with threshold %d", cfg.NodeQuorumNodes(), p.quorumThreshold())
+	}
 
 	return p
 }
@@ -120,6 +138,7 @@ func (p *Pool) Dial(ctx context.Context) error {
 				// otherwise leave no nodes available. It is better to have one
 				// node in a degraded state than no nodes at all.
 				rawNode.nLiveNodes = p.nLiveNodes
+				rawNode.onStateChange = p.nodeStateChanged
 			}
 			// node will handle its own redialing and automatic recovery
 			if err := n.Start(ctx); err != nil {
@@ -142,6 +161,30 @@ func (p *Pool) Dial(ctx context.Context) error {
 	})
 }
 
+// OnNodeStateChange registers hook to be called with the ID and new state of a
+// node after each of its state transitions, until unregister is called. Hooks
+// must not block, as they are called synchronously by the node.
+func (p *Pool) OnNodeStateChange(hook func(nodeID int32, state NodeState)) (unregister func()) {
+	p.stateHooksMu.Lock()
+	defer p.stateHooksMu.Unlock()
+	id := p.nextStateHook
+	p.nextStateHook++
+	p.stateHooks[id] = hook
+	return func() {
+		p.stateHooksMu.Lock()
+		defer p.stateHooksMu.Unlock()
+		delete(p.stateHooks, id)
+	}
+}
+
+func (p *Pool) nodeStateChanged(nodeID int32, state NodeState) {
+	p.stateHooksMu.RLock()
+	defer p.stateHooksMu.RUnlock()
+	for _, hook := range p.stateHooks {
+		hook(nodeID, state)
+	}
+}
+
 // nLiveNodes returns the number of currently alive nodes
 func (p *Pool) nLiveNodes() (nLiveNodes int) {
 	for _, n := range p.nodes {
@@ -351,6 +394,11 @@ func (p *Pool) NonceAt(ctx context.Context, account common.Address, blockNumber
 }
 
 func (p *Pool) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if p.quorumEnabled() {
+		return quorumRead(ctx, p, "TransactionReceipt", func(ctx context.Context, n Node) (*types.Receipt, error) {
+			return n.TransactionReceipt(ctx, txHash)
+		}, receiptQuorumKey)
+	}
 	return p.selectNode().TransactionReceipt(ctx, txHash)
 }
 
@@ -363,6 +411,11 @@ func (p *Pool) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block,
 }
 
 func (p *Pool) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	if p.quorumEnabled() {
+		return quorumRead(ctx, p, "BalanceAt", func(ctx context.Context, n Node) (*big.Int, error) {
+			return n.BalanceAt(ctx, account, blockNumber)
+		}, bigIntQuorumKey)
+	}
 	return p.selectNode().BalanceAt(ctx, account, blockNumber)
 }
 
@@ -383,6 +436,11 @@ func (p *Pool) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
 }
 
 func (p *Pool) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if p.quorumEnabled() {
+		return quorumRead(ctx, p, "CallContract", func(ctx context.Context, n Node) ([]byte, error) {
+			return n.CallContract(ctx, msg, blockNumber)
+		}, bytesQuorumKey)
+	}
 	return p.selectNode().CallContract(ctx, msg, blockNumber)
 }
 
@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	// PromEVMPoolRPCQuorumDisagreements reports quorum reads on which RPC nodes disagreed
+	PromEVMPoolRPCQuorumDisagreements = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "evm_pool_rpc_quorum_disagreements",
+		Help: "The total number of quorum reads where RPC nodes returned differing results",
+	}, []string{"evmChainID", "rpcCallName"})
+)
+
+// ErrQuorumNotReached is returned by quorum reads when not enough nodes agree on a result.
+var ErrQuorumNotReached = errors.New("quorum not reached")
+
+type quorumResult[T any] struct {
+	node Node
+	val  T
+	err  error
+	key  string
+}
+
+// quorumEnabled returns true if critical read calls must be fanned out to
+// multiple nodes.
+func (p *Pool) quorumEnabled() bool {
+	return p.config.NodeQuorumNodes() > 1
+}
+
+// quorumThreshold returns the number of nodes that must agree, defaulting to
+// a simple majority of NodeQuorumNodes.
+func (p *Pool) quorumThreshold() int {
+	if t := p.config.NodeQuorumThreshold(); t > 0 {
+		return int(t)
+	}
+	return int(p.config.NodeQuorumNodes())/2 + 1
+}
+
+// quorumNodes returns up to NodeQuorumNodes alive nodes, starting with the
+// node picked by the configured NodeSelector.
+func (p *Pool) quorumNodes() (nodes []Node) {
+	size := int(p.config.NodeQuorumNodes())
+	if first := p.nodeSelector.Select(); first != nil {
+		nodes = append(nodes, first)
+	}
+	for _, n := range p.nodes {
+		if len(nodes) >= size {
+			break
+		}
+		if n.State() != NodeStateAlive || (len(nodes) > 0 && n == nodes[0]) {
+			continue
+		}
+		nodes = append(nodes, n)
+	}
+	return
+}
+
+// quorumRead sends call to NodeQuorumNodes alive nodes in parallel, and
+// returns as soon as NodeQuorumThreshold of them return the same result.
+// Results are compared by the string returned from key. Errors are compared by
+// message, so that e.g. ethereum.NotFound can also reach a quorum.
+// Any disagreement between nodes is logged and counted.
+func quorumRead[T any](ctx context.Context, p *Pool, callName string, call func(context.Context, Node) (T, error), key func(T) string) (val T, err error) {
+	threshold := p.quorumThreshold()
+	nodes := p.quorumNodes()
+	if len(nodes) < threshold {
+		p.logger.Criticalw("Not enough live RPC nodes available for quorum read", "rpcCallName", callName,
+			"aliveNodes", len(nodes), "quorumThreshold", threshold)
+		return val, errors.Wrapf(ErrQuorumNotReached, "%s: only %d live nodes available for chain %s, need %d",
+			callName, len(nodes), p.chainID.String(), threshold)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// buffered so that nodes which respond after the quorum was reached never block
+	results := make(chan quorumResult[T], len(nodes))
+	for _, n := range nodes {
+		go func(n Node) {
+			r := quorumResult[T]{node: n}
+			r.val, r.err = call(ctx, n)
+			if r.err != nil {
+				r.key = "error: " + r.err.Error()
+			} else {
+				r.key = key(r.val)
+			}
+			results <- r
+		}(n)
+	}
+
+	counts := make(map[string]int)
+	var received []quorumResult[T]
+	for range nodes {
+		r := <-results
+		received = append(received, r)
+		counts[r.key]++
+		if counts[r.key] >= threshold {
+			reportQuorumDisagreement(p, callName, received, counts)
+			return r.val, r.err
+		}
+	}
+
+	reportQuorumDisagreement(p, callName, received, counts)
+	return val, errors.Wrapf(ErrQuorumNotReached, "%s: no %d of %d nodes agreed on a result", callName, threshold, len(nodes))
+}
+
+// reportQuorumDisagreement logs and counts quorum reads where nodes returned
+// more than one distinct result.
+func reportQuorumDisagreement[T any](p *Pool, callName string, received []quorumResult[T], counts map[string]int) {
+	if len(counts) < 2 {
+		return
+	}
+	PromEVMPoolRPCQuorumDisagreements.WithLabelValues(p.chainID.String(), callName).Inc()
+	results := make(map[string]string, len(received))
+	for _, r := range received {
+		results[r.node.String()] = r.key
+	}
+	p.logger.Warnw(fmt.Sprintf("RPC nodes disagree on %s result", callName), "rpcCallName", callName, "results", results)
+}
+
+func bytesQuorumKey(b []byte) string {
+	return hexutil.Encode(b)
+}
+
+func bigIntQuorumKey(i *big.Int) string {
+	if i == nil {
+		return "<nil>"
+	}
+	return i.String()
+}
+
+func receiptQuorumKey(r *types.Receipt) string {
+	if r == nil {
+		return "<nil>"
+	}
+	b, err := json.Marshal(r)
+	if err != nil {
+		return "invalid receipt: " + err.Error()
+	}
+	return string(b)
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
 	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
@@ -22,11 +23,14 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 type poolConfig struct {
 	selectionMode       string
 	noNewHeadsThreshold time.Duration
+	quorumNodes         uint32
+	quorumThreshold     uint32
 }
 
 func (c poolConfig) NodeSelectionMode() string {
@@ -37,6 +41,14 @@ func (c poolConfig) NodeNoNewHeadsThreshold() time.Duration {
 	return c.noNewHeadsThreshold
 }
 
+func (c poolConfig) NodeQuorumNodes() uint32 {
+	return c.quorumNodes
+}
+
+func (c poolConfig) NodeQuorumThreshold() uint32 {
+	return c.quorumThreshold
+}
+
 var defaultConfig evmclient.PoolConfig = &poolConfig{
 	selectionMode:       evmclient.NodeSelectionMode_RoundRobin,
 	noNewHeadsThreshold: 0,
@@ -218,7 +230,7 @@ func (r *chainIDResps) newNode(t *testing.T, nodeChainID int64) evmclient.Node {
 	}
 
 	defer func() { r.id++ }()
-	return evmclient.NewNode(evmclient.TestNodeConfig{}, logger.TestLogger(t), *wsURL, httpURL, t.Name(), r.id, big.NewInt(nodeChainID))
+	return evmclient.NewNode(evmclient.TestNodeConfig{}, logger.TestLogger(t), *wsURL, httpURL, t.Name(), r.id, big.NewInt(nodeChainID), 1)
 }
 
 type chainIDService struct {
@@ -319,3 +331,92 @@ func TestUnit_Pool_BatchCallContextAll(t *testing.T) {
 
 	p.BatchCallContextAll(ctx, b)
 }
+
+func TestUnit_Pool_QuorumReads(t *testing.T) {
+	t.Parallel()
+
+	cfg := &poolConfig{
+		selectionMode:   evmclient.NodeSelectionMode_RoundRobin,
+		quorumNodes:     3,
+		quorumThreshold: 2,
+	}
+	account := testutils.NewAddress()
+
+	newNode := func(t *testing.T, name string, state evmclient.NodeState) *evmmocks.Node {
+		n := evmmocks.NewNode(t)
+		n.On("String").Maybe().Return(name)
+		n.On("State").Maybe().Return(state)
+		return n
+	}
+
+	t.Run("returns result when nodes agree", func(t *testing.T) {
+		chainID := testutils.NewRandomEVMChainID()
+		var nodes []evmclient.Node
+		for _, name := range []string{"n1", "n2", "n3"} {
+			n := newNode(t, name, evmclient.NodeStateAlive)
+			n.On("BalanceAt", mock.Anything, account, (*big.Int)(nil)).Maybe().Return(big.NewInt(42), nil)
+			nodes = append(nodes, n)
+		}
+		p := evmclient.NewPool(logger.TestLogger(t), cfg, nodes, nil, chainID)
+
+		balance, err := p.BalanceAt(testutils.Context(t), account, nil)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), balance)
+		assert.Equal(t, float64(0), promtestutil.ToFloat64(evmclient.PromEVMPoolRPCQuorumDisagreements.WithLabelValues(chainID.String(), "BalanceAt")))
+	})
+
+	t.Run("returns majority result", func(t *testing.T) {
+		n1 := newNode(t, "n1", evmclient.NodeStateAlive)
+		n1.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).Maybe().Return([]byte{0xba, 0xd}, nil)
+		n2 := newNode(t, "n2", evmclient.NodeStateAlive)
+		n2.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).Maybe().Return([]byte{0x01}, nil)
+		n3 := newNode(t, "n3", evmclient.NodeStateAlive)
+		n3.On("CallContract", mock.Anything, mock.Anything, (*big.Int)(nil)).Maybe().Return([]byte{0x01}, nil)
+		p := evmclient.NewPool(logger.TestLogger(t), cfg, []evmclient.Node{n1, n2, n3}, nil, testutils.NewRandomEVMChainID())
+
+		res, err := p.CallContract(testutils.Context(t), ethereum.CallMsg{}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x01}, res)
+	})
+
+	t.Run("returns matching errors", func(t *testing.T) {
+		txHash := utils.NewHash()
+		var nodes []evmclient.Node
+		for _, name := range []string{"n1", "n2", "n3"} {
+			n := newNode(t, name, evmclient.NodeStateAlive)
+			n.On("TransactionReceipt", mock.Anything, txHash).Maybe().Return(nil, ethereum.NotFound)
+			nodes = append(nodes, n)
+		}
+		p := evmclient.NewPool(logger.TestLogger(t), cfg, nodes, nil, testutils.NewRandomEVMChainID())
+
+		_, err := p.TransactionReceipt(testutils.Context(t), txHash)
+		require.ErrorIs(t, err, ethereum.NotFound)
+	})
+
+	t.Run("errors and reports disagreement when no quorum is reached", func(t *testing.T) {
+		chainID := testutils.NewRandomEVMChainID()
+		var nodes []evmclient.Node
+		for i, name := range []string{"n1", "n2", "n3"} {
+			n := newNode(t, name, evmclient.NodeStateAlive)
+			n.On("BalanceAt", mock.Anything, account, (*big.Int)(nil)).Once().Return(big.NewInt(int64(i)), nil)
+			nodes = append(nodes, n)
+		}
+		p := evmclient.NewPool(logger.TestLogger(t), cfg, nodes, nil, chainID)
+
+		_, err := p.BalanceAt(testutils.Context(t), account, nil)
+		require.ErrorIs(t, err, evmclient.ErrQuorumNotReached)
+		assert.Equal(t, float64(1), promtestutil.ToFloat64(evmclient.PromEVMPoolRPCQuorumDisagreements.WithLabelValues(chainID.String(), "BalanceAt")))
+	})
+
+	t.Run("errors when not enough nodes are alive", func(t *testing.T) {
+		nodes := []evmclient.Node{
+			newNode(t, "n1", evmclient.NodeStateAlive),
+			newNode(t, "n2", evmclient.NodeStateUnreachable),
+			newNode(t, "n3", evmclient.NodeStateOutOfSync),
+		}
+		p := evmclient.NewPool(logger.TestLogger(t), cfg, nodes, nil, testutils.NewRandomEVMChainID())
+
+		_, err := p.BalanceAt(testutils.Context(t), account, nil)
+		require.ErrorIs(t, err, evmclient.ErrQuorumNotReached)
+	})
+}
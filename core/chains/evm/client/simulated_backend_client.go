@@ -493,3 +493,7 @@ func (c *SimulatedBackendClient) SuggestGasTipCap(ctx context.Context) (tipCap *
 
 // NodeStates implements evmclient.Client
 func (c *SimulatedBackendClient) NodeStates() map[int32]string { return nil }
+
+func (c *SimulatedBackendClient) OnNodeStateChange(func(int32, string)) (unregister func()) {
+	return func() {}
+}
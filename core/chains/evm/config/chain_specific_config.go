@@ -60,6 +60,7 @@ type (
 		linkContractAddress                        string
 		operatorFactoryAddress                     string
 		logBackfillBatchSize                       uint32
+		logKeepBlocksDepth                         uint32
 		logPollInterval                            time.Duration
 		maxGasPriceWei                             big.Int
 		maxInFlightTransactions                    uint32
@@ -71,6 +72,8 @@ type (
 		nodePollFailureThreshold                   uint32
 		nodePollInterval                           time.Duration
 		nodeSelectionMode                          string
+		nodeQuorumNodes                            uint32
+		nodeQuorumThreshold                        uint32
 
 		nonceAutoSync       bool
 		useForwarders       bool
@@ -138,6 +141,7 @@ func setChainSpecificConfigDefaultSets() {
 		linkContractAddress:                   "",
 		operatorFactoryAddress:                "",
 		logBackfillBatchSize:                  100,
+		logKeepBlocksDepth:                    0,
 		logPollInterval:                       15 * time.Second,
 		maxGasPriceWei:                        *assets.GWei(100000),
 		maxInFlightTransactions:               16,
@@ -149,6 +153,8 @@ func setChainSpecificConfigDefaultSets() {
 		nodePollFailureThreshold:              5,
 		nodePollInterval:                      10 * time.Second,
 		nodeSelectionMode:                     client.NodeSelectionMode_HighestHead,
+		nodeQuorumNodes:                       0,
+		nodeQuorumThreshold:                   0,
 		nonceAutoSync:                         true,
 		useForwarders:                         false,
 		ocrContractConfirmations:              4,
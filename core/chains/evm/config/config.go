@@ -62,6 +62,7 @@ type ChainScopedOnlyConfig interface {
 	EvmHeadTrackerMaxBufferSize() uint32
 	EvmHeadTrackerSamplingInterval() time.Duration
 	EvmLogBackfillBatchSize() uint32
+	EvmLogKeepBlocksDepth() uint32
 	EvmLogPollInterval() time.Duration
 	EvmMaxGasPriceWei() *big.Int
 	EvmMaxInFlightTransactions() uint32
@@ -196,8 +197,8 @@ func (c *chainScopedConfig) validate() (err error) {
 	if c.EvmHeadTrackerHistoryDepth() < c.EvmFinalityDepth() {
 		err = multierr.Combine(err, errors.New("ETH_HEAD_TRACKER_HISTORY_DEPTH must be equal to or greater than ETH_FINALITY_DEPTH"))
 	}
-	if c.GasEstimatorMode() == "BlockHistory" && c.BlockHistoryEstimatorBlockHistorySize() <= 0 {
-		err = multierr.Combine(err, errors.New("BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE must be greater than or equal to 1 if block history estimator is enabled"))
+	if mode := c.GasEstimatorMode(); (mode == "BlockHistory" || mode == "FeeHistory") && c.BlockHistoryEstimatorBlockHistorySize() <= 0 {
+		err = multierr.Combine(err, errors.Errorf("BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE must be greater than or equal to 1 if %s estimator is enabled", mode))
 	}
 	if c.EvmFinalityDepth() < 1 {
 		err = multierr.Combine(err, errors.New("ETH_FINALITY_DEPTH must be greater than or equal to 1"))
@@ -229,8 +230,8 @@ func (c *chainScopedConfig) validate() (err error) {
 		case config.ChainOptimism, config.ChainMetis:
 			gasEst := c.GasEstimatorMode()
 			switch gasEst {
-			case "Optimism2", "L2Suggested":
-				// valid
+			case "Optimism2", "L2Suggested", "OptimismL1Fee":
+				// valid, OptimismL1Fee is checked below
 			case "Optimism":
 				err = multierr.Combine(err, errors.Errorf("GAS_ESTIMATOR_MODE %q is no longer supported since OVM 1.0 was discontinued - use %q", "Optimism", "L2Suggested"))
 			default:
@@ -240,6 +241,9 @@ func (c *chainScopedConfig) validate() (err error) {
 		case config.ChainArbitrum, config.ChainXDai:
 
 		}
+		if gasEst := c.GasEstimatorMode(); gasEst == "OptimismL1Fee" && chainType != config.ChainOptimism {
+			err = multierr.Combine(err, errors.Errorf("GAS_ESTIMATOR_MODE %q is only allowed with chain type %q", gasEst, config.ChainOptimism))
+		}
 	}
 
 	return err
@@ -1091,6 +1095,18 @@ func (c *chainScopedConfig) EvmLogBackfillBatchSize() uint32 {
 	return c.defaultSet.logBackfillBatchSize
 }
 
+// EvmLogKeepBlocksDepth is the number of finalized blocks which the log poller
+// keeps in the database, older blocks are pruned.
+// Set to zero to disable pruning.
+func (c *chainScopedConfig) EvmLogKeepBlocksDepth() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmLogKeepBlocksDepth()
+	if ok {
+		c.logEnvOverrideOnce("EvmLogKeepBlocksDepth", val)
+		return val
+	}
+	return c.defaultSet.logKeepBlocksDepth
+}
+
 // EvmRPCDefaultBatchSize controls the number of receipts fetched in each
 // request in the EthConfirmer
 func (c *chainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
@@ -1252,6 +1268,29 @@ func (c *chainScopedConfig) NodeSelectionMode() string {
 	return c.defaultSet.nodeSelectionMode
 }
 
+// NodeQuorumNodes is the number of alive nodes that critical read calls are
+// sent to when quorum reads are enabled.
+// Set to zero to disable quorum reads.
+func (c *chainScopedConfig) NodeQuorumNodes() uint32 {
+	val, ok := c.GeneralConfig.GlobalNodeQuorumNodes()
+	if ok {
+		c.logEnvOverrideOnce("NodeQuorumNodes", val)
+		return val
+	}
+	return c.defaultSet.nodeQuorumNodes
+}
+
+// NodeQuorumThreshold is the number of nodes that must return the same result
+// for a quorum read to succeed.
+func (c *chainScopedConfig) NodeQuorumThreshold() uint32 {
+	val, ok := c.GeneralConfig.GlobalNodeQuorumThreshold()
+	if ok {
+		c.logEnvOverrideOnce("NodeQuorumThreshold", val)
+		return val
+	}
+	return c.defaultSet.nodeQuorumThreshold
+}
+
 // https://app.shortcut.com/chainlinklabs/story/33622/remove-legacy-config
 func lookupEnv[T any](c *chainScopedConfig, k string, parse func(string) (T, error)) (t T, ok bool) {
 	s, ok := os.LookupEnv(k)
@@ -1164,6 +1164,20 @@ func (_m *ChainScopedConfig) EvmLogBackfillBatchSize() uint32 {
 	return r0
 }
 
+// EvmLogKeepBlocksDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmLogKeepBlocksDepth() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // EvmLogPollInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmLogPollInterval() time.Duration {
 	ret := _m.Called()
@@ -1844,6 +1858,20 @@ func (_m *ChainScopedConfig) LeaseLockRefreshInterval() time.Duration {
 	return r0
 }
 
+// LeaseLockWarmStandby provides a mock function with given fields:
+func (_m *ChainScopedConfig) LeaseLockWarmStandby() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // LinkContractAddress provides a mock function with given fields:
 func (_m *ChainScopedConfig) LinkContractAddress() string {
 	ret := _m.Called()
@@ -2047,6 +2075,34 @@ func (_m *ChainScopedConfig) NodePollInterval() time.Duration {
 	return r0
 }
 
+// NodeQuorumNodes provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeQuorumNodes() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// NodeQuorumThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) NodeQuorumThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // NodeSelectionMode provides a mock function with given fields:
 func (_m *ChainScopedConfig) NodeSelectionMode() string {
 	ret := _m.Called()
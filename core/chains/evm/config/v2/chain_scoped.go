@@ -223,6 +223,10 @@ func (c *ChainScoped) EvmLogBackfillBatchSize() uint32 {
 	return *c.cfg.LogBackfillBatchSize
 }
 
+func (c *ChainScoped) EvmLogKeepBlocksDepth() uint32 {
+	return *c.cfg.LogKeepBlocksDepth
+}
+
 func (c *ChainScoped) EvmLogPollInterval() time.Duration {
 	return c.cfg.LogPollInterval.Duration()
 }
@@ -305,6 +309,14 @@ func (c *ChainScoped) NodeSelectionMode() string {
 	return *c.cfg.NodePool.SelectionMode
 }
 
+func (c *ChainScoped) NodeQuorumNodes() uint32 {
+	return *c.cfg.NodePool.QuorumNodes
+}
+
+func (c *ChainScoped) NodeQuorumThreshold() uint32 {
+	return *c.cfg.NodePool.QuorumThreshold
+}
+
 func (c *ChainScoped) OCRContractConfirmations() uint16 {
 	return *c.cfg.OCR.ContractConfirmations
 }
@@ -230,6 +230,7 @@ type Chain struct {
 	FlagsContractAddress     *ethkey.EIP55Address
 	LinkContractAddress      *ethkey.EIP55Address
 	LogBackfillBatchSize     *uint32
+	LogKeepBlocksDepth       *uint32
 	LogPollInterval          *models.Duration
 	MinIncomingConfirmations *uint32
 	MinContractPayment       *assets.Link
@@ -268,8 +269,8 @@ func (c Chain) ValidateConfig() (err error) {
 		case config.ChainOptimism, config.ChainMetis:
 			gasEst := *c.GasEstimator.Mode
 			switch gasEst {
-			case "Optimism2", "L2Suggested":
-				// valid
+			case "Optimism2", "L2Suggested", "OptimismL1Fee":
+				// valid, OptimismL1Fee is checked below
 			case "Optimism":
 				err = multierr.Append(err, v2.ErrInvalid{Name: "GasEstimator.Mode", Value: gasEst,
 					Msg: "unsupported since OVM 1.0 was discontinued - use L2Suggested"})
@@ -279,6 +280,10 @@ func (c Chain) ValidateConfig() (err error) {
 			}
 		case config.ChainArbitrum, config.ChainXDai:
 		}
+		if *c.GasEstimator.Mode == "OptimismL1Fee" && chainType != config.ChainOptimism {
+			err = multierr.Append(err, v2.ErrInvalid{Name: "GasEstimator.Mode", Value: *c.GasEstimator.Mode,
+				Msg: fmt.Sprintf("only allowed with ChainType %q", config.ChainOptimism)})
+		}
 	}
 
 	if uint32(*c.GasEstimator.BumpTxDepth) > *c.Transactions.MaxInFlight {
@@ -297,6 +302,11 @@ func (c Chain) ValidateConfig() (err error) {
 		err = multierr.Append(err, v2.ErrInvalid{Name: "MinIncomingConfirmations", Value: *c.MinIncomingConfirmations,
 			Msg: "must be greater than or equal to 1"})
 	}
+	// Quorum reads are only enabled with more than one node
+	if *c.NodePool.QuorumNodes > 1 && *c.NodePool.QuorumThreshold > *c.NodePool.QuorumNodes {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "NodePool.QuorumThreshold", Value: *c.NodePool.QuorumThreshold,
+			Msg: "must be less than or equal to NodePool.QuorumNodes"})
+	}
 	return
 }
 
@@ -471,9 +481,9 @@ func (e *GasEstimator) ValidateConfig() (err error) {
 		err = multierr.Append(err, v2.ErrInvalid{Name: "PriceMax", Value: e.PriceMin,
 			Msg: "must be greater than or equal to PriceDefault"})
 	}
-	if *e.Mode == "BlockHistory" && *e.BlockHistory.BlockHistorySize <= 0 {
+	if (*e.Mode == "BlockHistory" || *e.Mode == "FeeHistory") && *e.BlockHistory.BlockHistorySize <= 0 {
 		err = multierr.Append(err, v2.ErrInvalid{Name: "BlockHistory.BlockHistorySize", Value: *e.BlockHistory.BlockHistorySize,
-			Msg: "must be greater than or equal to 1 with BlockHistory Mode"})
+			Msg: fmt.Sprintf("must be greater than or equal to 1 with %s Mode", *e.Mode)})
 	}
 
 	return
@@ -636,6 +646,8 @@ type NodePool struct {
 	PollFailureThreshold *uint32
 	PollInterval         *models.Duration
 	SelectionMode        *string
+	QuorumNodes          *uint32
+	QuorumThreshold      *uint32
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -648,6 +660,12 @@ func (p *NodePool) setFrom(f *NodePool) {
 	if v := f.SelectionMode; v != nil {
 		p.SelectionMode = v
 	}
+	if v := f.QuorumNodes; v != nil {
+		p.QuorumNodes = v
+	}
+	if v := f.QuorumThreshold; v != nil {
+		p.QuorumThreshold = v
+	}
 }
 
 type OCR struct {
@@ -938,6 +956,7 @@ type Node struct {
 	WSURL    *models.URL
 	HTTPURL  *models.URL
 	SendOnly *bool
+	Order    *int32
 }
 
 func (n *Node) ValidateConfig() (err error) {
@@ -979,6 +998,10 @@ func (n *Node) ValidateConfig() (err error) {
 		}
 	}
 
+	if n.Order != nil && (*n.Order < 1 || *n.Order > 100) {
+		err = multierr.Append(err, v2.ErrInvalid{Name: "Order", Value: *n.Order, Msg: "must be between 1 and 100"})
+	}
+
 	return
 }
 
@@ -115,6 +115,9 @@ func (c *Chain) SetFrom(f *Chain) {
 	if v := f.LogBackfillBatchSize; v != nil {
 		c.LogBackfillBatchSize = v
 	}
+	if v := f.LogKeepBlocksDepth; v != nil {
+		c.LogKeepBlocksDepth = v
+	}
 	if v := f.LogPollInterval; v != nil {
 		c.LogPollInterval = v
 	}
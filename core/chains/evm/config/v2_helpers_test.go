@@ -33,6 +33,7 @@ func (set chainSpecificConfigDefaultSet) asV2() v2.Chain {
 		FlagsContractAddress:     asEIP155Address(set.flagsContractAddress),
 		LinkContractAddress:      asEIP155Address(set.linkContractAddress),
 		LogBackfillBatchSize:     ptr(set.logBackfillBatchSize),
+		LogKeepBlocksDepth:       ptr(set.logKeepBlocksDepth),
 		LogPollInterval:          models.MustNewDuration(set.logPollInterval),
 		MinIncomingConfirmations: ptr(set.minIncomingConfirmations),
 		MinContractPayment:       set.minimumContractPayment,
@@ -92,6 +93,8 @@ func (set chainSpecificConfigDefaultSet) asV2() v2.Chain {
 			PollFailureThreshold: ptr(set.nodePollFailureThreshold),
 			PollInterval:         models.MustNewDuration(set.nodePollInterval),
 			SelectionMode:        ptr(set.nodeSelectionMode),
+			QuorumNodes:          ptr(set.nodeQuorumNodes),
+			QuorumThreshold:      ptr(set.nodeQuorumThreshold),
 		},
 		OCR: &v2.OCR{
 			ContractConfirmations:              ptr(set.ocrContractConfirmations),
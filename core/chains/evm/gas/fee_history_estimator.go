@@ -0,0 +1,266 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var _ Estimator = &FeeHistoryEstimator{}
+
+// FeeHistory is the result of an eth_feeHistory call
+type FeeHistory struct {
+	OldestBlock   *hexutil.Big     `json:"oldestBlock"`
+	BaseFeePerGas []*hexutil.Big   `json:"baseFeePerGas"`
+	GasUsedRatio  []float64        `json:"gasUsedRatio"`
+	Reward        [][]*hexutil.Big `json:"reward"`
+}
+
+// FeeHistoryEstimator is an Estimator which uses eth_feeHistory to estimate
+// gas prices, instead of downloading full blocks like the
+// BlockHistoryEstimator.
+//
+// On every new head it requests the last BlockHistorySize blocks' priority fee
+// reward at TransactionPercentile, and uses the median of those per block
+// rewards as tip cap. The percentile is only applied within each block, so
+// that a few expensive blocks don't move the estimate. eth_feeHistory also
+// returns the base fee of the next block, which is used as base for the fee
+// cap and the legacy gas price.
+type FeeHistoryEstimator struct {
+	utils.StartStopOnce
+	client rpcClient
+	config Config
+	mb     *utils.Mailbox[*evmtypes.Head]
+	wg     sync.WaitGroup
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu       sync.RWMutex
+	gasPrice *big.Int
+	tipCap   *big.Int
+	baseFee  *big.Int
+
+	logger logger.SugaredLogger
+}
+
+// NewFeeHistoryEstimator returns a new FeeHistoryEstimator
+func NewFeeHistoryEstimator(lggr logger.Logger, client rpcClient, cfg Config) *FeeHistoryEstimator {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &FeeHistoryEstimator{
+		client: client,
+		config: cfg,
+		mb:     utils.NewMailbox[*evmtypes.Head](1),
+		ctx:    ctx,
+		cancel: cancel,
+		logger: logger.Sugared(lggr.Named("FeeHistoryEstimator")),
+	}
+}
+
+func (f *FeeHistoryEstimator) Start(ctx context.Context) error {
+	return f.StartOnce("FeeHistoryEstimator", func() error {
+		fetchCtx, cancel := context.WithTimeout(ctx, MaxStartTime)
+		defer cancel()
+		if err := f.FetchFeeHistoryAndRecalculate(fetchCtx); err != nil {
+			f.logger.Warnw("Initial fee history fetch failed", "err", err)
+		}
+
+		// NOTE: This only checks the start context, not the fetch context
+		if ctx.Err() != nil {
+			return errors.Wrap(ctx.Err(), "failed to start FeeHistoryEstimator due to main context error")
+		}
+
+		f.wg.Add(1)
+		go f.runLoop()
+		return nil
+	})
+}
+
+func (f *FeeHistoryEstimator) Close() error {
+	return f.StopOnce("FeeHistoryEstimator", func() error {
+		f.cancel()
+		f.wg.Wait()
+		return nil
+	})
+}
+
+// OnNewLongestChain triggers a fee history fetch, unless one is in progress
+func (f *FeeHistoryEstimator) OnNewLongestChain(_ context.Context, head *evmtypes.Head) {
+	f.mb.Deliver(head)
+}
+
+func (f *FeeHistoryEstimator) runLoop() {
+	defer f.wg.Done()
+	for {
+		select {
+		case <-f.ctx.Done():
+			return
+		case <-f.mb.Notify():
+			if _, exists := f.mb.Retrieve(); !exists {
+				continue
+			}
+			ctx, cancel := evmclient.ContextWithDefaultTimeoutFromChan(f.ctx.Done())
+			if err := f.FetchFeeHistoryAndRecalculate(ctx); err != nil {
+				f.logger.Warnw("Error fetching fee history", "err", err)
+			}
+			cancel()
+		}
+	}
+}
+
+// FetchFeeHistoryAndRecalculate calls eth_feeHistory and recalculates gas prices
+func (f *FeeHistoryEstimator) FetchFeeHistoryAndRecalculate(ctx context.Context) error {
+	blockCount := f.config.BlockHistoryEstimatorBlockHistorySize()
+	percentile := int(f.config.BlockHistoryEstimatorTransactionPercentile())
+
+	var history FeeHistory
+	err := f.client.CallContext(ctx, &history, "eth_feeHistory", hexutil.Uint64(blockCount), "latest", []float64{float64(percentile)})
+	if err != nil {
+		return errors.Wrap(err, "eth_feeHistory failed")
+	}
+	return f.Recalculate(history)
+}
+
+// Recalculate sets gas price, tip cap and base fee from history
+func (f *FeeHistoryEstimator) Recalculate(history FeeHistory) error {
+	if len(history.BaseFeePerGas) == 0 {
+		return errors.New("eth_feeHistory returned no base fees")
+	}
+	// the last base fee is the one of the next block
+	baseFee := history.BaseFeePerGas[len(history.BaseFeePerGas)-1].ToInt()
+
+	var rewards []*big.Int
+	for _, r := range history.Reward {
+		if len(r) > 0 && r[0] != nil {
+			rewards = append(rewards, r[0].ToInt())
+		}
+	}
+	if len(rewards) == 0 {
+		return errors.New("eth_feeHistory returned no rewards")
+	}
+	// the rewards are already at TransactionPercentile, take the (lower) median
+	// across blocks
+	sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+	tipCap := rewards[(len(rewards)-1)/2]
+
+	f.logger.Debugw("Recalculated from fee history", "oldestBlock", history.OldestBlock, "baseFee", baseFee, "tipCap", tipCap)
+
+	f.setBaseFee(baseFee)
+	f.setTipCap(tipCap)
+	f.setGasPrice(new(big.Int).Add(baseFee, tipCap))
+	return nil
+}
+
+func (f *FeeHistoryEstimator) setBaseFee(baseFee *big.Int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.baseFee = baseFee
+}
+
+func (f *FeeHistoryEstimator) setTipCap(tipCap *big.Int) {
+	min := f.config.EvmGasTipCapMinimum()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if tipCap.Cmp(min) < 0 {
+		f.logger.Warnw(fmt.Sprintf("Calculated gas tip cap of %s Wei falls below EVM_GAS_TIP_CAP_MINIMUM=%[2]s, setting gas tip cap to the minimum allowed value of %[2]s Wei instead", tipCap.String(), min.String()), "tipCapWei", tipCap, "minTipCapWei", min)
+		f.tipCap = min
+	} else {
+		f.tipCap = tipCap
+	}
+}
+
+func (f *FeeHistoryEstimator) setGasPrice(gasPrice *big.Int) {
+	max := f.config.EvmMaxGasPriceWei()
+	min := f.config.EvmMinGasPriceWei()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if gasPrice.Cmp(max) > 0 {
+		f.logger.Warnw(fmt.Sprintf("Calculated gas price of %s Wei exceeds ETH_MAX_GAS_PRICE_WEI=%[2]s, setting gas price to the maximum allowed value of %[2]s Wei instead", gasPrice.String(), max.String()), "gasPriceWei", gasPrice, "maxGasPriceWei", max)
+		f.gasPrice = max
+	} else if gasPrice.Cmp(min) < 0 {
+		f.logger.Warnw(fmt.Sprintf("Calculated gas price of %s Wei falls below ETH_MIN_GAS_PRICE_WEI=%[2]s, setting gas price to the minimum allowed value of %[2]s Wei instead", gasPrice.String(), min.String()), "gasPriceWei", gasPrice, "minGasPriceWei", min)
+		f.gasPrice = min
+	} else {
+		f.gasPrice = gasPrice
+	}
+}
+
+func (f *FeeHistoryEstimator) getGasPrice() *big.Int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.gasPrice
+}
+
+func (f *FeeHistoryEstimator) getTipCap() *big.Int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.tipCap
+}
+
+func (f *FeeHistoryEstimator) getBaseFee() *big.Int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.baseFee
+}
+
+func (f *FeeHistoryEstimator) GetLegacyGas(_ []byte, gasLimit uint32, maxGasPriceWei *big.Int, _ ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint32, err error) {
+	ok := f.IfStarted(func() {
+		chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		gasPrice = f.getGasPrice()
+	})
+	if !ok {
+		return nil, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	if gasPrice == nil {
+		return nil, 0, errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	}
+	gasPrice = capGasPrice(gasPrice, maxGasPriceWei, f.config)
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpLegacyGas(originalGasPrice *big.Int, gasLimit uint32, maxGasPriceWei *big.Int) (bumpedGasPrice *big.Int, chainSpecificGasLimit uint32, err error) {
+	return BumpLegacyGasPriceOnly(f.config, f.logger, f.getGasPrice(), originalGasPrice, gasLimit, maxGasPriceWei)
+}
+
+func (f *FeeHistoryEstimator) GetDynamicFee(gasLimit uint32, maxGasPriceWei *big.Int) (fee DynamicFee, chainSpecificGasLimit uint32, err error) {
+	if !f.config.EvmEIP1559DynamicFees() {
+		return fee, 0, errors.New("Can't get dynamic fee, EIP1559 is disabled")
+	}
+
+	ok := f.IfStarted(func() {
+		chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		tipCap, baseFee := f.getTipCap(), f.getBaseFee()
+		if tipCap == nil || baseFee == nil {
+			err = errors.New("FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+			return
+		}
+		maxGasPrice := getMaxGasPrice(maxGasPriceWei, f.config)
+		fee.TipCap = tipCap
+		if f.config.EvmGasBumpThreshold() == 0 {
+			// just use the max gas price if gas bumping is disabled
+			fee.FeeCap = maxGasPrice
+		} else {
+			// leave headroom for bumping, see BlockHistoryEstimator.GetDynamicFee
+			fee.FeeCap = calcFeeCap(baseFee, f.config, tipCap, maxGasPrice)
+		}
+	})
+	if !ok {
+		return fee, 0, errors.New("FeeHistoryEstimator is not started; cannot estimate gas")
+	}
+	return
+}
+
+func (f *FeeHistoryEstimator) BumpDynamicFee(originalFee DynamicFee, originalGasLimit uint32, maxGasPriceWei *big.Int) (bumped DynamicFee, chainSpecificGasLimit uint32, err error) {
+	return BumpDynamicFeeOnly(f.config, f.logger, f.getTipCap(), f.getBaseFee(), originalFee, originalGasLimit, maxGasPriceWei)
+}
@@ -0,0 +1,158 @@
+package gas_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func newFeeHistory(baseFees []int64, rewards []int64) gas.FeeHistory {
+	h := gas.FeeHistory{OldestBlock: (*hexutil.Big)(big.NewInt(100))}
+	for _, b := range baseFees {
+		h.BaseFeePerGas = append(h.BaseFeePerGas, (*hexutil.Big)(big.NewInt(b)))
+	}
+	for _, r := range rewards {
+		h.Reward = append(h.Reward, []*hexutil.Big{(*hexutil.Big)(big.NewInt(r))})
+		h.GasUsedRatio = append(h.GasUsedRatio, 0.5)
+	}
+	return h
+}
+
+func newFeeHistoryConfig(t *testing.T, eip1559 bool) *mocks.Config {
+	return newFeeHistoryConfigWithPercentile(t, eip1559, 50)
+}
+
+func newFeeHistoryConfigWithPercentile(t *testing.T, eip1559 bool, percentile uint16) *mocks.Config {
+	cfg := mocks.NewConfig(t)
+	cfg.On("BlockHistoryEstimatorBlockHistorySize").Return(uint16(4)).Maybe()
+	cfg.On("BlockHistoryEstimatorTransactionPercentile").Return(percentile).Maybe()
+	cfg.On("BlockHistoryEstimatorEIP1559FeeCapBufferBlocks").Return(uint16(0)).Maybe()
+	cfg.On("EvmEIP1559DynamicFees").Return(eip1559).Maybe()
+	cfg.On("EvmGasBumpThreshold").Return(uint64(3)).Maybe()
+	cfg.On("EvmGasBumpPercent").Return(uint16(10)).Maybe()
+	cfg.On("EvmGasBumpWei").Return(big.NewInt(1)).Maybe()
+	cfg.On("EvmGasLimitMultiplier").Return(float32(1)).Maybe()
+	cfg.On("EvmGasTipCapDefault").Return(big.NewInt(1)).Maybe()
+	cfg.On("EvmGasTipCapMinimum").Return(big.NewInt(1)).Maybe()
+	cfg.On("EvmMaxGasPriceWei").Return(assets.GWei(1000)).Maybe()
+	cfg.On("EvmMinGasPriceWei").Return(big.NewInt(1)).Maybe()
+	return cfg
+}
+
+func TestFeeHistoryEstimator(t *testing.T) {
+	t.Parallel()
+
+	maxGasPrice := assets.GWei(1000)
+	const gasLimit uint32 = 80000
+
+	t.Run("calling GetLegacyGas on unstarted estimator returns error", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig(t, false))
+		_, _, err := f.GetLegacyGas(nil, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "FeeHistoryEstimator is not started; cannot estimate gas")
+	})
+
+	t.Run("requests reward percentile and uses it with the next base fee", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", hexutil.Uint64(4), "latest", []float64{50}).Return(nil).Run(func(args mock.Arguments) {
+			res := args.Get(1).(*gas.FeeHistory)
+			*res = newFeeHistory([]int64{90, 95, 100, 105, 110}, []int64{40, 10, 30, 20})
+		}).Once()
+
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig(t, true))
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		// median of the per block rewards [10, 20, 30, 40] is 20, next base fee is 110
+		gasPrice, chainSpecificGasLimit, err := f.GetLegacyGas(nil, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(130), gasPrice)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+
+		fee, chainSpecificGasLimit, err := f.GetDynamicFee(gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(20), fee.TipCap)
+		assert.Equal(t, big.NewInt(130), fee.FeeCap)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+	})
+
+	t.Run("bumps like the other estimators", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			res := args.Get(1).(*gas.FeeHistory)
+			*res = newFeeHistory([]int64{100, 100}, []int64{200})
+		}).Once()
+
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig(t, true))
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		// current gas price of 300 is higher than the original price bumped by 10%
+		bumped, _, err := f.BumpLegacyGas(big.NewInt(100), gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(300), bumped)
+
+		bumpedFee, _, err := f.BumpDynamicFee(gas.DynamicFee{TipCap: big.NewInt(1000), FeeCap: big.NewInt(2000)}, gasLimit, maxGasPrice)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1100), bumpedFee.TipCap)
+		assert.Equal(t, big.NewInt(2200), bumpedFee.FeeCap)
+	})
+
+	t.Run("starts without estimates if eth_feeHistory fails", func(t *testing.T) {
+		client := mocks.NewRPCClient(t)
+		client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("kaboom")).Once()
+
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfig(t, true))
+		require.NoError(t, f.Start(testutils.Context(t)))
+		t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+		_, _, err := f.GetLegacyGas(nil, gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+		_, _, err = f.GetDynamicFee(gasLimit, maxGasPrice)
+		assert.EqualError(t, err, "FeeHistoryEstimator has not finished the first gas estimation yet, likely because a failure on start")
+	})
+}
+
+func TestFeeHistoryEstimator_Recalculate(t *testing.T) {
+	t.Parallel()
+
+	f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), mocks.NewRPCClient(t), newFeeHistoryConfig(t, true))
+
+	assert.EqualError(t, f.Recalculate(gas.FeeHistory{}), "eth_feeHistory returned no base fees")
+	assert.EqualError(t, f.Recalculate(newFeeHistory([]int64{1}, nil)), "eth_feeHistory returned no rewards")
+	assert.NoError(t, f.Recalculate(newFeeHistory([]int64{1, 2}, []int64{3})))
+}
+
+func TestFeeHistoryEstimator_TipCapIsMedianOfPercentileRewards(t *testing.T) {
+	t.Parallel()
+
+	const gasLimit uint32 = 80000
+	maxGasPrice := assets.GWei(1000)
+
+	client := mocks.NewRPCClient(t)
+	client.On("CallContext", mock.Anything, mock.Anything, "eth_feeHistory", hexutil.Uint64(4), "latest", []float64{90}).Return(nil).Run(func(args mock.Arguments) {
+		res := args.Get(1).(*gas.FeeHistory)
+		*res = newFeeHistory([]int64{100, 100, 100, 100, 100, 100}, []int64{1000, 10, 30, 20, 40})
+	}).Once()
+
+	f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), client, newFeeHistoryConfigWithPercentile(t, true, 90))
+	require.NoError(t, f.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+	// each reward is already the 90th percentile of its block, so the tip cap
+	// is the median 30 and not the 90th percentile 40 of the rewards
+	fee, _, err := f.GetDynamicFee(gasLimit, maxGasPrice)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(30), fee.TipCap)
+}
@@ -0,0 +1,53 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	big "math/big"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// L1FeeEstimator is an autogenerated mock type for the L1FeeEstimator type
+type L1FeeEstimator struct {
+	mock.Mock
+}
+
+// GetL1Fee provides a mock function with given fields: ctx, calldata
+func (_m *L1FeeEstimator) GetL1Fee(ctx context.Context, calldata []byte) (*big.Int, error) {
+	ret := _m.Called(ctx, calldata)
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *big.Int); ok {
+		r0 = rf(ctx, calldata)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, calldata)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewL1FeeEstimator interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewL1FeeEstimator creates a new instance of L1FeeEstimator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewL1FeeEstimator(t mockConstructorTestingTNewL1FeeEstimator) *L1FeeEstimator {
+	mock := &L1FeeEstimator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
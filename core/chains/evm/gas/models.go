@@ -58,10 +58,14 @@ func NewEstimator(lggr logger.Logger, ethClient evmclient.Client, cfg Config) Es
 		return NewArbitrumEstimator(lggr, cfg, ethClient, ethClient)
 	case "BlockHistory":
 		return NewBlockHistoryEstimator(lggr, ethClient, cfg, *ethClient.ChainID())
+	case "FeeHistory":
+		return NewFeeHistoryEstimator(lggr, ethClient, cfg)
 	case "FixedPrice":
 		return NewFixedPriceEstimator(cfg, lggr)
 	case "Optimism2", "L2Suggested":
 		return NewL2SuggestedPriceEstimator(lggr, ethClient)
+	case "OptimismL1Fee":
+		return NewOptimismEstimator(lggr, ethClient, ethClient)
 	default:
 		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
 		return NewFixedPriceEstimator(cfg, lggr)
@@ -95,6 +99,25 @@ type Estimator interface {
 	BumpDynamicFee(original DynamicFee, gasLimit uint32, maxGasPriceWei *big.Int) (bumped DynamicFee, chainSpecificGasLimit uint32, err error)
 }
 
+// L1FeeEstimator is implemented by Estimators of L2 chains, which charge a fee
+// for posting the transaction data to L1 on top of the L2 execution fee
+//
+//go:generate mockery --name L1FeeEstimator --output ./mocks/ --case=underscore
+type L1FeeEstimator interface {
+	// GetL1Fee returns the L1 data fee in wei of a transaction with calldata
+	GetL1Fee(ctx context.Context, calldata []byte) (*big.Int, error)
+}
+
+// TotalFee returns the total expected fee in wei of a transaction, i.e. the L2
+// execution fee gasPrice * gasLimit plus the l1Fee, which may be nil
+func TotalFee(gasPrice *big.Int, gasLimit uint32, l1Fee *big.Int) *big.Int {
+	total := new(big.Int).Mul(gasPrice, big.NewInt(int64(gasLimit)))
+	if l1Fee != nil {
+		total.Add(total, l1Fee)
+	}
+	return total
+}
+
 // Opt is an option for a gas estimator
 type Opt int
 
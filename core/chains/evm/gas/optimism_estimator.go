@@ -0,0 +1,88 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+var (
+	_ Estimator      = &optimismEstimator{}
+	_ L1FeeEstimator = &optimismEstimator{}
+)
+
+const (
+	// OPGasPriceOracleAddress is the address of the GasPriceOracle predeploy
+	// on OP stack (Bedrock) chains.
+	// https://github.com/ethereum-optimism/optimism/blob/develop/packages/contracts-bedrock/contracts/L2/GasPriceOracle.sol
+	OPGasPriceOracleAddress = "0x420000000000000000000000000000000000000F"
+	// OPGasPriceOracle_getL1Fee is the hex encoded selector of:
+	// `function getL1Fee(bytes memory _data) external view returns (uint256);`
+	OPGasPriceOracle_getL1Fee = "49948e0e"
+)
+
+var bytesArgs = abi.Arguments{{Type: mustNewABIType("bytes")}}
+
+func mustNewABIType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// optimismEstimator is an Estimator which extends l2SuggestedPriceEstimator
+// with the L1 data fee of OP stack chains. On these chains, the L2 execution
+// fee is usually tiny compared to the fee charged for posting the transaction
+// data to L1, which is calculated by the GasPriceOracle predeploy.
+type optimismEstimator struct {
+	Estimator // *l2SuggestedPriceEstimator
+
+	client ethClient
+	logger logger.Logger
+}
+
+// NewOptimismEstimator returns a new Estimator which uses the L2 suggested gas
+// price and implements L1FeeEstimator.
+func NewOptimismEstimator(lggr logger.Logger, rpcClient rpcClient, ethClient ethClient) Estimator {
+	lggr = lggr.Named("OptimismEstimator")
+	return &optimismEstimator{
+		Estimator: NewL2SuggestedPriceEstimator(lggr, rpcClient),
+		client:    ethClient,
+		logger:    lggr,
+	}
+}
+
+// GetL1Fee calls GasPriceOracle.getL1Fee(calldata) on the predeploy at
+// OPGasPriceOracleAddress.
+//
+// The oracle expects the full RLP encoded transaction, and already accounts
+// for a signature. Passing only the calldata slightly underestimates the fee,
+// by the few bytes of the remaining transaction fields.
+func (o *optimismEstimator) GetL1Fee(ctx context.Context, calldata []byte) (*big.Int, error) {
+	args, err := bytesArgs.Pack(calldata)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode getL1Fee call")
+	}
+	oracle := common.HexToAddress(OPGasPriceOracleAddress)
+	b, err := o.client.CallContract(ctx, ethereum.CallMsg{
+		To:   &oracle,
+		Data: append(common.Hex2Bytes(OPGasPriceOracle_getL1Fee), args...),
+	}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "GasPriceOracle.getL1Fee failed")
+	}
+	if len(b) != 32 { // returns (uint256);
+		return nil, fmt.Errorf("return data length (%d) different than expected (%d)", len(b), 32)
+	}
+	l1Fee := new(big.Int).SetBytes(b)
+	o.logger.Debugw("GetL1Fee", "calldataLen", len(calldata), "l1Fee", l1Fee)
+	return l1Fee, nil
+}
@@ -0,0 +1,70 @@
+package gas_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestOptimismEstimator_GetL1Fee(t *testing.T) {
+	t.Parallel()
+
+	calldata := []byte{0x01, 0x02, 0x03}
+
+	t.Run("calls getL1Fee on the GasPriceOracle", func(t *testing.T) {
+		rpcClient := mocks.NewRPCClient(t)
+		ethClient := mocks.NewETHClient(t)
+		ethClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(common.LeftPadBytes(big.NewInt(42).Bytes(), 32), nil).Run(func(args mock.Arguments) {
+			msg := args.Get(1).(ethereum.CallMsg)
+			assert.Equal(t, gas.OPGasPriceOracleAddress, msg.To.String())
+			assert.Equal(t, "49948e0e"+
+				"0000000000000000000000000000000000000000000000000000000000000020"+
+				"0000000000000000000000000000000000000000000000000000000000000003"+
+				"0102030000000000000000000000000000000000000000000000000000000000", common.Bytes2Hex(msg.Data))
+		})
+
+		o := gas.NewOptimismEstimator(logger.TestLogger(t), rpcClient, ethClient)
+		l1FeeEstimator, ok := o.(gas.L1FeeEstimator)
+		require.True(t, ok)
+
+		l1Fee, err := l1FeeEstimator.GetL1Fee(testutils.Context(t), calldata)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), l1Fee)
+	})
+
+	t.Run("returns error on unexpected return data", func(t *testing.T) {
+		ethClient := mocks.NewETHClient(t)
+		ethClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return([]byte{0x01}, nil)
+
+		o := gas.NewOptimismEstimator(logger.TestLogger(t), mocks.NewRPCClient(t), ethClient).(gas.L1FeeEstimator)
+		_, err := o.GetL1Fee(testutils.Context(t), calldata)
+		assert.EqualError(t, err, "return data length (1) different than expected (32)")
+	})
+
+	t.Run("returns error if the call fails", func(t *testing.T) {
+		ethClient := mocks.NewETHClient(t)
+		ethClient.On("CallContract", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("kaboom"))
+
+		o := gas.NewOptimismEstimator(logger.TestLogger(t), mocks.NewRPCClient(t), ethClient).(gas.L1FeeEstimator)
+		_, err := o.GetL1Fee(testutils.Context(t), calldata)
+		assert.EqualError(t, err, "GasPriceOracle.getL1Fee failed: kaboom")
+	})
+}
+
+func TestTotalFee(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, big.NewInt(2000), gas.TotalFee(big.NewInt(10), 200, nil))
+	assert.Equal(t, big.NewInt(2500), gas.TotalFee(big.NewInt(10), 200, big.NewInt(500)))
+}
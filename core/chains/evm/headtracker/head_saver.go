@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/atomic"
 
 	httypes "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/types"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
@@ -15,6 +16,8 @@ type headSaver struct {
 	config Config
 	logger logger.Logger
 	heads  Heads
+	// readOnly is set while heads must not be persisted
+	readOnly atomic.Bool
 }
 
 func NewHeadSaver(lggr logger.Logger, orm ORM, config Config) httypes.HeadSaver {
@@ -27,11 +30,16 @@ func NewHeadSaver(lggr logger.Logger, orm ORM, config Config) httypes.HeadSaver
 }
 
 func (hs *headSaver) Save(ctx context.Context, head *evmtypes.Head) error {
+	historyDepth := uint(hs.config.EvmHeadTrackerHistoryDepth())
+	if hs.readOnly.Load() {
+		hs.heads.AddHeads(historyDepth, head)
+		return nil
+	}
+
 	if err := hs.orm.IdempotentInsertHead(ctx, head); err != nil {
 		return err
 	}
 
-	historyDepth := uint(hs.config.EvmHeadTrackerHistoryDepth())
 	hs.heads.AddHeads(historyDepth, head)
 
 	return hs.orm.TrimOldHeads(ctx, historyDepth)
@@ -67,6 +75,10 @@ func (hs *headSaver) Chain(hash common.Hash) *evmtypes.Head {
 	return hs.heads.HeadByHash(hash)
 }
 
+func (hs *headSaver) SetReadOnly(readOnly bool) {
+	hs.readOnly.Store(readOnly)
+}
+
 var NullSaver httypes.HeadSaver = &nullSaver{}
 
 type nullSaver struct{}
@@ -76,3 +88,4 @@ func (*nullSaver) LoadFromDB(ctx context.Context) (*evmtypes.Head, error)
 func (*nullSaver) LatestHeadFromDB(ctx context.Context) (*evmtypes.Head, error) { return nil, nil }
 func (*nullSaver) LatestChain() *evmtypes.Head                                  { return nil }
 func (*nullSaver) Chain(hash common.Hash) *evmtypes.Head                        { return nil }
+func (*nullSaver) SetReadOnly(readOnly bool)                                    {}
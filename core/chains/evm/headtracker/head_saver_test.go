@@ -48,6 +48,31 @@ func TestHeadSaver_Save(t *testing.T) {
 	require.Equal(t, int64(1), latest.Number)
 }
 
+func TestHeadSaver_Save_ReadOnly(t *testing.T) {
+	t.Parallel()
+
+	saver, _ := configureSaver(t)
+	saver.SetReadOnly(true)
+
+	head := cltest.Head(1)
+	require.NoError(t, saver.Save(testutils.Context(t), head))
+
+	latest, err := saver.LatestHeadFromDB(testutils.Context(t))
+	require.NoError(t, err)
+	require.Nil(t, latest)
+
+	latest = saver.LatestChain()
+	require.NotNil(t, latest)
+	require.Equal(t, int64(1), latest.Number)
+
+	saver.SetReadOnly(false)
+	require.NoError(t, saver.Save(testutils.Context(t), cltest.Head(2)))
+
+	latest, err = saver.LatestHeadFromDB(testutils.Context(t))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), latest.Number)
+}
+
 func TestHeadSaver_LoadFromDB(t *testing.T) {
 	t.Parallel()
 
@@ -22,6 +22,9 @@ type HeadSaver interface {
 	LatestChain() *evmtypes.Head
 	// Chain returns a head for the specified hash, or nil.
 	Chain(hash common.Hash) *evmtypes.Head
+	// SetReadOnly toggles read-only mode, in which saved heads are only kept
+	// in memory and not persisted. Used while the node is a warm standby.
+	SetReadOnly(readOnly bool)
 }
 
 // HeadTracker holds and stores the latest block number experienced by this particular node in a thread safe manner.
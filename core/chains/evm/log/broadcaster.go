@@ -10,6 +10,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/atomic"
 
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
@@ -61,6 +63,10 @@ type (
 		// MarkManyConsumed marks all the provided log broadcasts as consumed.
 		MarkManyConsumed(lbs []Broadcast, qopts ...pg.QOpt) error
 
+		// ReorgedBroadcasts returns the broadcasts in the block range which were consumed before their log was
+		// removed by a reorg.
+		ReorgedBroadcasts(fromBlock, toBlock int64) ([]ReorgedBroadcast, error)
+
 		// NOTE: WasAlreadyConsumed, MarkConsumed and MarkManyConsumed MUST be used within a single goroutine in order for WasAlreadyConsumed to be accurate
 	}
 
@@ -143,6 +149,10 @@ type (
 
 		// ReplayStartedCallback is called by the log broadcaster once a replay request is received.
 		ReplayStartedCallback func()
+
+		// ReorgedAfterConsumptionCallback is called by the log broadcaster with the removed log, if a log
+		// which the listener already consumed was removed by a reorg.
+		ReorgedAfterConsumptionCallback func(log types.Log)
 	}
 
 	ParseLogFunc func(log types.Log) (generated.AbigenLog, error)
@@ -162,6 +172,11 @@ const (
 
 var _ Broadcaster = (*broadcaster)(nil)
 
+var promConsumedLogsReorged = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "log_broadcaster_consumed_logs_reorged",
+	Help: "The number of log broadcasts which were removed by a reorg after they had been consumed",
+}, []string{"evmChainID"})
+
 // NewBroadcaster creates a new instance of the broadcaster
 func NewBroadcaster(orm ORM, ethClient evmclient.Client, config Config, lggr logger.Logger, highestSavedHead *evmtypes.Head) *broadcaster {
 	chStop := make(chan struct{})
@@ -510,6 +525,7 @@ func (b *broadcaster) onNewLog(log types.Log) {
 		// Remove the whole block that contained this log.
 		b.logger.Debugw("Found reverted log", "log", log)
 		b.logPool.removeBlock(log.BlockHash, log.BlockNumber)
+		b.onRemovedLog(log)
 		return
 	} else if !b.registrations.isAddressRegistered(log.Address) {
 		b.logger.Debugw("Found unregistered address", "address", log.Address)
@@ -526,6 +542,44 @@ func (b *broadcaster) onNewLog(log types.Log) {
 	}
 }
 
+// onRemovedLog records which listeners already consumed the removed log, and
+// notifies them. Only logs of registered addresses are considered, and the
+// work is done off the event loop.
+func (b *broadcaster) onRemovedLog(log types.Log) {
+	if !b.registrations.isAddressRegistered(log.Address) {
+		return
+	}
+	// registrations may only be read from the event loop, so collect the
+	// callbacks for the log's contract here.
+	callbacks := make(map[int32][]func(types.Log))
+	for sub := range b.registrations.registeredSubs {
+		if sub.opts.Contract == log.Address && sub.opts.ReorgedAfterConsumptionCallback != nil {
+			jobID := sub.listener.JobID()
+			callbacks[jobID] = append(callbacks[jobID], sub.opts.ReorgedAfterConsumptionCallback)
+		}
+	}
+
+	b.wgDone.Add(1)
+	go func() {
+		defer b.wgDone.Done()
+		ctx, cancel := utils.ContextFromChan(b.chStop)
+		defer cancel()
+		reorged, err := b.orm.MarkConsumedBroadcastsReorged(log.BlockHash, log.Index, pg.WithParentCtx(ctx))
+		if err != nil {
+			b.logger.Errorw("Failed to mark consumed broadcasts reorged", "blockHash", log.BlockHash, "logIndex", log.Index, "err", err)
+			return
+		}
+		for _, rb := range reorged {
+			promConsumedLogsReorged.WithLabelValues(b.evmChainID.String()).Inc()
+			b.logger.Warnw("Consumed log was removed by a reorg", "jobID", rb.JobID, "blockNumber", rb.BlockNumber,
+				"blockHash", rb.BlockHash, "logIndex", rb.LogIndex, "address", log.Address, "txHash", log.TxHash)
+			for _, callback := range callbacks[rb.JobID] {
+				callback(log)
+			}
+		}
+	}()
+}
+
 func (b *broadcaster) onNewHeads() {
 	var latestHead *evmtypes.Head
 	for {
@@ -676,6 +730,11 @@ func (b *broadcaster) MarkConsumed(lb Broadcast, qopts ...pg.QOpt) error {
 	return b.orm.MarkBroadcastConsumed(lb.RawLog().BlockHash, lb.RawLog().BlockNumber, lb.RawLog().Index, lb.JobID(), qopts...)
 }
 
+// ReorgedBroadcasts implements the Broadcaster interface.
+func (b *broadcaster) ReorgedBroadcasts(fromBlock, toBlock int64) ([]ReorgedBroadcast, error) {
+	return b.orm.FindReorgedBroadcasts(fromBlock, toBlock)
+}
+
 // MarkManyConsumed marks the logs as having been successfully consumed by the subscriber
 func (b *broadcaster) MarkManyConsumed(lbs []Broadcast, qopts ...pg.QOpt) (err error) {
 	var (
@@ -763,6 +822,9 @@ func (n *NullBroadcaster) MarkConsumed(lb Broadcast, qopts ...pg.QOpt) error {
 func (n *NullBroadcaster) MarkManyConsumed(lbs []Broadcast, qopts ...pg.QOpt) error {
 	return errors.New(n.ErrMsg)
 }
+func (n *NullBroadcaster) ReorgedBroadcasts(fromBlock, toBlock int64) ([]ReorgedBroadcast, error) {
+	return nil, errors.New(n.ErrMsg)
+}
 
 func (n *NullBroadcaster) AddDependents(int) {}
 func (n *NullBroadcaster) AwaitDependents() <-chan struct{} {
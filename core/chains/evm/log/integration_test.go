@@ -1530,6 +1530,77 @@ func TestBroadcaster_InjectsBroadcastRecordFunctions(t *testing.T) {
 	helper.requireBroadcastCount(2)
 }
 
+func TestBroadcaster_ReorgedAfterConsumption(t *testing.T) {
+	const blockHeight int64 = 0
+	helper := newBroadcasterHelper(t, blockHeight, 1)
+	helper.start()
+	defer helper.stop()
+
+	blocks := cltest.NewBlocks(t, 20)
+
+	logListener := helper.newLogListenerWithJob("logListener")
+	otherListener := helper.newLogListenerWithJob("otherListener")
+
+	contract := newMockContract()
+	log1 := blocks.LogOnBlockNum(1, contract.Address())
+	contract.On("ParseLog", log1).Return(flux_aggregator_wrapper.FluxAggregatorNewRound{}, nil)
+
+	chReorged := make(chan types.Log, 1)
+	topics := map[common.Hash][][]log.Topic{(flux_aggregator_wrapper.FluxAggregatorNewRound{}).Topic(): nil}
+	helper.toUnsubscribe = append(helper.toUnsubscribe, helper.lb.Register(logListener, log.ListenerOpts{
+		Contract:                        contract.Address(),
+		ParseLog:                        contract.ParseLog,
+		LogsWithTopics:                  topics,
+		MinIncomingConfirmations:        1,
+		ReorgedAfterConsumptionCallback: func(l types.Log) { chReorged <- l },
+	}))
+	helper.registerWithTopicValues(otherListener, contract, 1, topics)
+
+	headsDone := cltest.SimulateIncomingHeads(t, cltest.SimulateIncomingHeadsArgs{
+		StartBlock:     1,
+		EndBlock:       3,
+		HeadTrackables: []httypes.HeadTrackable{(helper.lb).(httypes.HeadTrackable)},
+		Blocks:         blocks,
+	})
+
+	chRawLogs := <-helper.chchRawLogs
+	chRawLogs.TrySend(log1)
+
+	<-headsDone
+	require.Eventually(t, func() bool { return len(logListener.received.getUniqueLogs()) == 1 }, testutils.WaitTimeout(t), 100*time.Millisecond)
+	require.Eventually(t, func() bool { return len(otherListener.received.getUniqueLogs()) == 1 }, testutils.WaitTimeout(t), 100*time.Millisecond)
+
+	removed := blocks.LogOnBlockNumRemoved(1, contract.Address())
+	chRawLogs.TrySend(removed)
+
+	select {
+	case l := <-chReorged:
+		require.Equal(t, removed, l)
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for reorged log")
+	}
+
+	reorged, err := helper.lb.ReorgedBroadcasts(0, 10)
+	require.NoError(t, err)
+	require.Len(t, reorged, 2)
+	var jobIDs []int32
+	for _, rb := range reorged {
+		require.Equal(t, log1.BlockHash, rb.BlockHash)
+		require.Equal(t, int64(1), rb.BlockNumber)
+		require.Equal(t, log1.Index, rb.LogIndex)
+		jobIDs = append(jobIDs, rb.JobID)
+	}
+	require.ElementsMatch(t, []int32{logListener.JobID(), otherListener.JobID()}, jobIDs)
+
+	// Removing the log again is not reported twice
+	chRawLogs.TrySend(removed)
+	select {
+	case l := <-chReorged:
+		t.Fatalf("unexpected reorged log %v", l)
+	case <-time.After(time.Second):
+	}
+}
+
 func TestBroadcaster_ProcessesLogsFromReorgsAndMissedHead(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -163,6 +163,29 @@ func (_m *Broadcaster) Register(listener log.Listener, opts log.ListenerOpts) fu
 	return r0
 }
 
+// ReorgedBroadcasts provides a mock function with given fields: fromBlock, toBlock
+func (_m *Broadcaster) ReorgedBroadcasts(fromBlock int64, toBlock int64) ([]log.ReorgedBroadcast, error) {
+	ret := _m.Called(fromBlock, toBlock)
+
+	var r0 []log.ReorgedBroadcast
+	if rf, ok := ret.Get(0).(func(int64, int64) []log.ReorgedBroadcast); ok {
+		r0 = rf(fromBlock, toBlock)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]log.ReorgedBroadcast)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, int64) error); ok {
+		r1 = rf(fromBlock, toBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReplayFromBlock provides a mock function with given fields: number, forceBroadcast
 func (_m *Broadcaster) ReplayFromBlock(number int64, forceBroadcast bool) {
 	_m.Called(number, forceBroadcast)
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -35,6 +36,11 @@ type ORM interface {
 	// MarkBroadcastsUnconsumed marks all log broadcasts from all jobs on or after fromBlock as
 	// unconsumed.
 	MarkBroadcastsUnconsumed(fromBlock int64, qopts ...pg.QOpt) error
+	// MarkConsumedBroadcastsReorged records that the log was removed by a reorg, and returns the
+	// broadcasts of the log which had already been consumed.
+	MarkConsumedBroadcastsReorged(blockHash common.Hash, logIndex uint, qopts ...pg.QOpt) ([]ReorgedBroadcast, error)
+	// FindReorgedBroadcasts returns the consumed broadcasts which were later reorged, for a range of block numbers.
+	FindReorgedBroadcasts(fromBlockNum int64, toBlockNum int64, qopts ...pg.QOpt) ([]ReorgedBroadcast, error)
 
 	// SetPendingMinBlock sets the minimum block number for which there are pending broadcasts in the pool, or nil if empty.
 	SetPendingMinBlock(blockNum *int64, qopts ...pg.QOpt) error
@@ -163,6 +169,36 @@ func (o *orm) MarkBroadcastsUnconsumed(fromBlock int64, qopts ...pg.QOpt) error
 	return errors.Wrap(err, "failed to mark broadcasts unconsumed")
 }
 
+// MarkConsumedBroadcastsReorged implements the ORM interface.
+func (o *orm) MarkConsumedBroadcastsReorged(blockHash common.Hash, logIndex uint, qopts ...pg.QOpt) (reorged []ReorgedBroadcast, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&reorged, `
+        UPDATE log_broadcasts
+        SET reorged_at = NOW()
+        WHERE block_hash = $1
+        AND log_index = $2
+        AND evm_chain_id = $3
+        AND consumed = true
+        AND reorged_at IS NULL
+        RETURNING block_hash, COALESCE(block_number, 0) AS block_number, log_index, job_id, reorged_at
+        `, blockHash, logIndex, o.evmChainID)
+	return reorged, errors.Wrap(err, "failed to mark consumed broadcasts reorged")
+}
+
+// FindReorgedBroadcasts implements the ORM interface.
+func (o *orm) FindReorgedBroadcasts(fromBlockNum int64, toBlockNum int64, qopts ...pg.QOpt) (reorged []ReorgedBroadcast, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Select(&reorged, `
+        SELECT block_hash, block_number, log_index, job_id, reorged_at FROM log_broadcasts
+        WHERE block_number >= $1
+        AND block_number <= $2
+        AND evm_chain_id = $3
+        AND reorged_at IS NOT NULL
+        ORDER BY block_number, log_index, job_id
+        `, fromBlockNum, toBlockNum, o.evmChainID)
+	return reorged, errors.Wrap(err, "failed to find reorged broadcasts")
+}
+
 func (o *orm) Reinitialize(qopts ...pg.QOpt) (*int64, error) {
 	// Minimum block number from the set of unconsumed logs, which we'll remove later.
 	minUnconsumed, err := o.getUnconsumedMinBlock(qopts...)
@@ -260,6 +296,16 @@ func (b LogBroadcast) AsKey() LogBroadcastAsKey {
 	}
 }
 
+// ReorgedBroadcast is a log broadcast which was consumed, before its log was
+// removed by a reorg.
+type ReorgedBroadcast struct {
+	BlockHash   common.Hash
+	BlockNumber int64
+	LogIndex    uint
+	JobID       int32
+	ReorgedAt   time.Time
+}
+
 // LogBroadcastAsKey - used as key in a map to filter out already consumed logs
 type LogBroadcastAsKey struct {
 	BlockHash common.Hash
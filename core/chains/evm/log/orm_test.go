@@ -207,6 +207,54 @@ func TestORM_MarkUnconsumed(t *testing.T) {
 	require.False(t, consumed)
 }
 
+func TestORM_MarkConsumedBroadcastsReorged(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+
+	orm := log.NewORM(db, lggr, cfg, cltest.FixtureChainID)
+
+	_, addr1 := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	job1 := cltest.MustInsertV2JobSpec(t, db, addr1)
+
+	_, addr2 := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+	job2 := cltest.MustInsertV2JobSpec(t, db, addr2)
+
+	rawLog := cltest.RandomLog(t)
+	rawLog.BlockNumber = 42
+	// job1 consumed the log, job2 has not yet
+	require.NoError(t,
+		orm.CreateBroadcast(rawLog.BlockHash, rawLog.BlockNumber, rawLog.Index, job1.ID))
+	require.NoError(t,
+		orm.MarkBroadcastConsumed(rawLog.BlockHash, rawLog.BlockNumber, rawLog.Index, job1.ID))
+	require.NoError(t,
+		orm.CreateBroadcast(rawLog.BlockHash, rawLog.BlockNumber, rawLog.Index, job2.ID))
+
+	reorged, err := orm.MarkConsumedBroadcastsReorged(rawLog.BlockHash, rawLog.Index)
+	require.NoError(t, err)
+	require.Len(t, reorged, 1)
+	assert.Equal(t, job1.ID, reorged[0].JobID)
+	assert.Equal(t, rawLog.BlockHash, reorged[0].BlockHash)
+	assert.Equal(t, int64(42), reorged[0].BlockNumber)
+	assert.Equal(t, rawLog.Index, reorged[0].LogIndex)
+	assert.False(t, reorged[0].ReorgedAt.IsZero())
+
+	// Broadcasts are only reported once
+	reorged, err = orm.MarkConsumedBroadcastsReorged(rawLog.BlockHash, rawLog.Index)
+	require.NoError(t, err)
+	require.Empty(t, reorged)
+
+	found, err := orm.FindReorgedBroadcasts(40, 45)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, job1.ID, found[0].JobID)
+
+	found, err = orm.FindReorgedBroadcasts(43, 45)
+	require.NoError(t, err)
+	require.Empty(t, found)
+}
+
 func TestORM_Reinitialize(t *testing.T) {
 	type TestLogBroadcast struct {
 		BlockNumber big.Int
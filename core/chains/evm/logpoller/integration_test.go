@@ -106,7 +106,7 @@ func TestLogPoller_Integration(t *testing.T) {
 	th := logpoller.SetupTH(t, 2, 3, 2)
 	th.Client.Commit() // Block 2. Ensure we have finality number of blocks
 
-	_, err := th.LogPoller.RegisterFilter(logpoller.Filter{[]common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{th.EmitterAddress1}})
+	_, err := th.LogPoller.RegisterFilter(logpoller.Filter{EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{th.EmitterAddress1}})
 	require.NoError(t, err)
 	require.NoError(t, th.LogPoller.Start(testutils.Context(t)))
 
@@ -128,8 +128,8 @@ func TestLogPoller_Integration(t *testing.T) {
 	assert.Equal(t, 5, len(logs))
 	// Now let's update the filter and replay to get Log2 logs.
 	_, err = th.LogPoller.RegisterFilter(logpoller.Filter{
-		[]common.Hash{EmitterABI.Events["Log2"].ID},
-		[]common.Address{th.EmitterAddress1},
+		EventSigs: []common.Hash{EmitterABI.Events["Log2"].ID},
+		Addresses: []common.Address{th.EmitterAddress1},
 	})
 	require.NoError(t, err)
 	// Replay an invalid block should error
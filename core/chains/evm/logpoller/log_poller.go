@@ -16,6 +16,9 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/atomic"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
@@ -29,9 +32,14 @@ import (
 //go:generate mockery --name LogPoller --output ./mocks/ --case=underscore --structname LogPoller --filename log_poller.go
 type LogPoller interface {
 	services.ServiceCtx
+	// StartReadOnly starts the log poller in read-only mode, used while the
+	// node is a warm standby. Start then has it save the blocks and logs it
+	// polls.
+	StartReadOnly(ctx context.Context) error
 	Replay(ctx context.Context, fromBlock int64) error
 	RegisterFilter(filter Filter) (int, error)
 	UnregisterFilter(filterID int) error
+	Subscribe(filterID int) (Subscription, error)
 	LatestBlock(qopts ...pg.QOpt) (int64, error)
 	GetBlocks(ctx context.Context, numbers []uint64, qopts ...pg.QOpt) ([]LogPollerBlock, error)
 
@@ -53,6 +61,31 @@ var (
 	_                          LogPoller = &logPoller{}
 	ErrReplayAbortedByClient             = errors.New("replay aborted by client")
 	ErrReplayAbortedOnShutdown           = errors.New("replay aborted, log poller shutdown")
+	ErrReplayReadOnly                    = errors.New("replay unavailable, log poller is read-only")
+)
+
+var (
+	promPrunedLogs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "log_poller_pruned_logs",
+		Help: "The number of logs deleted by the log poller because they exceeded the retention of their filters",
+	}, []string{"evmChainID"})
+	promPrunedBlocks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "log_poller_pruned_blocks",
+		Help: "The number of finalized blocks deleted by the log poller",
+	}, []string{"evmChainID"})
+	promTableRows = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "log_poller_table_rows",
+		Help: "The estimated number of rows in a log poller table, across all chains",
+	}, []string{"table"})
+	promTableSizeBytes = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "log_poller_table_size_bytes",
+		Help: "The total size on disk of a log poller table including indexes, across all chains",
+	}, []string{"table"})
+)
+
+const (
+	// PruneInterval is how often expired logs and old blocks are deleted.
+	PruneInterval = 10 * time.Minute
 )
 
 type logPoller struct {
@@ -61,9 +94,11 @@ type logPoller struct {
 	orm               *ORM
 	lggr              logger.Logger
 	pollPeriod        time.Duration // poll period set by block production rate
+	pruneInterval     time.Duration // how often expired logs and old blocks are deleted
 	finalityDepth     int64         // finality depth is taken to mean that block (head - finality) is finalized
 	backfillBatchSize int64         // batch size to use when backfilling finalized logs
 	rpcBatchSize      int64         // batch size to use for fallback RPC calls made in GetBlocks
+	keepBlocksDepth   int64         // number of finalized blocks kept in log_poller_blocks, zero disables pruning
 
 	filterMu        sync.RWMutex
 	currentFilterID int
@@ -72,6 +107,17 @@ type logPoller struct {
 	cachedAddresses []common.Address
 	cachedEventSigs []common.Hash
 
+	subsMu       sync.Mutex
+	currentSubID int
+	subs         map[int]*subscription
+
+	// readOnly is set while blocks and logs must not be persisted. Polled
+	// headers of unfinalized blocks are then cached, to be saved without
+	// fetching them again once the log poller is no longer read-only.
+	readOnly      atomic.Bool
+	headersMu     sync.Mutex
+	cachedHeaders map[int64]*types.Header
+
 	replayStart    chan ReplayRequest
 	replayComplete chan error
 	ctx            context.Context
@@ -93,7 +139,10 @@ type ReplayRequest struct {
 // - 1 db tx including block write and logs write to logs.
 // How fast that can be done depends largely on network speed and DB, but even for the fastest
 // support chain, polygon, which has 2s block times, we need RPCs roughly with <= 500ms latency
-func NewLogPoller(orm *ORM, ec client.Client, lggr logger.Logger, pollPeriod time.Duration, finalityDepth, backfillBatchSize, rpcBatchSize int64) *logPoller {
+//
+// Finalized blocks older than keepBlocksDepth are pruned from the database, GetBlocks falls back
+// to RPC for them. A keepBlocksDepth of zero disables the pruning of blocks.
+func NewLogPoller(orm *ORM, ec client.Client, lggr logger.Logger, pollPeriod time.Duration, finalityDepth, backfillBatchSize, rpcBatchSize, keepBlocksDepth int64) *logPoller {
 	return &logPoller{
 		ec:                ec,
 		orm:               orm,
@@ -102,10 +151,14 @@ func NewLogPoller(orm *ORM, ec client.Client, lggr logger.Logger, pollPeriod tim
 		replayComplete:    make(chan error),
 		done:              make(chan struct{}),
 		pollPeriod:        pollPeriod,
+		pruneInterval:     PruneInterval,
 		finalityDepth:     finalityDepth,
 		backfillBatchSize: backfillBatchSize,
 		rpcBatchSize:      rpcBatchSize,
+		keepBlocksDepth:   keepBlocksDepth,
 		filters:           make(map[int]Filter),
+		subs:              make(map[int]*subscription),
+		cachedHeaders:     make(map[int64]*types.Header),
 		filterDirty:       true, // Always build filter on first call to cache an empty filter if nothing registered yet.
 	}
 }
@@ -113,6 +166,17 @@ func NewLogPoller(orm *ORM, ec client.Client, lggr logger.Logger, pollPeriod tim
 type Filter struct {
 	EventSigs []common.Hash
 	Addresses []common.Address
+	// Retention is how long logs matching the filter are kept after being
+	// saved. Zero disables the time based limit.
+	Retention time.Duration
+	// RetentionBlocks is how many blocks past finality logs matching the filter
+	// are kept. Zero disables the block based limit.
+	RetentionBlocks int64
+}
+
+// keepsForever returns true if the filter has no retention limit, i.e. its logs are never pruned
+func (f Filter) keepsForever() bool {
+	return f.Retention <= 0 && f.RetentionBlocks <= 0
 }
 
 // RegisterFilter adds the provided EventSigs and Addresses to the log poller's log filter query.
@@ -127,6 +191,10 @@ type Filter struct {
 // Generally speaking this is harmless. We enforce that EventSigs and Addresses are non-empty,
 // which means that anonymous events are not supported and log.Topics >= 1 always (log.Topics[0] is the event signature).
 // It returns an ID which can be used to unregister.
+//
+// Logs are kept forever, unless the filter sets a Retention and/or
+// RetentionBlocks. Logs are then pruned once they exceed all the limits set,
+// and only if every registered filter matching them allows it.
 func (lp *logPoller) RegisterFilter(filter Filter) (int, error) {
 	lp.filterMu.Lock()
 	defer lp.filterMu.Unlock()
@@ -146,12 +214,16 @@ func (lp *logPoller) RegisterFilter(filter Filter) (int, error) {
 			return 0, errors.Errorf("empty address")
 		}
 	}
+	if filter.Retention < 0 || filter.RetentionBlocks < 0 {
+		return 0, errors.Errorf("retention must not be negative")
+	}
 	lp.currentFilterID++
 	lp.filters[lp.currentFilterID] = filter
 	lp.filterDirty = true
 	return lp.currentFilterID, nil
 }
 
+// UnregisterFilter removes the filter with filterID and closes its subscriptions.
 func (lp *logPoller) UnregisterFilter(filterID int) error {
 	lp.filterMu.Lock()
 	defer lp.filterMu.Unlock()
@@ -161,6 +233,9 @@ func (lp *logPoller) UnregisterFilter(filterID int) error {
 	}
 	delete(lp.filters, filterID)
 	lp.filterDirty = true
+
+	// Under filterMu, so that no subscription to the filter is added concurrently
+	lp.unsubscribe(func(sub *subscription) bool { return sub.filterID == filterID })
 	return nil
 }
 
@@ -213,6 +288,9 @@ func (lp *logPoller) filter(from, to *big.Int, bh *common.Hash) ethereum.FilterQ
 // Blocks until the replay is complete.
 // Replay can be used to ensure that filter modification has been applied for all blocks from "fromBlock" up to latest.
 func (lp *logPoller) Replay(ctx context.Context, fromBlock int64) error {
+	if lp.readOnly.Load() {
+		return ErrReplayReadOnly
+	}
 	latest, err := lp.ec.HeaderByNumber(ctx, nil)
 	if err != nil {
 		return err
@@ -241,7 +319,28 @@ func (lp *logPoller) Replay(ctx context.Context, fromBlock int64) error {
 	return nil
 }
 
+// StartReadOnly starts the log poller without saving anything to the
+// database. It only caches the headers of the unfinalized blocks which the
+// database does not have yet, as the filters are registered by jobs which are
+// not running on warm standbys, so there are no logs to poll.
+func (lp *logPoller) StartReadOnly(parentCtx context.Context) error {
+	return lp.StartOnce("LogPoller", func() error {
+		lp.readOnly.Store(true)
+		ctx, cancel := context.WithCancel(parentCtx)
+		lp.ctx = ctx
+		lp.cancel = cancel
+		go lp.run()
+		return nil
+	})
+}
+
+// Start starts the log poller, or has a log poller started with
+// StartReadOnly save the blocks and logs it polls from now on.
 func (lp *logPoller) Start(parentCtx context.Context) error {
+	if lp.readOnly.CAS(true, false) {
+		lp.lggr.Debug("LogPoller: leaving read-only mode")
+		return nil
+	}
 	return lp.StartOnce("LogPoller", func() error {
 		ctx, cancel := context.WithCancel(parentCtx)
 		lp.ctx = ctx
@@ -255,6 +354,7 @@ func (lp *logPoller) Close() error {
 	return lp.StopOnce("LogPoller", func() error {
 		lp.cancel()
 		<-lp.done
+		lp.unsubscribe(func(*subscription) bool { return true })
 		return nil
 	})
 }
@@ -278,6 +378,7 @@ func (lp *logPoller) getReplayFromBlock(ctx context.Context, requested int64) (i
 func (lp *logPoller) run() {
 	defer close(lp.done)
 	tick := time.After(0)
+	pruneTick := time.After(utils.WithJitter(lp.pruneInterval))
 	for {
 		select {
 		case <-lp.ctx.Done():
@@ -302,6 +403,10 @@ func (lp *logPoller) run() {
 			}
 		case <-tick:
 			tick = time.After(utils.WithJitter(lp.pollPeriod))
+			if lp.readOnly.Load() {
+				lp.cacheHeaders(lp.ctx)
+				continue
+			}
 			// Always start from the latest block in the db.
 			var start int64
 			lastProcessed, err := lp.orm.SelectLatestBlock(pg.WithParentCtx(lp.ctx))
@@ -332,8 +437,181 @@ func (lp *logPoller) run() {
 				start = lastProcessed.BlockNumber + 1
 			}
 			lp.pollAndSaveLogs(lp.ctx, start)
+		case <-pruneTick:
+			pruneTick = time.After(utils.WithJitter(lp.pruneInterval))
+			if lp.readOnly.Load() {
+				continue
+			}
+			if err := lp.prune(lp.ctx); err != nil {
+				lp.lggr.Errorw("Unable to prune logs and blocks", "err", err)
+			}
+		}
+	}
+}
+
+// cacheHeaders caches the headers of the unfinalized blocks after the latest
+// block in the db, which is saved by the node writing to it. Headers of the
+// blocks saved since are dropped, as are all of them on a reorg.
+func (lp *logPoller) cacheHeaders(ctx context.Context) {
+	lastProcessed, err := lp.orm.SelectLatestBlock(pg.WithParentCtx(ctx))
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			lp.lggr.Errorw("unable to get latest block", "err", err)
+		}
+		return
+	}
+	latest, err := lp.ec.HeaderByNumber(ctx, nil)
+	if err != nil {
+		lp.lggr.Warnw("Unable to get latest block", "err", err)
+		return
+	}
+	latestNum := latest.Number.Int64()
+	start := mathutil.Max(lastProcessed.BlockNumber+1, latestNum-lp.finalityDepth)
+
+	lp.headersMu.Lock()
+	defer lp.headersMu.Unlock()
+	for n := range lp.cachedHeaders {
+		if n < start || n > latestNum {
+			delete(lp.cachedHeaders, n)
+		}
+	}
+	for n := start; n <= latestNum; n++ {
+		if _, ok := lp.cachedHeaders[n]; ok {
+			continue
+		}
+		header := latest
+		if n != latestNum {
+			header, err = lp.ec.HeaderByNumber(ctx, big.NewInt(n))
+			if err != nil {
+				lp.lggr.Warnw("Unable to get block", "err", err, "blockNumber", n)
+				return
+			}
+		}
+		if parent, ok := lp.cachedHeaders[n-1]; ok && parent.Hash() != header.ParentHash {
+			lp.lggr.Infow("Reorg detected, dropping cached blocks", "blockNumber", n)
+			lp.cachedHeaders = make(map[int64]*types.Header)
+			return
+		}
+		lp.cachedHeaders[n] = header
+	}
+}
+
+// takeCachedHeader removes the header of block n from the cache and returns
+// it, or nil if it was not cached.
+func (lp *logPoller) takeCachedHeader(n int64) *types.Header {
+	lp.headersMu.Lock()
+	defer lp.headersMu.Unlock()
+	header, ok := lp.cachedHeaders[n]
+	if !ok {
+		return nil
+	}
+	delete(lp.cachedHeaders, n)
+	return header
+}
+
+// retentionPolicy is the effective retention of the logs of an (address, event sig) pair.
+type retentionPolicy struct {
+	retention       time.Duration
+	retentionBlocks int64
+}
+
+// retentionPolicies merges the retention of all registered filters by (address, event sig) pair.
+// A pair is only pruned if every filter matching it has a retention, so pairs matched by a filter
+// without retention are left out. Otherwise the largest retention of each kind wins.
+func (lp *logPoller) retentionPolicies() map[common.Address]map[common.Hash]retentionPolicy {
+	lp.filterMu.RLock()
+	defer lp.filterMu.RUnlock()
+	policies := make(map[common.Address]map[common.Hash]retentionPolicy)
+	forever := make(map[common.Address]map[common.Hash]bool)
+	for _, filter := range lp.filters {
+		for _, addr := range filter.Addresses {
+			if policies[addr] == nil {
+				policies[addr] = make(map[common.Hash]retentionPolicy)
+				forever[addr] = make(map[common.Hash]bool)
+			}
+			for _, eventSig := range filter.EventSigs {
+				if filter.keepsForever() {
+					forever[addr][eventSig] = true
+					continue
+				}
+				p := policies[addr][eventSig]
+				p.retention = mathutil.Max(p.retention, filter.Retention)
+				p.retentionBlocks = mathutil.Max(p.retentionBlocks, filter.RetentionBlocks)
+				policies[addr][eventSig] = p
+			}
+		}
+	}
+	for addr, eventSigs := range forever {
+		for eventSig := range eventSigs {
+			delete(policies[addr], eventSig)
+		}
+	}
+	return policies
+}
+
+// prune deletes the logs which exceeded the retention of their filters and the finalized blocks
+// older than keepBlocksDepth, then updates the table metrics.
+func (lp *logPoller) prune(ctx context.Context) error {
+	latest, err := lp.orm.SelectLatestBlock(pg.WithParentCtx(ctx))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Nothing polled yet
+			return nil
+		}
+		return errors.Wrap(err, "unable to get latest block")
+	}
+	latestFinalized := latest.BlockNumber - lp.finalityDepth
+	chainID := lp.ec.ChainID().String()
+
+	now := time.Now()
+	var prunedLogs int64
+	for addr, eventSigs := range lp.retentionPolicies() {
+		for eventSig, p := range eventSigs {
+			var createdBefore time.Time
+			if p.retention > 0 {
+				createdBefore = now.Add(-p.retention)
+			}
+			var blockBefore int64
+			if p.retentionBlocks > 0 {
+				blockBefore = latestFinalized - p.retentionBlocks
+				if blockBefore <= 0 {
+					// No log exceeds the block based retention yet
+					continue
+				}
+			}
+			if createdBefore.IsZero() && blockBefore == 0 {
+				continue
+			}
+			n, err := lp.orm.DeleteExpiredLogs(addr, eventSig, createdBefore, blockBefore, pg.WithParentCtx(ctx))
+			if err != nil {
+				return errors.Wrapf(err, "unable to delete expired logs of event %s emitted by %s", eventSig, addr)
+			}
+			prunedLogs += n
 		}
 	}
+	promPrunedLogs.WithLabelValues(chainID).Add(float64(prunedLogs))
+
+	var prunedBlocks int64
+	if end := latestFinalized - lp.keepBlocksDepth; lp.keepBlocksDepth > 0 && end > 0 {
+		prunedBlocks, err = lp.orm.DeleteBlocksBefore(end, pg.WithParentCtx(ctx))
+		if err != nil {
+			return errors.Wrap(err, "unable to delete old blocks")
+		}
+		promPrunedBlocks.WithLabelValues(chainID).Add(float64(prunedBlocks))
+	}
+	if prunedLogs > 0 || prunedBlocks > 0 {
+		lp.lggr.Debugw("Pruned logs and blocks", "logs", prunedLogs, "blocks", prunedBlocks)
+	}
+
+	stats, err := lp.orm.SelectTableStats(pg.WithParentCtx(ctx))
+	if err != nil {
+		return errors.Wrap(err, "unable to get table stats")
+	}
+	for _, s := range stats {
+		promTableRows.WithLabelValues(s.Table).Set(float64(s.Rows))
+		promTableSizeBytes.WithLabelValues(s.Table).Set(float64(s.SizeBytes))
+	}
+	return nil
 }
 
 func convertLogs(chainID *big.Int, logs []types.Log) []Log {
@@ -380,13 +658,15 @@ func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 			continue
 		}
 		lp.lggr.Infow("Backfill found logs", "from", from, "to", to, "logs", len(logs))
+		lgs := convertLogs(lp.ec.ChainID(), logs)
 		err = lp.orm.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
-			return lp.orm.InsertLogs(convertLogs(lp.ec.ChainID(), logs), pg.WithQueryer(tx))
+			return lp.orm.InsertLogs(lgs, pg.WithQueryer(tx))
 		})
 		if err != nil {
 			lp.lggr.Warnw("Unable to insert logs, retrying", "err", err, "from", from, "to", to)
 			return err
 		}
+		lp.notifyLogs(lgs)
 	}
 	return nil
 }
@@ -401,6 +681,10 @@ func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 // 3. Return the LCA+1, i.e. our new current (unprocessed) block.
 func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, currentBlockNumber int64, currentBlock *types.Header) (*types.Header, error) {
 	var err1 error
+	if currentBlock == nil {
+		// Reorgs are detected below for cached blocks as well.
+		currentBlock = lp.takeCachedHeader(currentBlockNumber)
+	}
 	if currentBlock == nil {
 		// If we don't have the current block already, lets get it.
 		currentBlock, err1 = lp.ec.HeaderByNumber(ctx, big.NewInt(currentBlockNumber))
@@ -471,6 +755,10 @@ func (lp *logPoller) getCurrentBlockMaybeHandleReorg(ctx context.Context, curren
 			// We return an error here which will cause us to restart polling from lastBlockSaved + 1
 			return nil, err2
 		}
+		lp.headersMu.Lock()
+		lp.cachedHeaders = make(map[int64]*types.Header)
+		lp.headersMu.Unlock()
+		lp.notifyReorg(blockAfterLCA.Number.Int64())
 		return blockAfterLCA, nil
 	}
 	// No reorg, return current block.
@@ -545,19 +833,21 @@ func (lp *logPoller) pollAndSaveLogs(ctx context.Context, currentBlockNumber int
 			return
 		}
 		lp.lggr.Infow("Unfinalized log query", "logs", len(logs), "currentBlockNumber", currentBlockNumber, "blockHash", currentBlock.Hash())
+		lgs := convertLogs(lp.ec.ChainID(), logs)
 		err = lp.orm.q.WithOpts(pg.WithParentCtx(ctx)).Transaction(func(tx pg.Queryer) error {
 			if err2 := lp.orm.InsertBlock(h, currentBlockNumber, pg.WithQueryer(tx)); err2 != nil {
 				return err2
 			}
-			if len(logs) == 0 {
+			if len(lgs) == 0 {
 				return nil
 			}
-			return lp.orm.InsertLogs(convertLogs(lp.ec.ChainID(), logs), pg.WithQueryer(tx))
+			return lp.orm.InsertLogs(lgs, pg.WithQueryer(tx))
 		})
 		if err != nil {
 			lp.lggr.Warnw("Unable to save logs resuming from last saved block + 1", "err", err, "block", currentBlockNumber)
 			return
 		}
+		lp.notifyLogs(lgs)
 		// Update current block.
 		// Same reorg detection on unfinalized blocks.
 		currentBlockNumber++
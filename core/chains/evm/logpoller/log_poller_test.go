@@ -109,7 +109,7 @@ func TestLogPoller_SynchronizedWithGeth(t *testing.T) {
 		}, 10e6)
 		_, _, emitter1, err := log_emitter.DeployLogEmitter(owner, ec)
 		require.NoError(t, err)
-		lp := NewLogPoller(orm, client.NewSimulatedBackendClient(t, ec, chainID), lggr, 15*time.Second, int64(finalityDepth), 3, 2)
+		lp := NewLogPoller(orm, client.NewSimulatedBackendClient(t, ec, chainID), lggr, 15*time.Second, int64(finalityDepth), 3, 2, 0)
 		for i := 0; i < finalityDepth; i++ { // Have enough blocks that we could reorg the full finalityDepth-1.
 			ec.Commit()
 		}
@@ -175,8 +175,8 @@ func TestLogPoller_PollAndSaveLogs(t *testing.T) {
 
 	// Set up a log poller listening for log emitter logs.
 	_, err := th.LogPoller.RegisterFilter(Filter{
-		[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID},
-		[]common.Address{th.EmitterAddress1, th.EmitterAddress2},
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID},
+		Addresses: []common.Address{th.EmitterAddress1, th.EmitterAddress2},
 	})
 	require.NoError(t, err)
 
@@ -419,7 +419,7 @@ func TestLogPoller_Logs(t *testing.T) {
 }
 
 func TestLogPoller_RegisterFilter(t *testing.T) {
-	lp := NewLogPoller(nil, nil, nil, 15*time.Second, 1, 1, 2)
+	lp := NewLogPoller(nil, nil, nil, 15*time.Second, 1, 1, 2, 0)
 	a1 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbb")
 	a2 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
 
@@ -428,33 +428,33 @@ func TestLogPoller_RegisterFilter(t *testing.T) {
 	require.Equal(t, 1, len(f.Addresses))
 	assert.Equal(t, common.HexToAddress("0x0000000000000000000000000000000000000000"), f.Addresses[0])
 
-	_, err := lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{a1}})
+	_, err := lp.RegisterFilter(Filter{EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{a1}})
 	require.NoError(t, err)
 	assert.Equal(t, []common.Address{a1}, lp.Filter().Addresses)
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID}}, lp.Filter().Topics)
 
 	// Should de-dupe EventSigs
-	_, err = lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}})
+	_, err = lp.RegisterFilter(Filter{EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, Addresses: []common.Address{a2}})
 	require.NoError(t, err)
 	assert.Equal(t, []common.Address{a1, a2}, lp.Filter().Addresses)
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}}, lp.Filter().Topics)
 
 	// Should de-dupe Addresses
-	_, err = lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}})
+	_, err = lp.RegisterFilter(Filter{EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, Addresses: []common.Address{a2}})
 	require.NoError(t, err)
 	assert.Equal(t, []common.Address{a1, a2}, lp.Filter().Addresses)
 	assert.Equal(t, [][]common.Hash{{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}}, lp.Filter().Topics)
 
 	// Address required.
-	_, err = lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID}, []common.Address{}})
+	_, err = lp.RegisterFilter(Filter{EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{}})
 	require.Error(t, err)
 	// Event required
-	_, err = lp.RegisterFilter(Filter{[]common.Hash{}, []common.Address{a1}})
+	_, err = lp.RegisterFilter(Filter{EventSigs: []common.Hash{}, Addresses: []common.Address{a1}})
 	require.Error(t, err)
 	// ID should increment
-	id1, err := lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}})
+	id1, err := lp.RegisterFilter(Filter{EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, Addresses: []common.Address{a2}})
 	require.NoError(t, err)
-	id2, err := lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}})
+	id2, err := lp.RegisterFilter(Filter{EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, Addresses: []common.Address{a2}})
 	require.NoError(t, err)
 	assert.Equal(t, id1+1, id2)
 	// Removing non-existence filterID should error.
@@ -463,16 +463,147 @@ func TestLogPoller_RegisterFilter(t *testing.T) {
 	err = lp.UnregisterFilter(id1)
 	require.Error(t, err)
 	// Continues to increment fine after removing.
-	id3, err := lp.RegisterFilter(Filter{[]common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{a2}})
+	id3, err := lp.RegisterFilter(Filter{EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, Addresses: []common.Address{a2}})
 	require.NoError(t, err)
 	assert.Equal(t, id2+1, id3)
 }
 
+func TestLogPoller_RetentionPolicies(t *testing.T) {
+	lp := NewLogPoller(nil, nil, nil, 15*time.Second, 1, 1, 2, 0)
+	a1 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbb")
+	a2 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
+	log1, log2 := EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID
+
+	_, err := lp.RegisterFilter(Filter{EventSigs: []common.Hash{log1}, Addresses: []common.Address{a1}, Retention: -time.Hour})
+	require.Error(t, err)
+
+	// No retention, logs are kept forever
+	_, err = lp.RegisterFilter(Filter{EventSigs: []common.Hash{log1}, Addresses: []common.Address{a1}})
+	require.NoError(t, err)
+	assert.Empty(t, lp.retentionPolicies()[a1])
+
+	_, err = lp.RegisterFilter(Filter{EventSigs: []common.Hash{log1, log2}, Addresses: []common.Address{a1, a2}, Retention: time.Hour})
+	require.NoError(t, err)
+	id, err := lp.RegisterFilter(Filter{EventSigs: []common.Hash{log2}, Addresses: []common.Address{a2}, Retention: time.Minute, RetentionBlocks: 100})
+	require.NoError(t, err)
+
+	// (a1, log1) is still kept forever, the largest retention of each kind wins otherwise
+	assert.Equal(t, map[common.Address]map[common.Hash]retentionPolicy{
+		a1: {log2: {retention: time.Hour}},
+		a2: {
+			log1: {retention: time.Hour},
+			log2: {retention: time.Hour, retentionBlocks: 100},
+		},
+	}, lp.retentionPolicies())
+
+	require.NoError(t, lp.UnregisterFilter(id))
+	assert.Equal(t, retentionPolicy{retention: time.Hour}, lp.retentionPolicies()[a2][log2])
+}
+
+func TestLogPoller_Prune(t *testing.T) {
+	th := SetupTH(t, 2, 3, 2)
+	lp, o := th.LogPoller, th.ORM
+	ctx := testutils.Context(t)
+	log1, log2 := EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID
+
+	_, err := lp.RegisterFilter(Filter{EventSigs: []common.Hash{log1}, Addresses: []common.Address{th.EmitterAddress1}, RetentionBlocks: 10})
+	require.NoError(t, err)
+	_, err = lp.RegisterFilter(Filter{EventSigs: []common.Hash{log2}, Addresses: []common.Address{th.EmitterAddress1}})
+	require.NoError(t, err)
+	// Logs are only pruned once they exceed both retentions, and there are not enough blocks for
+	// the block based retention yet
+	_, err = lp.RegisterFilter(Filter{EventSigs: []common.Hash{log1}, Addresses: []common.Address{th.EmitterAddress2}, Retention: time.Nanosecond, RetentionBlocks: 1000000})
+	require.NoError(t, err)
+	_, err = lp.RegisterFilter(Filter{EventSigs: []common.Hash{log2}, Addresses: []common.Address{th.EmitterAddress2}, Retention: time.Nanosecond})
+	require.NoError(t, err)
+
+	const keepBlocksDepth = 1000
+	latest := int64(keepBlocksDepth + 20)
+	for _, n := range []int64{1, 5, latest - 13, latest - 2, latest} {
+		require.NoError(t, o.InsertBlock(common.BigToHash(big.NewInt(n)), n))
+		require.NoError(t, o.InsertLogs([]Log{
+			GenLog(th.ChainID, 1, n, common.BigToHash(big.NewInt(n)).Hex(), log1[:], th.EmitterAddress1),
+			GenLog(th.ChainID, 2, n, common.BigToHash(big.NewInt(n)).Hex(), log2[:], th.EmitterAddress1),
+			GenLog(th.ChainID, 3, n, common.BigToHash(big.NewInt(n)).Hex(), log1[:], th.EmitterAddress2),
+			GenLog(th.ChainID, 4, n, common.BigToHash(big.NewInt(n)).Hex(), log2[:], th.EmitterAddress2),
+		}))
+	}
+
+	require.NoError(t, lp.prune(ctx))
+
+	// Log1 logs more than 10 blocks past finality (latest - 2) are deleted, Log2 logs are kept forever
+	logs, err := o.SelectLogsByBlockRangeFilter(1, latest, th.EmitterAddress1, log1)
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	assert.Equal(t, latest-2, logs[0].BlockNumber)
+	logs, err = o.SelectLogsByBlockRangeFilter(1, latest, th.EmitterAddress1, log2)
+	require.NoError(t, err)
+	assert.Len(t, logs, 5)
+	logs, err = o.SelectLogsByBlockRangeFilter(1, latest, th.EmitterAddress2, log1)
+	require.NoError(t, err)
+	assert.Len(t, logs, 5)
+	logs, err = o.SelectLogsByBlockRangeFilter(1, latest, th.EmitterAddress2, log2)
+	require.NoError(t, err)
+	assert.Len(t, logs, 0)
+
+	// Blocks are not pruned by default
+	_, err = o.SelectBlockByNumber(1)
+	require.NoError(t, err)
+
+	// Blocks older than keepBlocksDepth past finality are deleted
+	lp.keepBlocksDepth = keepBlocksDepth
+	require.NoError(t, lp.prune(ctx))
+	assertDontHave(t, 1, 6, o)
+	_, err = o.SelectBlockByNumber(latest - 13)
+	require.NoError(t, err)
+}
+
+func TestLogPoller_ReadOnly(t *testing.T) {
+	th := SetupTH(t, 2, 3, 2)
+	lp := th.LogPoller
+	ctx := testutils.Context(t)
+
+	_, err := lp.RegisterFilter(Filter{EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID}, Addresses: []common.Address{th.EmitterAddress1}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), lp.PollAndSaveLogs(ctx, 1))
+
+	// Chain gen <- 1 <- 2 (L1) <- 3 (L1) <- 4 (L1)
+	// DB: 1
+	for i := 0; i < 3; i++ {
+		_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(int64(i))})
+		require.NoError(t, err)
+		th.Client.Commit()
+	}
+
+	// Read-only, the headers of the blocks after the latest saved one are only cached
+	lp.readOnly.Store(true)
+	lp.cacheHeaders(ctx)
+	latest, err := th.ORM.SelectLatestBlock()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), latest.BlockNumber)
+	require.Len(t, lp.cachedHeaders, 3)
+	for n := int64(2); n <= 4; n++ {
+		b, err2 := th.Client.BlockByNumber(ctx, big.NewInt(n))
+		require.NoError(t, err2)
+		assert.Equal(t, b.Hash(), lp.cachedHeaders[n].Hash())
+	}
+	assert.ErrorIs(t, lp.Replay(ctx, 1), ErrReplayReadOnly)
+
+	// Once writable, the cached blocks are saved along with their logs
+	lp.readOnly.Store(false)
+	assert.Equal(t, int64(5), lp.PollAndSaveLogs(ctx, 2))
+	assert.Empty(t, lp.cachedHeaders)
+	lgs, err := th.ORM.SelectLogsByBlockRange(2, 4)
+	require.NoError(t, err)
+	assert.Len(t, lgs, 3)
+	assertHaveCanonical(t, 1, 4, th.Client, th.ORM)
+}
+
 func TestLogPoller_GetBlocks(t *testing.T) {
 	th := SetupTH(t, 2, 3, 2)
 
-	_, err := th.LogPoller.RegisterFilter(Filter{[]common.Hash{
-		EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, []common.Address{th.EmitterAddress1, th.EmitterAddress2}},
+	_, err := th.LogPoller.RegisterFilter(Filter{EventSigs: []common.Hash{
+		EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID}, Addresses: []common.Address{th.EmitterAddress1, th.EmitterAddress2}},
 	)
 	require.NoError(t, err)
 
@@ -586,7 +717,7 @@ func TestGetReplayFromBlock(t *testing.T) {
 
 func benchmarkFilter(b *testing.B, nFilters, nAddresses, nEvents int) {
 	lggr := logger.TestLogger(b)
-	lp := NewLogPoller(nil, nil, lggr, 1*time.Hour, 2, 3, 2)
+	lp := NewLogPoller(nil, nil, lggr, 1*time.Hour, 2, 3, 2, 0)
 	for i := 0; i < nFilters; i++ {
 		var addresses []common.Address
 		var events []common.Hash
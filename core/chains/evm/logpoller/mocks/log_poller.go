@@ -437,6 +437,43 @@ func (_m *LogPoller) Start(_a0 context.Context) error {
 	return r0
 }
 
+// StartReadOnly provides a mock function with given fields: ctx
+func (_m *LogPoller) StartReadOnly(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Subscribe provides a mock function with given fields: filterID
+func (_m *LogPoller) Subscribe(filterID int) (logpoller.Subscription, error) {
+	ret := _m.Called(filterID)
+
+	var r0 logpoller.Subscription
+	if rf, ok := ret.Get(0).(func(int) logpoller.Subscription); ok {
+		r0 = rf(filterID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(logpoller.Subscription)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(filterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnregisterFilter provides a mock function with given fields: filterID
 func (_m *LogPoller) UnregisterFilter(filterID int) error {
 	ret := _m.Called(filterID)
@@ -3,6 +3,7 @@ package logpoller
 import (
 	"database/sql"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/lib/pq"
@@ -88,6 +89,61 @@ func (o *ORM) DeleteLogsAfter(start int64, qopts ...pg.QOpt) error {
 	return q.ExecQ(`DELETE FROM logs WHERE block_number >= $1 AND evm_chain_id = $2`, start, utils.NewBig(o.chainID))
 }
 
+// DeleteBlocksBefore deletes all blocks before end, returning the number of deleted blocks.
+func (o *ORM) DeleteBlocksBefore(end int64, qopts ...pg.QOpt) (int64, error) {
+	q := o.q.WithOpts(qopts...)
+	res, cancel, err := q.ExecQIter(`DELETE FROM log_poller_blocks WHERE block_number < $1 AND evm_chain_id = $2`, end, utils.NewBig(o.chainID))
+	defer cancel()
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// DeleteExpiredLogs deletes the logs of eventSig emitted by address, which were saved before
+// createdBefore and are in blocks before blockBefore. A zero createdBefore or blockBefore
+// disables the respective check. It returns the number of deleted logs.
+func (o *ORM) DeleteExpiredLogs(address common.Address, eventSig common.Hash, createdBefore time.Time, blockBefore int64, qopts ...pg.QOpt) (int64, error) {
+	q := o.q.WithOpts(qopts...)
+	res, cancel, err := q.ExecQIter(`DELETE FROM logs
+		WHERE evm_chain_id = $1
+			AND address = $2
+			AND event_sig = $3
+			AND ($4::timestamptz IS NULL OR created_at < $4)
+			AND ($5 = 0 OR block_number < $5)`,
+		utils.NewBig(o.chainID), address, eventSig.Bytes(), nullTime(createdBefore), blockBefore)
+	defer cancel()
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// TableStats is the estimated number of rows and the total size on disk of a log poller table,
+// shared by all chains.
+type TableStats struct {
+	Table     string `db:"table_name"`
+	Rows      int64  `db:"row_estimate"`
+	SizeBytes int64  `db:"size_bytes"`
+}
+
+// SelectTableStats returns the TableStats of the logs and log_poller_blocks tables. The row count is the
+// planner's estimate, since counting tens of millions of rows is too expensive to do periodically.
+func (o *ORM) SelectTableStats(qopts ...pg.QOpt) ([]TableStats, error) {
+	q := o.q.WithOpts(qopts...)
+	var stats []TableStats
+	err := q.Select(&stats, `SELECT relname AS table_name, GREATEST(reltuples, 0)::bigint AS row_estimate, pg_total_relation_size(oid) AS size_bytes
+		FROM pg_class WHERE oid IN ('logs'::regclass, 'log_poller_blocks'::regclass)`)
+	return stats, err
+}
+
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
 // InsertLogs is idempotent to support replays.
 func (o *ORM) InsertLogs(logs []Log, qopts ...pg.QOpt) error {
 	for _, log := range logs {
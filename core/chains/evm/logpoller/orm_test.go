@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -518,3 +519,55 @@ func BenchmarkLogs(b *testing.B) {
 		require.NoError(b, err)
 	}
 }
+
+func TestORM_DeleteExpiredLogs(t *testing.T) {
+	o1, o2 := setup(t)
+	sig := common.HexToHash("0x1234")
+	addr := common.HexToAddress("0x1234")
+
+	for _, o := range []*ORM{o1, o2} {
+		require.NoError(t, o.InsertLogs([]Log{
+			GenLog(o.chainID, 1, 10, "0x3", sig[:], addr),
+			GenLog(o.chainID, 1, 20, "0x4", sig[:], addr),
+			GenLog(o.chainID, 1, 30, "0x5", sig[:], addr),
+		}))
+	}
+	require.NoError(t, o1.q.ExecQ(`UPDATE logs SET created_at = NOW() - '2 hours'::interval WHERE block_number = 10 AND evm_chain_id = $1`, utils.NewBig(o1.chainID)))
+
+	// Only logs matching both limits are deleted
+	n, err := o1.DeleteExpiredLogs(addr, sig, time.Now().Add(-time.Hour), 30)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	n, err = o1.DeleteExpiredLogs(addr, sig, time.Time{}, 30)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	logs, err := o1.SelectLogsByBlockRangeFilter(0, 100, addr, sig)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, int64(30), logs[0].BlockNumber)
+
+	// Other chains are unaffected
+	logs, err = o2.SelectLogsByBlockRangeFilter(0, 100, addr, sig)
+	require.NoError(t, err)
+	assert.Len(t, logs, 3)
+}
+
+func TestORM_DeleteBlocksBefore(t *testing.T) {
+	o1, _ := setup(t)
+	for i := int64(1); i <= 5; i++ {
+		require.NoError(t, o1.InsertBlock(common.BigToHash(big.NewInt(i)), i))
+	}
+	n, err := o1.DeleteBlocksBefore(3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+	_, err = o1.SelectBlockByNumber(2)
+	require.True(t, errors.Is(err, sql.ErrNoRows))
+	_, err = o1.SelectBlockByNumber(3)
+	require.NoError(t, err)
+
+	stats, err := o1.SelectTableStats()
+	require.NoError(t, err)
+	assert.Len(t, stats, 2)
+}
@@ -0,0 +1,148 @@
+package logpoller
+
+import "github.com/pkg/errors"
+
+// SubscriptionBufferSize is the number of notifications buffered per
+// subscription. Notifications for subscribers which fall further behind are
+// dropped.
+const SubscriptionBufferSize = 100
+
+// Notification is sent to subscribers after the log poller committed new logs
+// matching their filter, or removed logs due to a reorg.
+type Notification struct {
+	// Logs are the newly saved logs matching the filter, ordered by block
+	// number and log index. Logs may be sent again on replays.
+	Logs []Log
+	// ReorgedFromBlock is non-zero if all logs from this block onwards were
+	// removed due to a reorg. Logs of the new canonical chain follow in later
+	// notifications.
+	ReorgedFromBlock int64
+}
+
+// Subscription streams the Notifications of a filter.
+//
+// Notifications are best effort, they are dropped if the subscriber does not
+// keep up. Subscribers which need every log should still query the log poller,
+// e.g. on startup, and use notifications as a trigger.
+type Subscription interface {
+	// Notifications returns the channel notifications are delivered on. It is
+	// closed once the subscription, the filter or the log poller are closed.
+	Notifications() <-chan Notification
+	// Close unsubscribes.
+	Close()
+}
+
+type subscription struct {
+	id       int
+	filterID int
+	ch       chan Notification
+	lp       *logPoller
+}
+
+func (s *subscription) Notifications() <-chan Notification {
+	return s.ch
+}
+
+func (s *subscription) Close() {
+	s.lp.unsubscribe(func(sub *subscription) bool { return sub.id == s.id })
+}
+
+// Subscribe returns a Subscription to the logs of the registered filter with
+// filterID, which are delivered after they have been committed to the db.
+func (lp *logPoller) Subscribe(filterID int) (Subscription, error) {
+	// Hold filterMu until the subscription is added, so that UnregisterFilter
+	// can't remove the filter in between and leave the subscription open
+	lp.filterMu.RLock()
+	defer lp.filterMu.RUnlock()
+	if _, ok := lp.filters[filterID]; !ok {
+		return nil, errors.Errorf("filter %d doesn't exist", filterID)
+	}
+
+	lp.subsMu.Lock()
+	defer lp.subsMu.Unlock()
+	lp.currentSubID++
+	sub := &subscription{
+		id:       lp.currentSubID,
+		filterID: filterID,
+		ch:       make(chan Notification, SubscriptionBufferSize),
+		lp:       lp,
+	}
+	lp.subs[sub.id] = sub
+	return sub, nil
+}
+
+// unsubscribe removes and closes all subscriptions matching remove.
+func (lp *logPoller) unsubscribe(remove func(*subscription) bool) {
+	lp.subsMu.Lock()
+	defer lp.subsMu.Unlock()
+	for id, sub := range lp.subs {
+		if remove(sub) {
+			delete(lp.subs, id)
+			close(sub.ch)
+		}
+	}
+}
+
+// notifyLogs sends the logs matching the filter of each subscription to it.
+func (lp *logPoller) notifyLogs(logs []Log) {
+	if len(logs) == 0 {
+		return
+	}
+	lp.filterMu.RLock()
+	filters := make(map[int]Filter, len(lp.filters))
+	for id, filter := range lp.filters {
+		filters[id] = filter
+	}
+	lp.filterMu.RUnlock()
+
+	lp.subsMu.Lock()
+	defer lp.subsMu.Unlock()
+	for _, sub := range lp.subs {
+		var matching []Log
+		for _, l := range logs {
+			if filters[sub.filterID].matches(l) {
+				matching = append(matching, l)
+			}
+		}
+		if len(matching) > 0 {
+			lp.send(sub, Notification{Logs: matching})
+		}
+	}
+}
+
+// notifyReorg tells all subscriptions that logs from block onwards were removed.
+func (lp *logPoller) notifyReorg(block int64) {
+	lp.subsMu.Lock()
+	defer lp.subsMu.Unlock()
+	for _, sub := range lp.subs {
+		lp.send(sub, Notification{ReorgedFromBlock: block})
+	}
+}
+
+func (lp *logPoller) send(sub *subscription, n Notification) {
+	select {
+	case sub.ch <- n:
+	default:
+		lp.lggr.Warnw("Subscriber is not keeping up, dropping notification", "filterID", sub.filterID, "logs", len(n.Logs), "reorgedFromBlock", n.ReorgedFromBlock)
+	}
+}
+
+// matches returns true if l was emitted by one of the filter's addresses with one of its event sigs.
+func (f Filter) matches(l Log) bool {
+	var addressMatches bool
+	for _, addr := range f.Addresses {
+		if addr == l.Address {
+			addressMatches = true
+			break
+		}
+	}
+	if !addressMatches {
+		return false
+	}
+	for _, eventSig := range f.EventSigs {
+		if eventSig == l.EventSig {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,158 @@
+package logpoller
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestLogPoller_Subscribe(t *testing.T) {
+	lp := NewLogPoller(nil, nil, logger.TestLogger(t), 15*time.Second, 1, 1, 2, 0)
+	chainID := testutils.FixtureChainID
+	a1 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbb")
+	a2 := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
+	log1, log2 := EmitterABI.Events["Log1"].ID, EmitterABI.Events["Log2"].ID
+
+	_, err := lp.Subscribe(1)
+	require.EqualError(t, err, "filter 1 doesn't exist")
+
+	id1, err := lp.RegisterFilter(Filter{EventSigs: []common.Hash{log1}, Addresses: []common.Address{a1}})
+	require.NoError(t, err)
+	id2, err := lp.RegisterFilter(Filter{EventSigs: []common.Hash{log1, log2}, Addresses: []common.Address{a2}})
+	require.NoError(t, err)
+	sub1, err := lp.Subscribe(id1)
+	require.NoError(t, err)
+	sub2, err := lp.Subscribe(id2)
+	require.NoError(t, err)
+
+	t.Run("delivers the logs matching the filter", func(t *testing.T) {
+		l1 := GenLog(chainID, 1, 1, "0x1", log1[:], a1)
+		l2 := GenLog(chainID, 2, 1, "0x1", log2[:], a1)
+		l3 := GenLog(chainID, 3, 1, "0x1", log2[:], a2)
+		lp.notifyLogs([]Log{l1, l2, l3})
+
+		assert.Equal(t, Notification{Logs: []Log{l1}}, <-sub1.Notifications())
+		assert.Equal(t, Notification{Logs: []Log{l3}}, <-sub2.Notifications())
+	})
+
+	t.Run("delivers reorgs to all subscriptions", func(t *testing.T) {
+		lp.notifyReorg(5)
+
+		assert.Equal(t, Notification{ReorgedFromBlock: 5}, <-sub1.Notifications())
+		assert.Equal(t, Notification{ReorgedFromBlock: 5}, <-sub2.Notifications())
+	})
+
+	t.Run("drops notifications if the subscriber does not keep up", func(t *testing.T) {
+		for i := 0; i < SubscriptionBufferSize+1; i++ {
+			lp.notifyReorg(int64(i + 1))
+		}
+		for i := 0; i < SubscriptionBufferSize; i++ {
+			<-sub1.Notifications()
+		}
+		select {
+		case n := <-sub1.Notifications():
+			t.Fatalf("unexpected notification %v", n)
+		default:
+		}
+		for i := 0; i < SubscriptionBufferSize; i++ {
+			<-sub2.Notifications()
+		}
+	})
+
+	t.Run("closing the subscription or unregistering the filter closes the channel", func(t *testing.T) {
+		sub1.Close()
+		_, ok := <-sub1.Notifications()
+		assert.False(t, ok)
+		// closing twice is a noop
+		sub1.Close()
+
+		require.NoError(t, lp.UnregisterFilter(id2))
+		_, ok = <-sub2.Notifications()
+		assert.False(t, ok)
+		assert.Empty(t, lp.subs)
+	})
+}
+
+func TestLogPoller_Subscribe_ConcurrentUnregisterFilter(t *testing.T) {
+	lp := NewLogPoller(nil, nil, logger.TestLogger(t), 15*time.Second, 1, 1, 2, 0)
+	log1 := EmitterABI.Events["Log1"].ID
+	id, err := lp.RegisterFilter(Filter{EventSigs: []common.Hash{log1}, Addresses: []common.Address{testutils.NewAddress()}})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	subs := make(chan Subscription, 100)
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if sub, err := lp.Subscribe(id); err == nil {
+				subs <- sub
+			}
+		}()
+	}
+	require.NoError(t, lp.UnregisterFilter(id))
+	wg.Wait()
+	close(subs)
+
+	// every subscription which was added is closed with the filter
+	for sub := range subs {
+		_, ok := <-sub.Notifications()
+		assert.False(t, ok)
+	}
+	assert.Empty(t, lp.subs)
+}
+
+func TestLogPoller_Subscribe_PollAndSaveLogs(t *testing.T) {
+	th := SetupTH(t, 2, 3, 2)
+	ctx := testutils.Context(t)
+
+	id, err := th.LogPoller.RegisterFilter(Filter{
+		EventSigs: []common.Hash{EmitterABI.Events["Log1"].ID},
+		Addresses: []common.Address{th.EmitterAddress1},
+	})
+	require.NoError(t, err)
+	sub, err := th.LogPoller.Subscribe(id)
+	require.NoError(t, err)
+
+	newStart := th.LogPoller.PollAndSaveLogs(ctx, 1)
+	assert.Equal(t, int64(2), newStart)
+
+	// Chain gen <- 1 <- 2 (L1)
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+	th.Client.Commit()
+
+	newStart = th.LogPoller.PollAndSaveLogs(ctx, newStart)
+	assert.Equal(t, int64(3), newStart)
+	n := <-sub.Notifications()
+	require.Len(t, n.Logs, 1)
+	assert.Equal(t, int64(2), n.Logs[0].BlockNumber)
+	assert.Equal(t, hexutil.MustDecode(`0x0000000000000000000000000000000000000000000000000000000000000001`), n.Logs[0].Data)
+
+	// Chain gen <- 1 <- 2 (L1_1)
+	//                \ 2'(L1_2) <- 3
+	lca, err := th.Client.BlockByNumber(ctx, big.NewInt(1))
+	require.NoError(t, err)
+	require.NoError(t, th.Client.Fork(ctx, lca.Hash()))
+	_, err = th.Emitter1.EmitLog1(th.Owner, []*big.Int{big.NewInt(2)})
+	require.NoError(t, err)
+	th.Client.Commit()
+	th.Client.Commit()
+
+	newStart = th.LogPoller.PollAndSaveLogs(ctx, newStart)
+	assert.Equal(t, int64(4), newStart)
+	assert.Equal(t, Notification{ReorgedFromBlock: 2}, <-sub.Notifications())
+	n = <-sub.Notifications()
+	require.Len(t, n.Logs, 1)
+	assert.Equal(t, int64(2), n.Logs[0].BlockNumber)
+	assert.Equal(t, hexutil.MustDecode(`0x0000000000000000000000000000000000000000000000000000000000000002`), n.Logs[0].Data)
+}
@@ -156,6 +156,22 @@ func (_m *Chain) ID() *big.Int {
 	return r0
 }
 
+// KeyPool provides a mock function with given fields:
+func (_m *Chain) KeyPool() txmgr.EthKeyPool {
+	ret := _m.Called()
+
+	var r0 txmgr.EthKeyPool
+	if rf, ok := ret.Get(0).(func() txmgr.EthKeyPool); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(txmgr.EthKeyPool)
+		}
+	}
+
+	return r0
+}
+
 // LogBroadcaster provides a mock function with given fields:
 func (_m *Chain) LogBroadcaster() log.Broadcaster {
 	ret := _m.Called()
@@ -232,6 +248,20 @@ func (_m *Chain) Start(_a0 context.Context) error {
 	return r0
 }
 
+// StartStandby provides a mock function with given fields: ctx
+func (_m *Chain) StartStandby(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // TxManager provides a mock function with given fields:
 func (_m *Chain) TxManager() txmgr.TxManager {
 	ret := _m.Called()
@@ -395,6 +395,20 @@ func (_m *ChainSet) Start(_a0 context.Context) error {
 	return r0
 }
 
+// StartStandby provides a mock function with given fields: ctx
+func (_m *ChainSet) StartStandby(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateConfig provides a mock function with given fields: id, updaters
 func (_m *ChainSet) UpdateConfig(id *big.Int, updaters ...evm.ChainConfigUpdater) error {
 	_va := make([]interface{}, len(updaters))
@@ -441,6 +441,22 @@ func (_m *Client) NonceAt(ctx context.Context, account common.Address, blockNumb
 	return r0, r1
 }
 
+// OnNodeStateChange provides a mock function with given fields: hook
+func (_m *Client) OnNodeStateChange(hook func(int32, string)) func() {
+	ret := _m.Called(hook)
+
+	var r0 func()
+	if rf, ok := ret.Get(0).(func(func(int32, string)) func()); ok {
+		r0 = rf(hook)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+
+	return r0
+}
+
 // PendingCodeAt provides a mock function with given fields: ctx, account
 func (_m *Client) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
 	ret := _m.Called(ctx, account)
@@ -19,6 +19,8 @@ import (
 	rpc "github.com/ethereum/go-ethereum/rpc"
 
 	types "github.com/ethereum/go-ethereum/core/types"
+
+	time "time"
 )
 
 // Node is an autogenerated mock type for the Node type
@@ -26,6 +28,20 @@ type Node struct {
 	mock.Mock
 }
 
+// AverageLatency provides a mock function with given fields:
+func (_m *Node) AverageLatency() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // BalanceAt provides a mock function with given fields: ctx, account, blockNumber
 func (_m *Node) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
 	ret := _m.Called(ctx, account, blockNumber)
@@ -344,6 +360,20 @@ func (_m *Node) NonceAt(ctx context.Context, account common.Address, blockNumber
 	return r0, r1
 }
 
+// Order provides a mock function with given fields:
+func (_m *Node) Order() int32 {
+	ret := _m.Called()
+
+	var r0 int32
+	if rf, ok := ret.Get(0).(func() int32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	return r0
+}
+
 // PendingCodeAt provides a mock function with given fields: ctx, account
 func (_m *Node) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
 	ret := _m.Called(ctx, account)
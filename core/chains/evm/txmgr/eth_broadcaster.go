@@ -359,6 +359,39 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 	}
 }
 
+// errTotalFeeExceedsMax is returned by checkTotalFee if the total fee of a
+// transaction would exceed the max configured total fee
+var errTotalFeeExceedsMax = errors.New("total fee exceeds max configured total fee")
+
+// checkTotalFee enforces the max gas price in total cost terms on chains which
+// charge an L1 data fee, where the L1 fee usually dominates the L2 execution
+// fee. The L1 fee plus gasPrice * gasLimit may not exceed
+// maxGasPriceWei * gasLimit.
+//
+// It only applies to estimators which implement gas.L1FeeEstimator, i.e. with
+// GAS_ESTIMATOR_MODE=OptimismL1Fee.
+func (eb *EthBroadcaster) checkTotalFee(ctx context.Context, etx EthTx, attempt EthTxAttempt) error {
+	l1FeeEstimator, ok := eb.estimator.(gas.L1FeeEstimator)
+	if !ok {
+		return nil
+	}
+	l1Fee, err := l1FeeEstimator.GetL1Fee(ctx, etx.EncodedPayload)
+	if err != nil {
+		return errors.Wrap(err, "failed to get L1 fee")
+	}
+	gasPrice := attempt.GasPrice
+	if attempt.TxType == 0x2 {
+		gasPrice = attempt.GasFeeCap
+	}
+	maxGasPriceWei := eb.config.KeySpecificMaxGasPriceWei(etx.FromAddress)
+	total := gas.TotalFee(gasPrice.ToInt(), attempt.ChainSpecificGasLimit, l1Fee)
+	max := gas.TotalFee(maxGasPriceWei, attempt.ChainSpecificGasLimit, nil)
+	if total.Cmp(max) > 0 {
+		return errors.Wrapf(errTotalFeeExceedsMax, "total fee of %s Wei (including L1 fee of %s Wei) would exceed max of %s Wei (%s Wei per gas) for key %s", total.String(), l1Fee.String(), max.String(), maxGasPriceWei.String(), etx.FromAddress.Hex())
+	}
+	return nil
+}
+
 // handleInProgressEthTx checks if there is any transaction
 // in_progress and if so, finishes the job
 func (eb *EthBroadcaster) handleAnyInProgressEthTx(ctx context.Context, fromAddress gethCommon.Address) (err error, retryable bool) {
@@ -433,6 +466,14 @@ func (eb *EthBroadcaster) handleInProgressEthTx(ctx context.Context, etx EthTx,
 	}
 	cancel()
 
+	if err = eb.checkTotalFee(ctx, etx, attempt); errors.Is(err, errTotalFeeExceedsMax) {
+		etx.Error = null.StringFrom(err.Error())
+		lgr.Errorw("Total fee exceeds max, fatally erroring transaction.", "err", err)
+		return eb.saveFatallyErroredTransaction(lgr, &etx), true
+	} else if err != nil {
+		return errors.Wrap(err, "handleInProgressEthTx failed on checkTotalFee"), true
+	}
+
 	sendError := sendTransaction(ctx, eb.ethClient, attempt, etx, lgr)
 
 	if sendError.Fatal() {
@@ -646,9 +687,9 @@ func (eb *EthBroadcaster) saveInProgressTransaction(etx *EthTx, attempt *EthTxAt
 	})
 }
 
-// Finds earliest saved transaction that has yet to be broadcast from the given address
+// Finds the highest priority, earliest saved transaction that has yet to be broadcast from the given address
 func findNextUnstartedTransactionFromAddress(db *sqlx.DB, etx *EthTx, fromAddress gethCommon.Address, chainID big.Int) error {
-	err := db.Get(etx, `SELECT * FROM eth_txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
+	err := db.Get(etx, `SELECT * FROM eth_txes WHERE from_address = $1 AND state = 'unstarted' AND evm_chain_id = $2 ORDER BY priority DESC, value ASC, created_at ASC, id ASC`, fromAddress, chainID.String())
 	return errors.Wrap(err, "failed to findNextUnstartedTransactionFromAddress")
 }
 
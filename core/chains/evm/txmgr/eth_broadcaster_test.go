@@ -617,6 +617,102 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_OptimisticLockingOnEthTx(t *testi
 	}
 }
 
+type l1FeeEstimator struct {
+	*gasmocks.Estimator
+	*gasmocks.L1FeeEstimator
+}
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_L1Fee(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewTxmORM(t, db, cfg)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	maxGasPrice := evmcfg.KeySpecificMaxGasPriceWei(fromAddress)
+	gasLimit := uint32(500)
+	maxTotalFee := new(big.Int).Mul(maxGasPrice, big.NewInt(int64(gasLimit)))
+
+	estimator := l1FeeEstimator{gasmocks.NewEstimator(t), gasmocks.NewL1FeeEstimator(t)}
+	estimator.Estimator.On("GetLegacyGas", mock.Anything, mock.Anything, maxGasPrice).Return(assets.GWei(32), gasLimit, nil)
+
+	eb := txmgr.NewEthBroadcaster(
+		db,
+		ethClient,
+		evmcfg,
+		ethKeyStore,
+		&pg.NullEventBroadcaster{},
+		[]ethkey.State{keyState},
+		estimator,
+		nil,
+		logger.TestLogger(t),
+		&testCheckerFactory{},
+	)
+
+	etx := txmgr.EthTx{
+		FromAddress:    fromAddress,
+		ToAddress:      testutils.NewAddress(),
+		EncodedPayload: []byte{42, 42, 0},
+		Value:          *assets.NewEth(0),
+		GasLimit:       500000,
+		State:          txmgr.EthTxUnstarted,
+	}
+	require.NoError(t, borm.InsertEthTx(&etx))
+
+	t.Run("retries if the L1 fee can't be fetched", func(t *testing.T) {
+		estimator.L1FeeEstimator.On("GetL1Fee", mock.Anything, etx.EncodedPayload).Return(nil, errors.New("kaboom")).Once()
+
+		err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "kaboom")
+		assert.True(t, retryable)
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxInProgress, etx.State)
+	})
+
+	t.Run("sends transactions if the total fee is below the max", func(t *testing.T) {
+		estimator.L1FeeEstimator.On("GetL1Fee", mock.Anything, etx.EncodedPayload).Return(assets.GWei(1), nil).Once()
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(0) && tx.GasPrice().Cmp(assets.GWei(32)) == 0
+		})).Return(nil).Once()
+
+		err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+	})
+
+	t.Run("fatally errors transactions if the total fee exceeds the max", func(t *testing.T) {
+		etx := txmgr.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      testutils.NewAddress(),
+			EncodedPayload: []byte{42, 42, 1},
+			Value:          *assets.NewEth(0),
+			GasLimit:       500000,
+			State:          txmgr.EthTxUnstarted,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+		estimator.L1FeeEstimator.On("GetL1Fee", mock.Anything, etx.EncodedPayload).Return(maxTotalFee, nil).Once()
+
+		err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+		require.NoError(t, err)
+		assert.False(t, retryable)
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxFatalError, etx.State)
+		assert.Contains(t, etx.Error.String, "total fee exceeds max configured total fee")
+		assert.Len(t, etx.EthTxAttempts, 0)
+	})
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 	cfg := cltest.NewTestGeneralConfig(t)
@@ -656,6 +752,61 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing
 	}
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Priority(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := cltest.NewTxmORM(t, db, cfg)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState}, &testCheckerFactory{})
+
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+	insertTx := func(payload []byte, priority txmgr.TxPriority, createdAt time.Time) txmgr.EthTx {
+		etx := txmgr.EthTx{
+			FromAddress:    fromAddress,
+			ToAddress:      toAddress,
+			EncodedPayload: payload,
+			Value:          assets.NewEthValue(0),
+			GasLimit:       242,
+			CreatedAt:      createdAt,
+			State:          txmgr.EthTxUnstarted,
+			Priority:       priority,
+		}
+		require.NoError(t, borm.InsertEthTx(&etx))
+		return etx
+	}
+
+	// Inserted in this order, but the high priority txes must be sent first
+	lowTx := insertTx([]byte{1}, txmgr.TxPriorityLow, time.Unix(0, 0))
+	normalTx := insertTx([]byte{2}, txmgr.TxPriorityNormal, time.Unix(1, 0))
+	highTx1 := insertTx([]byte{3}, txmgr.TxPriorityHigh, time.Unix(2, 0))
+	highTx2 := insertTx([]byte{4}, txmgr.TxPriorityHigh, time.Unix(3, 0))
+
+	var sent [][]byte
+	ethClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		sent = append(sent, args.Get(1).(*gethTypes.Transaction).Data())
+	}).Times(4)
+
+	err, retryable := eb.ProcessUnstartedEthTxs(testutils.Context(t), keyState)
+	assert.NoError(t, err)
+	assert.False(t, retryable)
+
+	assert.Equal(t, [][]byte{highTx1.EncodedPayload, highTx2.EncodedPayload, normalTx.EncodedPayload, lowTx.EncodedPayload}, sent)
+
+	for i, etx := range []txmgr.EthTx{highTx1, highTx2, normalTx, lowTx} {
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+		require.NotNil(t, etx.Nonce)
+		assert.Equal(t, int64(i), *etx.Nonce)
+	}
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_ResumingFromCrash(t *testing.T) {
 	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
 	value := assets.NewEthValue(142)
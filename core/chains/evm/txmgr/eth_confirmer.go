@@ -26,6 +26,7 @@ import (
 
 	"github.com/smartcontractkit/sqlx"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/label"
@@ -646,7 +647,9 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []evmtypes.Receipt) (err er
 	// and mined.
 	//
 	// # EthTxes update
-	// Should be self-explanatory. If we got a receipt, the eth_tx is confirmed.
+	// Should be self-explanatory. If we got a receipt, the eth_tx is confirmed,
+	// unless the receipt is for a cancellation attempt, in which case the
+	// eth_tx is cancelled.
 	//
 	var valueStrs []string
 	var valueArgs []interface{}
@@ -679,10 +682,10 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []evmtypes.Receipt) (err er
 			broadcast_before_block_num = COALESCE(eth_tx_attempts.broadcast_before_block_num, inserted_receipts.block_number)
 		FROM inserted_receipts
 		WHERE inserted_receipts.tx_hash = eth_tx_attempts.hash
-		RETURNING eth_tx_attempts.eth_tx_id
+		RETURNING eth_tx_attempts.eth_tx_id, eth_tx_attempts.is_cancellation
 	)
 	UPDATE eth_txes
-	SET state = 'confirmed'
+	SET state = CASE WHEN updated_eth_tx_attempts.is_cancellation THEN 'cancelled'::eth_txes_state ELSE 'confirmed'::eth_txes_state END
 	FROM updated_eth_tx_attempts
 	WHERE updated_eth_tx_attempts.eth_tx_id = eth_txes.id
 	AND evm_chain_id = ?
@@ -722,7 +725,7 @@ UPDATE eth_txes
 SET state = 'confirmed_missing_receipt'
 FROM (
 	SELECT from_address, MAX(nonce) as max_nonce from eth_txes
-	WHERE state = 'confirmed' AND evm_chain_id = $1
+	WHERE state IN ('confirmed', 'cancelled') AND evm_chain_id = $1
 	GROUP BY from_address
 ) AS max_table
 WHERE state = 'unconfirmed'
@@ -925,7 +928,7 @@ func getInProgressEthTxAttempts(ctx context.Context, q pg.Q, lggr logger.Logger,
 	err = qq.Transaction(func(tx pg.Queryer) error {
 		err = tx.Select(&attempts, `
 SELECT eth_tx_attempts.* FROM eth_tx_attempts
-INNER JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_txes.state in ('confirmed', 'confirmed_missing_receipt', 'unconfirmed')
+INNER JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_txes.state in ('confirmed', 'confirmed_missing_receipt', 'unconfirmed', 'cancelled')
 WHERE eth_tx_attempts.state = 'in_progress' AND eth_txes.from_address = $1 AND eth_txes.evm_chain_id = $2
 `, address, chainID.String())
 		if err != nil {
@@ -962,7 +965,8 @@ func loadEthTxes(q pg.Queryer, attempts []EthTxAttempt) error {
 }
 
 // FindEthTxsRequiringRebroadcast returns attempts that hit insufficient eth,
-// and attempts that need bumping, in nonce ASC order
+// attempts that need bumping, and attempts that need to be replaced by a
+// cancellation, in nonce ASC order
 func FindEthTxsRequiringRebroadcast(ctx context.Context, q pg.Q, lggr logger.Logger, address gethCommon.Address, blockNum, gasBumpThreshold, bumpDepth int64, maxInFlightTransactions uint32, chainID big.Int) (etxs []*EthTx, err error) {
 	// NOTE: These two queries could be combined into one using union but it
 	// becomes harder to read and difficult to test in isolation. KISS principle
@@ -999,12 +1003,29 @@ func FindEthTxsRequiringRebroadcast(ctx context.Context, q pg.Q, lggr logger.Log
 		lggr.Infow(fmt.Sprintf("Found %d transactions to re-sent that have still not been confirmed after at least %d blocks. The oldest of these has not still not been confirmed after %d blocks. These transactions will have their gas price bumped. %s", len(etxBumps), gasBumpThreshold, oldestBlocksBehind, label.NodeConnectivityProblemWarning), "blockNum", blockNum, "address", address, "gasBumpThreshold", gasBumpThreshold)
 	}
 
+	etxCancellations, err := FindEthTxsRequiringCancellation(ctx, q, address, chainID)
+	if ctx.Err() != nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(etxCancellations) > 0 {
+		lggr.Infow(fmt.Sprintf("Found %d transactions to be cancelled. These transactions will be replaced by zero-value self-sends at a bumped gas price", len(etxCancellations)), "blockNum", blockNum, "address", address)
+	}
+
 	seen := make(map[int64]struct{})
 
 	for _, etx := range etxInsufficientEths {
 		seen[etx.ID] = struct{}{}
 		etxs = append(etxs, etx)
 	}
+	for _, etx := range etxCancellations {
+		if _, exists := seen[etx.ID]; !exists {
+			seen[etx.ID] = struct{}{}
+			etxs = append(etxs, etx)
+		}
+	}
 	for _, etx := range etxBumps {
 		if _, exists := seen[etx.ID]; !exists {
 			etxs = append(etxs, etx)
@@ -1045,6 +1066,27 @@ ORDER BY nonce ASC
 	return
 }
 
+// FindEthTxsRequiringCancellation returns unconfirmed transactions for which
+// cancellation was requested but no cancellation attempt was created yet
+func FindEthTxsRequiringCancellation(ctx context.Context, q pg.Q, address gethCommon.Address, chainID big.Int) (etxs []*EthTx, err error) {
+	qq := q.WithOpts(pg.WithParentCtx(ctx))
+	err = qq.Transaction(func(tx pg.Queryer) error {
+		err = tx.Select(&etxs, `
+SELECT eth_txes.* FROM eth_txes
+WHERE eth_txes.from_address = $1 AND eth_txes.state = 'unconfirmed' AND eth_txes.evm_chain_id = $2 AND eth_txes.cancel_requested_at IS NOT NULL
+AND NOT EXISTS (SELECT 1 FROM eth_tx_attempts WHERE eth_tx_attempts.eth_tx_id = eth_txes.id AND eth_tx_attempts.is_cancellation)
+ORDER BY nonce ASC
+`, address, chainID.String())
+		if err != nil {
+			return errors.Wrap(err, "FindEthTxsRequiringCancellation failed to load eth_txes")
+		}
+
+		err = loadEthTxesAttempts(tx, etxs)
+		return errors.Wrap(err, "FindEthTxsRequiringCancellation failed to load eth_tx_attempts")
+	}, pg.OptReadOnlyTx())
+	return
+}
+
 func loadEthTxesAttempts(q pg.Queryer, etxs []*EthTx) error {
 	ethTxIDs := make([]int64, len(etxs))
 	ethTxesM := make(map[int64]*EthTx, len(etxs))
@@ -1095,7 +1137,7 @@ func (ec *EthConfirmer) attemptForRebroadcast(ctx context.Context, lggr logger.L
 		previousAttempt := etx.EthTxAttempts[0]
 		previousAttempt.EthTx = etx
 		logFields := ec.logFieldsPreviousAttempt(previousAttempt)
-		if previousAttempt.State == EthTxAttemptInsufficientEth {
+		if previousAttempt.State == EthTxAttemptInsufficientEth && (etx.CancelRequestedAt == nil || previousAttempt.IsCancellation) {
 			// Do not create a new attempt if we ran out of eth last time since bumping gas is pointless
 			// Instead try to resubmit the same attempt at the same price, in the hope that the wallet was funded since our last attempt
 			lggr.Debugw("Rebroadcast InsufficientEth", logFields...)
@@ -1134,25 +1176,33 @@ func (ec *EthConfirmer) logFieldsPreviousAttempt(attempt EthTxAttempt) []interfa
 func (ec *EthConfirmer) bumpGas(previousAttempt EthTxAttempt) (bumpedAttempt EthTxAttempt, err error) {
 	logFields := ec.logFieldsPreviousAttempt(previousAttempt)
 	keySpecificMaxGasPriceWei := ec.config.KeySpecificMaxGasPriceWei(previousAttempt.EthTx.FromAddress)
+	etx := previousAttempt.EthTx
+	if etx.CancelRequestedAt != nil {
+		// Replace the nonce with a zero-value self-send instead of bumping the original payload
+		etx = cancellationEthTx(etx)
+		logFields = append(logFields, "cancellation", true)
+	}
 	switch previousAttempt.TxType {
 	case 0x0: // Legacy
 		var bumpedGasPrice *big.Int
 		var bumpedGasLimit uint32
-		bumpedGasPrice, bumpedGasLimit, err = ec.estimator.BumpLegacyGas(previousAttempt.GasPrice.ToInt(), previousAttempt.EthTx.GasLimit, keySpecificMaxGasPriceWei)
+		bumpedGasPrice, bumpedGasLimit, err = ec.estimator.BumpLegacyGas(previousAttempt.GasPrice.ToInt(), etx.GasLimit, keySpecificMaxGasPriceWei)
 		if err == nil {
 			promNumGasBumps.WithLabelValues(ec.chainID.String()).Inc()
 			ec.lggr.Debugw("Rebroadcast bumping gas for Legacy tx", append(logFields, "bumpedGasPrice", bumpedGasPrice.String())...)
-			return ec.NewLegacyAttempt(previousAttempt.EthTx, bumpedGasPrice, bumpedGasLimit)
+			bumpedAttempt, err = ec.NewLegacyAttempt(etx, bumpedGasPrice, bumpedGasLimit)
+			return withCancellation(bumpedAttempt, previousAttempt.EthTx), err
 		}
 	case 0x2: // EIP1559
 		var bumpedFee gas.DynamicFee
 		var bumpedGasLimit uint32
 		original := previousAttempt.DynamicFee()
-		bumpedFee, bumpedGasLimit, err = ec.estimator.BumpDynamicFee(original, previousAttempt.EthTx.GasLimit, keySpecificMaxGasPriceWei)
+		bumpedFee, bumpedGasLimit, err = ec.estimator.BumpDynamicFee(original, etx.GasLimit, keySpecificMaxGasPriceWei)
 		if err == nil {
 			promNumGasBumps.WithLabelValues(ec.chainID.String()).Inc()
 			ec.lggr.Debugw("Rebroadcast bumping gas for DynamicFee tx", append(logFields, "bumpedTipCap", bumpedFee.TipCap.String(), "bumpedFeeCap", bumpedFee.FeeCap.String())...)
-			return ec.NewDynamicFeeAttempt(previousAttempt.EthTx, bumpedFee, bumpedGasLimit)
+			bumpedAttempt, err = ec.NewDynamicFeeAttempt(etx, bumpedFee, bumpedGasLimit)
+			return withCancellation(bumpedAttempt, previousAttempt.EthTx), err
 		}
 	default:
 		err = errors.Errorf("invariant violation: Attempt %v had unrecognised transaction type %v"+
@@ -1166,6 +1216,26 @@ func (ec *EthConfirmer) bumpGas(previousAttempt EthTxAttempt) (bumpedAttempt Eth
 	return bumpedAttempt, errors.Wrap(err, "error bumping gas")
 }
 
+// cancellationEthTx returns a copy of etx that sends zero value to its own
+// from address with no data, used to replace the nonce of a cancelled eth_tx.
+// The gas limit of the original eth_tx is kept, it is always sufficient for a
+// plain transfer.
+func cancellationEthTx(etx EthTx) EthTx {
+	etx.ToAddress = etx.FromAddress
+	etx.Value = assets.NewEthValue(0)
+	etx.EncodedPayload = []byte{}
+	etx.AccessList = NullableEIP2930AccessList{}
+	return etx
+}
+
+// withCancellation marks attempt as a cancellation attempt if cancellation
+// was requested for etx, and restores the original eth_tx on the attempt.
+func withCancellation(attempt EthTxAttempt, etx EthTx) EthTxAttempt {
+	attempt.EthTx = etx
+	attempt.IsCancellation = etx.CancelRequestedAt != nil
+	return attempt
+}
+
 // saveInProgressAttempt inserts or updates an attempt
 func (ec *EthConfirmer) saveInProgressAttempt(attempt *EthTxAttempt) error {
 	if attempt.State != EthTxAttemptInProgress {
@@ -1439,7 +1509,7 @@ func findTransactionsConfirmedInBlockRange(q pg.Q, lggr logger.Logger, highBlock
 SELECT DISTINCT eth_txes.* FROM eth_txes
 INNER JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_tx_attempts.state = 'broadcast'
 INNER JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
-WHERE eth_txes.state IN ('confirmed', 'confirmed_missing_receipt') AND block_number BETWEEN $1 AND $2 AND evm_chain_id = $3
+WHERE eth_txes.state IN ('confirmed', 'confirmed_missing_receipt', 'cancelled') AND block_number BETWEEN $1 AND $2 AND evm_chain_id = $3
 ORDER BY nonce ASC
 `, lowBlockNumber, highBlockNumber, chainID.String())
 		if err != nil {
@@ -1520,8 +1590,8 @@ AND eth_tx_attempts.eth_tx_id = $1
 }
 
 func unconfirmEthTx(q pg.Queryer, etx EthTx) error {
-	if etx.State != EthTxConfirmed {
-		return errors.New("expected eth_tx state to be confirmed")
+	if etx.State != EthTxConfirmed && etx.State != EthTxCancelled {
+		return errors.New("expected eth_tx state to be confirmed or cancelled")
 	}
 	_, err := q.Exec(`UPDATE eth_txes SET state = 'unconfirmed' WHERE id = $1`, etx.ID)
 	return errors.Wrap(err, "unconfirmEthTx failed")
@@ -1614,13 +1684,14 @@ func (ec *EthConfirmer) ResumePendingTaskRuns(ctx context.Context, head *evmtype
 		ID           uuid.UUID        `db:"id"`
 		Receipt      evmtypes.Receipt `db:"receipt"`
 		FailOnRevert bool             `db:"FailOnRevert"`
+		State        EthTxState       `db:"state"`
 	}
 	var receipts []x
 
 	// NOTE: we don't filter on eth_txes.state = 'confirmed', because a transaction with an attached receipt
 	// is guaranteed to be confirmed. This results in a slightly better query plan.
 	if err := ec.q.SelectContext(ctx, &receipts, `
-	SELECT pipeline_task_runs.id, eth_receipts.receipt, COALESCE((eth_txes.meta->>'FailOnRevert')::boolean, false) "FailOnRevert" FROM pipeline_task_runs
+	SELECT pipeline_task_runs.id, eth_receipts.receipt, COALESCE((eth_txes.meta->>'FailOnRevert')::boolean, false) "FailOnRevert", eth_txes.state FROM pipeline_task_runs
 	INNER JOIN pipeline_runs ON pipeline_runs.id = pipeline_task_runs.pipeline_run_id
 	INNER JOIN eth_txes ON eth_txes.pipeline_task_run_id = pipeline_task_runs.id
 	INNER JOIN eth_tx_attempts ON eth_txes.id = eth_tx_attempts.eth_tx_id
@@ -1638,7 +1709,9 @@ func (ec *EthConfirmer) ResumePendingTaskRuns(ctx context.Context, head *evmtype
 	for _, data := range receipts {
 		var taskErr error
 		var output interface{}
-		if data.FailOnRevert && data.Receipt.Status == 0 {
+		if data.State == EthTxCancelled {
+			taskErr = errors.Errorf("transaction was cancelled, nonce was consumed by %s", data.Receipt.TxHash)
+		} else if data.FailOnRevert && data.Receipt.Status == 0 {
 			taskErr = errors.Errorf("transaction %s reverted on-chain", data.Receipt.TxHash)
 		} else {
 			output = data.Receipt
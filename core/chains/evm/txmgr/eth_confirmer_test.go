@@ -2390,6 +2390,81 @@ func TestEthConfirmer_RebroadcastWhereNecessary_TerminallyUnderpriced_ThenGoesTh
 	})
 }
 
+func TestEthConfirmer_RebroadcastWhereNecessary_Cancellation(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	borm := cltest.NewTxmORM(t, db, cfg)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+	keys := []ethkey.State{state}
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	ec := cltest.NewEthConfirmer(t, db, ethClient, evmcfg, ethKeyStore, keys, nil)
+
+	currentHead := int64(30)
+	nonce := int64(0)
+
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, nonce, fromAddress)
+	originalAttempt := etx.EthTxAttempts[0]
+	// Not old enough to be bumped, so only the cancellation request triggers a new attempt
+	require.NoError(t, db.Get(&originalAttempt, `UPDATE eth_tx_attempts SET broadcast_before_block_num=$1 WHERE id=$2 RETURNING *`, currentHead, originalAttempt.ID))
+	_, err := db.Exec(`UPDATE eth_txes SET cancel_requested_at = NOW() WHERE id = $1`, etx.ID)
+	require.NoError(t, err)
+
+	t.Run("replaces the eth_tx with a zero-value self-send at a bumped gas price", func(t *testing.T) {
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(nonce) &&
+				*tx.To() == fromAddress &&
+				tx.Value().Sign() == 0 &&
+				len(tx.Data()) == 0 &&
+				tx.GasPrice().Cmp(originalAttempt.GasPrice.ToInt()) > 0
+		})).Return(nil).Once()
+
+		require.NoError(t, ec.RebroadcastWhereNecessary(testutils.Context(t), currentHead))
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+		require.Len(t, etx.EthTxAttempts, 2)
+		cancellationAttempt := etx.EthTxAttempts[0]
+		assert.True(t, cancellationAttempt.IsCancellation)
+		assert.Equal(t, txmgr.EthTxAttemptBroadcast, cancellationAttempt.State)
+		assert.False(t, etx.EthTxAttempts[1].IsCancellation)
+	})
+
+	t.Run("does not create another cancellation attempt if one exists", func(t *testing.T) {
+		require.NoError(t, ec.RebroadcastWhereNecessary(testutils.Context(t), currentHead))
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		require.Len(t, etx.EthTxAttempts, 2)
+	})
+
+	t.Run("marks the eth_tx as cancelled once the cancellation attempt is mined", func(t *testing.T) {
+		cancellationAttempt := etx.EthTxAttempts[0]
+		txmReceipt := newTxReceipt(cancellationAttempt.Hash, 42, 0)
+
+		ethClient.On("NonceAt", mock.Anything, mock.Anything, mock.Anything).Return(uint64(nonce+1), nil).Once()
+		ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+			return len(b) == 2 &&
+				cltest.BatchElemMatchesParams(b[0], cancellationAttempt.Hash, "eth_getTransactionReceipt") &&
+				cltest.BatchElemMatchesParams(b[1], originalAttempt.Hash, "eth_getTransactionReceipt")
+		})).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			elems[0].Result = &txmReceipt
+			elems[1].Result = &evmtypes.Receipt{}
+		}).Once()
+
+		require.NoError(t, ec.CheckForReceipts(testutils.Context(t), currentHead))
+
+		mustTxBeInState(t, borm, etx, txmgr.EthTxCancelled)
+	})
+}
+
 func TestEthConfirmer_RebroadcastWhereNecessary_WhenOutOfEth(t *testing.T) {
 	t.Parallel()
 
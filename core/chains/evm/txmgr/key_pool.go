@@ -0,0 +1,158 @@
+package txmgr
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// EthKeyPool balances transactions across the sending keys of a chain
+//
+//go:generate mockery --name EthKeyPool --output ./mocks/ --case=underscore
+type EthKeyPool interface {
+	// LeastLoadedAddress returns the enabled key with the fewest pending
+	// eth_txes, restricted to addresses if any are given. Keys which are known
+	// to have a zero balance are only returned if no funded key is available.
+	LeastLoadedAddress(ctx context.Context, addresses ...common.Address) (common.Address, error)
+}
+
+// EthBalanceGetter returns the last known balance of an address, or nil if it
+// is not known (e.g. monitor.BalanceMonitor)
+type EthBalanceGetter interface {
+	GetEthBalance(address common.Address) *assets.Eth
+}
+
+// ErrNoEnabledKeys is returned by EthKeyPool if no enabled key is available
+var ErrNoEnabledKeys = errors.New("no enabled sending keys available")
+
+var _ EthKeyPool = &ethKeyPool{}
+
+type ethKeyPool struct {
+	q        pg.Q
+	keyStore KeyStore
+	balances EthBalanceGetter
+	chainID  big.Int
+	logger   logger.Logger
+}
+
+type keyLoad struct {
+	address common.Address
+	pending int64
+	balance *assets.Eth
+}
+
+// NewEthKeyPool creates a new EthKeyPool. balances may be nil, if balances are
+// not monitored for the chain.
+func NewEthKeyPool(db *sqlx.DB, keyStore KeyStore, balances EthBalanceGetter, chainID big.Int, cfg pg.LogConfig, lggr logger.Logger) EthKeyPool {
+	namedLogger := lggr.Named("EthKeyPool")
+	return &ethKeyPool{
+		q:        pg.NewQ(db, namedLogger, cfg),
+		keyStore: keyStore,
+		balances: balances,
+		chainID:  chainID,
+		logger:   namedLogger,
+	}
+}
+
+func (p *ethKeyPool) LeastLoadedAddress(ctx context.Context, addresses ...common.Address) (common.Address, error) {
+	states, err := p.keyStore.GetStatesForChain(&p.chainID)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "EthKeyPool failed to get key states")
+	}
+
+	var loads []keyLoad
+	for _, s := range states {
+		if s.Disabled || !containsAddress(addresses, s.Address.Address()) {
+			continue
+		}
+		load := keyLoad{address: s.Address.Address()}
+		if p.balances != nil {
+			load.balance = p.balances.GetEthBalance(load.address)
+		}
+		loads = append(loads, load)
+	}
+	if len(loads) == 0 {
+		return common.Address{}, errors.Wrapf(ErrNoEnabledKeys, "chain %s, addresses %v", p.chainID.String(), addresses)
+	}
+
+	pending, err := p.pendingEthTxCounts(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	for i := range loads {
+		loads[i].pending = pending[loads[i].address]
+	}
+
+	sort.SliceStable(loads, func(i, j int) bool {
+		if a, b := loads[i].isUnfunded(), loads[j].isUnfunded(); a != b {
+			return b
+		}
+		if loads[i].pending != loads[j].pending {
+			return loads[i].pending < loads[j].pending
+		}
+		return loads[i].hasMoreFundsThan(loads[j])
+	})
+
+	if loads[0].isUnfunded() {
+		p.logger.Warnw("All sending keys have a zero balance, transactions may not be sent", "chainID", p.chainID.String(), "addresses", addresses)
+	}
+	return loads[0].address, nil
+}
+
+// pendingEthTxCounts returns the number of eth_txes not yet confirmed on chain
+// for each from address
+func (p *ethKeyPool) pendingEthTxCounts(ctx context.Context) (map[common.Address]int64, error) {
+	var rows []struct {
+		FromAddress common.Address
+		Count       int64
+	}
+	err := p.q.WithOpts(pg.WithParentCtx(ctx)).Select(&rows, `
+SELECT from_address, count(*) FROM eth_txes
+WHERE evm_chain_id = $1 AND state IN ('unstarted', 'in_progress', 'unconfirmed')
+GROUP BY from_address`, p.chainID.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "EthKeyPool failed to count pending eth_txes")
+	}
+	counts := make(map[common.Address]int64, len(rows))
+	for _, r := range rows {
+		counts[r.FromAddress] = r.Count
+	}
+	return counts, nil
+}
+
+func (l keyLoad) isUnfunded() bool {
+	return l.balance != nil && l.balance.IsZero()
+}
+
+// hasMoreFundsThan returns true if l has a known balance, which is greater
+// than the balance of other
+func (l keyLoad) hasMoreFundsThan(other keyLoad) bool {
+	if l.balance == nil {
+		return false
+	}
+	if other.balance == nil {
+		return true
+	}
+	return l.balance.Cmp(other.balance) > 0
+}
+
+// containsAddress returns true if addresses is empty or contains address
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
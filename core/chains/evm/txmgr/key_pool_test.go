@@ -0,0 +1,83 @@
+package txmgr_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+type balances map[common.Address]*assets.Eth
+
+func (b balances) GetEthBalance(address common.Address) *assets.Eth {
+	return b[address]
+}
+
+func TestEthKeyPool_LeastLoadedAddress(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	borm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	ctx := testutils.Context(t)
+
+	_, addr1 := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, addr2 := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, disabledAddr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	require.NoError(t, ethKeyStore.Disable(disabledAddr, &cltest.FixtureChainID))
+
+	one, zero := assets.NewEthValue(1), assets.NewEthValue(0)
+
+	// addr1 has two pending eth_txes, addr2 one
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, addr1)
+	mustInsertUnstartedEthTx(t, borm, addr1)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, addr2)
+	// confirmed eth_txes do not count
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 1, 1, addr2)
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 2, 1, addr2)
+
+	t.Run("picks the key with the fewest pending eth_txes", func(t *testing.T) {
+		pool := txmgr.NewEthKeyPool(db, ethKeyStore, nil, cltest.FixtureChainID, cfg, logger.TestLogger(t))
+
+		addr, err := pool.LeastLoadedAddress(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, addr2, addr)
+
+		addr, err = pool.LeastLoadedAddress(ctx, addr1)
+		require.NoError(t, err)
+		assert.Equal(t, addr1, addr)
+	})
+
+	t.Run("skips keys with a zero balance", func(t *testing.T) {
+		pool := txmgr.NewEthKeyPool(db, ethKeyStore, balances{
+			addr1: &one,
+			addr2: &zero,
+		}, cltest.FixtureChainID, cfg, logger.TestLogger(t))
+
+		addr, err := pool.LeastLoadedAddress(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, addr1, addr)
+
+		// unless there is no funded key
+		addr, err = pool.LeastLoadedAddress(ctx, addr2)
+		require.NoError(t, err)
+		assert.Equal(t, addr2, addr)
+	})
+
+	t.Run("never picks disabled keys", func(t *testing.T) {
+		pool := txmgr.NewEthKeyPool(db, ethKeyStore, nil, cltest.FixtureChainID, cfg, logger.TestLogger(t))
+
+		_, err := pool.LeastLoadedAddress(ctx, disabledAddr)
+		require.ErrorIs(t, err, txmgr.ErrNoEnabledKeys)
+	})
+}
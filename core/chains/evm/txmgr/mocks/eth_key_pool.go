@@ -0,0 +1,60 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	common "github.com/ethereum/go-ethereum/common"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EthKeyPool is an autogenerated mock type for the EthKeyPool type
+type EthKeyPool struct {
+	mock.Mock
+}
+
+// LeastLoadedAddress provides a mock function with given fields: ctx, addresses
+func (_m *EthKeyPool) LeastLoadedAddress(ctx context.Context, addresses ...common.Address) (common.Address, error) {
+	_va := make([]interface{}, len(addresses))
+	for _i := range addresses {
+		_va[_i] = addresses[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 common.Address
+	if rf, ok := ret.Get(0).(func(context.Context, ...common.Address) common.Address); ok {
+		r0 = rf(ctx, addresses...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Address)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...common.Address) error); ok {
+		r1 = rf(ctx, addresses...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewEthKeyPool interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewEthKeyPool creates a new instance of EthKeyPool. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewEthKeyPool(t mockConstructorTestingTNewEthKeyPool) *EthKeyPool {
+	mock := &EthKeyPool{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
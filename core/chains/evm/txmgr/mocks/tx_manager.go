@@ -27,6 +27,20 @@ type TxManager struct {
 	mock.Mock
 }
 
+// CancelEthTx provides a mock function with given fields: etxID
+func (_m *TxManager) CancelEthTx(etxID int64) error {
+	ret := _m.Called(etxID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(etxID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *TxManager) Close() error {
 	ret := _m.Called()
@@ -7,6 +7,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/satori/go.uuid"
+
+	txmgr "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 )
 
 // TxStrategy is an autogenerated mock type for the TxStrategy type
@@ -14,6 +16,20 @@ type TxStrategy struct {
 	mock.Mock
 }
 
+// Priority provides a mock function with given fields:
+func (_m *TxStrategy) Priority() txmgr.TxPriority {
+	ret := _m.Called()
+
+	var r0 txmgr.TxPriority
+	if rf, ok := ret.Get(0).(func() txmgr.TxPriority); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(txmgr.TxPriority)
+	}
+
+	return r0
+}
+
 // PruneQueue provides a mock function with given fields: q
 func (_m *TxStrategy) PruneQueue(q pg.Queryer) (int64, error) {
 	ret := _m.Called(q)
@@ -83,6 +83,8 @@ const (
 	EthTxUnconfirmed             = EthTxState("unconfirmed")
 	EthTxConfirmed               = EthTxState("confirmed")
 	EthTxConfirmedMissingReceipt = EthTxState("confirmed_missing_receipt")
+	// EthTxCancelled is terminal, the nonce of the eth_tx was consumed by a zero-value self-send instead
+	EthTxCancelled = EthTxState("cancelled")
 
 	EthTxAttemptInProgress      = EthTxAttemptState("in_progress")
 	EthTxAttemptInsufficientEth = EthTxAttemptState("insufficient_eth")
@@ -192,6 +194,14 @@ type EthTx struct {
 	// TransmitChecker defines the check that should be performed before a transaction is submitted on
 	// chain.
 	TransmitChecker *datatypes.JSON
+
+	// Priority orders unstarted eth_txes of the same from address. Higher
+	// priority eth_txes are broadcast first.
+	Priority TxPriority
+
+	// CancelRequestedAt is set once cancellation of an unconfirmed eth_tx was requested. From then
+	// on the EthConfirmer only creates cancellation attempts for it.
+	CancelRequestedAt *time.Time
 }
 
 func (e EthTx) GetError() error {
@@ -297,6 +307,8 @@ type EthTxAttempt struct {
 	State                   EthTxAttemptState
 	EthReceipts             []EthReceipt `json:"-"`
 	TxType                  int
+	// IsCancellation is true if this attempt is a zero-value self-send replacing the eth_tx's nonce
+	IsCancellation bool
 }
 
 // GetSignedTx decodes the SignedRawTx into a types.Transaction struct
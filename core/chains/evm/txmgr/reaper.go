@@ -116,7 +116,7 @@ func (r *Reaper) ReapEthTxes(headNum int64) error {
 
 	r.log.Debugw(fmt.Sprintf("TxmReaper: reaping old eth_txes created before %s", timeThreshold.Format(time.RFC3339)), "ageThreshold", threshold, "timeThreshold", timeThreshold, "minBlockNumberToKeep", minBlockNumberToKeep)
 
-	// Delete old confirmed eth_txes
+	// Delete old confirmed or cancelled eth_txes
 	// NOTE that this relies on foreign key triggers automatically removing
 	// the eth_tx_attempts and eth_receipts linked to every eth_tx
 	err := pg.Batch(func(_, limit uint) (count uint, err error) {
@@ -132,7 +132,7 @@ USING old_enough_receipts, eth_tx_attempts
 WHERE eth_tx_attempts.eth_tx_id = eth_txes.id
 AND eth_tx_attempts.hash = old_enough_receipts.tx_hash
 AND eth_txes.created_at < $3
-AND eth_txes.state = 'confirmed'
+AND eth_txes.state IN ('confirmed', 'cancelled')
 AND evm_chain_id = $4`, minBlockNumberToKeep, limit, timeThreshold, r.chainID)
 		if err != nil {
 			return count, errors.Wrap(err, "ReapEthTxes failed to delete old confirmed eth_txes")
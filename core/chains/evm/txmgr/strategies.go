@@ -14,8 +14,23 @@ type TxStrategy interface {
 	Subject() uuid.NullUUID
 	// PruneQueue is called after eth_tx insertion
 	PruneQueue(q pg.Queryer) (n int64, err error)
+	// Priority will be saved to eth_txes.priority unless overridden by NewTx
+	Priority() TxPriority
 }
 
+// TxPriority orders the unstarted transactions of a from address. Transactions with a higher
+// priority are broadcast first, transactions with equal priority in insertion order.
+type TxPriority int32
+
+const (
+	// TxPriorityLow is for transactions which may be delayed by everything else, e.g. keeper upkeeps
+	TxPriorityLow TxPriority = -100
+	// TxPriorityNormal is the default priority
+	TxPriorityNormal TxPriority = 0
+	// TxPriorityHigh is for time-critical transactions, e.g. OCR transmissions
+	TxPriorityHigh TxPriority = 100
+)
+
 var _ TxStrategy = SendEveryStrategy{}
 
 // NewQueueingTxStrategy creates a new TxStrategy that drops the oldest transactions after the
@@ -39,6 +54,7 @@ type SendEveryStrategy struct{}
 
 func (SendEveryStrategy) Subject() uuid.NullUUID               { return uuid.NullUUID{} }
 func (SendEveryStrategy) PruneQueue(pg.Queryer) (int64, error) { return 0, nil }
+func (SendEveryStrategy) Priority() TxPriority                 { return TxPriorityNormal }
 
 var _ TxStrategy = DropOldestStrategy{}
 
@@ -59,6 +75,10 @@ func (s DropOldestStrategy) Subject() uuid.NullUUID {
 	return uuid.NullUUID{UUID: s.subject, Valid: true}
 }
 
+func (s DropOldestStrategy) Priority() TxPriority {
+	return TxPriorityNormal
+}
+
 func (s DropOldestStrategy) PruneQueue(q pg.Queryer) (n int64, err error) {
 	ctx, cancel := pg.DefaultQueryCtx()
 	defer cancel()
@@ -79,3 +99,22 @@ id < (
 	}
 	return res.RowsAffected()
 }
+
+var _ TxStrategy = PriorityStrategy{}
+
+// PriorityStrategy queues and prunes transactions like the wrapped TxStrategy,
+// but with the given priority
+type PriorityStrategy struct {
+	TxStrategy
+	priority TxPriority
+}
+
+// NewPriorityStrategy creates a new TxStrategy that gives all transactions of strategy the
+// given priority.
+func NewPriorityStrategy(strategy TxStrategy, priority TxPriority) PriorityStrategy {
+	return PriorityStrategy{strategy, priority}
+}
+
+func (s PriorityStrategy) Priority() TxPriority {
+	return s.priority
+}
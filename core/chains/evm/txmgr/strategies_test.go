@@ -24,6 +24,18 @@ func Test_SendEveryStrategy(t *testing.T) {
 	assert.Equal(t, int64(0), n)
 }
 
+func Test_PriorityStrategy(t *testing.T) {
+	t.Parallel()
+
+	subject := uuid.NewV4()
+	s := txmgr.NewPriorityStrategy(txmgr.NewDropOldestStrategy(subject, 1), txmgr.TxPriorityHigh)
+
+	assert.Equal(t, txmgr.TxPriorityHigh, s.Priority())
+	assert.Equal(t, subject, s.Subject().UUID)
+	assert.Equal(t, txmgr.TxPriorityNormal, txmgr.SendEveryStrategy{}.Priority())
+	assert.Equal(t, txmgr.TxPriorityNormal, txmgr.NewDropOldestStrategy(subject, 1).Priority())
+}
+
 func Test_DropOldestStrategy_Subject(t *testing.T) {
 	t.Parallel()
 
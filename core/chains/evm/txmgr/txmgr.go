@@ -68,6 +68,9 @@ type KeyStore interface {
 
 var _ TxManager = &Txm{}
 
+// ErrEthTxNotCancellable is returned by CancelEthTx for eth_txes which are not unconfirmed
+var ErrEthTxNotCancellable = errors.New("only unconfirmed transactions can be cancelled")
+
 // ResumeCallback is assumed to be idempotent
 type ResumeCallback func(id uuid.UUID, result interface{}, err error) error
 
@@ -82,6 +85,7 @@ type TxManager interface {
 	RegisterResumeCallback(fn ResumeCallback)
 	SendEther(chainID *big.Int, from, to common.Address, value assets.Eth, gasLimit uint32) (etx EthTx, err error)
 	Reset(f func(), addr common.Address, abandon bool) error
+	CancelEthTx(etxID int64) error
 }
 
 type reset struct {
@@ -258,6 +262,27 @@ func (b *Txm) abandon(addr common.Address) (err error) {
 	return errors.Wrapf(err, "abandon failed to update eth_txes for key %s", addr.Hex())
 }
 
+// CancelEthTx requests cancellation of an unconfirmed eth_tx. On the next head
+// the EthConfirmer replaces its nonce with a zero-value self-send at a bumped
+// gas price, and marks the eth_tx as cancelled once the self-send is mined.
+// The original transaction may still be mined first, in which case the eth_tx
+// is confirmed as usual.
+func (b *Txm) CancelEthTx(etxID int64) error {
+	var state EthTxState
+	err := b.q.Get(&state, `UPDATE eth_txes SET cancel_requested_at = COALESCE(cancel_requested_at, NOW()) WHERE id = $1 AND evm_chain_id = $2 AND state = 'unconfirmed' RETURNING state`, etxID, b.chainID.String())
+	if errors.Is(err, sql.ErrNoRows) {
+		if err = b.q.Get(&state, `SELECT state FROM eth_txes WHERE id = $1 AND evm_chain_id = $2`, etxID, b.chainID.String()); err != nil {
+			return errors.Wrapf(err, "CancelEthTx failed to load eth_tx %d", etxID)
+		}
+		return errors.Wrapf(ErrEthTxNotCancellable, "eth_tx %d is %s", etxID, state)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "CancelEthTx failed to update eth_tx %d", etxID)
+	}
+	b.logger.Infow("Cancellation requested for transaction", "ethTxID", etxID)
+	return nil
+}
+
 func (b *Txm) Close() (merr error) {
 	return b.StopOnce("Txm", func() error {
 		close(b.chStop)
@@ -464,6 +489,9 @@ type NewTx struct {
 
 	Strategy TxStrategy
 
+	// Priority overrides the priority given by Strategy, if set.
+	Priority *TxPriority
+
 	// Checker defines the check that should be run before a transaction is submitted on chain.
 	Checker TransmitCheckerSpec
 }
@@ -499,6 +527,11 @@ func (b *Txm) CreateEthTransaction(newTx NewTx, qs ...pg.QOpt) (etx EthTx, err e
 		return etx, errors.Wrap(err, "Txm#CreateEthTransaction")
 	}
 
+	priority := newTx.Strategy.Priority()
+	if newTx.Priority != nil {
+		priority = *newTx.Priority
+	}
+
 	value := 0
 	err = q.Transaction(func(tx pg.Queryer) error {
 		if newTx.PipelineTaskRunID != nil {
@@ -513,12 +546,12 @@ func (b *Txm) CreateEthTransaction(newTx NewTx, qs ...pg.QOpt) (etx EthTx, err e
 			}
 		}
 		err := tx.Get(&etx, `
-INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker)
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, transmit_checker, priority)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11
+$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11,$12
 )
 RETURNING "eth_txes".*
-`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Checker)
+`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Checker, priority)
 		if err != nil {
 			return errors.Wrap(err, "Txm#CreateEthTransaction failed to insert eth_tx")
 		}
@@ -648,8 +681,8 @@ func makeEmptyTransaction(keyStore KeyStore, nonce uint64, gasLimit uint32, gasP
 }
 
 const insertIntoEthTxAttemptsQuery = `
-INSERT INTO eth_tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap)
-VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap)
+INSERT INTO eth_tx_attempts (eth_tx_id, gas_price, signed_raw_tx, hash, broadcast_before_block_num, state, created_at, chain_specific_gas_limit, tx_type, gas_tip_cap, gas_fee_cap, is_cancellation)
+VALUES (:eth_tx_id, :gas_price, :signed_raw_tx, :hash, :broadcast_before_block_num, :state, NOW(), :chain_specific_gas_limit, :tx_type, :gas_tip_cap, :gas_fee_cap, :is_cancellation)
 RETURNING *;
 `
 
@@ -733,6 +766,11 @@ func (n *NullTxManager) Reset(f func(), addr common.Address, abandon bool) error
 	return nil
 }
 
+// CancelEthTx does nothing, null functionality
+func (n *NullTxManager) CancelEthTx(etxID int64) error {
+	return errors.New(n.ErrMsg)
+}
+
 // SendEther does nothing, null functionality
 func (n *NullTxManager) SendEther(chainID *big.Int, from, to common.Address, value assets.Eth, gasLimit uint32) (etx EthTx, err error) {
 	return etx, errors.New(n.ErrMsg)
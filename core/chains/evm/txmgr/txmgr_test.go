@@ -2,6 +2,7 @@ package txmgr_test
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -54,7 +55,7 @@ func TestTxm_SendEther_DoesNotSendToZero(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	checkerFactory := &testCheckerFactory{}
 	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)),
-		ethClient, lggr, 100*time.Millisecond, 2, 3, 2)
+		ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 0)
 	txm := txmgr.NewTxm(db, ethClient, config, nil, nil, lggr, checkerFactory, lp)
 
 	_, err := txm.SendEther(big.NewInt(0), from, to, *value, 21000)
@@ -220,7 +221,7 @@ func TestTxm_CreateEthTransaction(t *testing.T) {
 	lggr := logger.TestLogger(t)
 	checkerFactory := &testCheckerFactory{}
 	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)),
-		ethClient, lggr, 100*time.Millisecond, 2, 3, 2)
+		ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 0)
 	txm := txmgr.NewTxm(db, ethClient, config, kst.Eth(), nil, lggr, checkerFactory, lp)
 
 	t.Run("with queue under capacity inserts eth_tx", func(t *testing.T) {
@@ -501,7 +502,7 @@ func TestTxm_CreateEthTransaction_OutOfEth(t *testing.T) {
 	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
 	lggr := logger.TestLogger(t)
 	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)),
-		ethClient, lggr, 100*time.Millisecond, 2, 3, 2)
+		ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 0)
 	kst := cltest.NewKeyStore(t, db, cfg)
 	txm := txmgr.NewTxm(db, ethClient, config, kst.Eth(), nil, lggr, &testCheckerFactory{}, lp)
 
@@ -598,7 +599,7 @@ func TestTxm_Lifecycle(t *testing.T) {
 	checkerFactory := &testCheckerFactory{}
 
 	lp := logpoller.NewLogPoller(logpoller.NewORM(testutils.FixtureChainID, db, lggr, pgtest.NewPGCfg(true)),
-		ethClient, lggr, 100*time.Millisecond, 2, 3, 2)
+		ethClient, lggr, 100*time.Millisecond, 2, 3, 2, 0)
 	txm := txmgr.NewTxm(db, ethClient, config, kst, eventBroadcaster, lggr, checkerFactory, lp)
 
 	head := cltest.Head(42)
@@ -765,6 +766,54 @@ func TestTxm_Reset(t *testing.T) {
 	})
 }
 
+func TestTxm_CancelEthTx(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	gcfg := configtest.NewTestGeneralConfig(t)
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+	kst := cltest.NewKeyStore(t, db, cfg)
+	_, addr := cltest.MustInsertRandomKey(t, kst.Eth(), 0)
+	borm := cltest.NewTxmORM(t, db, cfg)
+
+	ethClient := evmtest.NewEthClientMockWithDefaultChain(t)
+	txm := txmgr.NewTxm(db, ethClient, cfg, kst.Eth(), nil, logger.TestLogger(t), nil, nil)
+
+	t.Run("requests cancellation of unconfirmed eth_tx", func(t *testing.T) {
+		etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, addr)
+
+		require.NoError(t, txm.CancelEthTx(etx.ID))
+
+		etx, err := borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgr.EthTxUnconfirmed, etx.State)
+		require.NotNil(t, etx.CancelRequestedAt)
+
+		// requesting again does not move the request time
+		requestedAt := *etx.CancelRequestedAt
+		require.NoError(t, txm.CancelEthTx(etx.ID))
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, requestedAt, *etx.CancelRequestedAt)
+	})
+
+	t.Run("refuses to cancel eth_tx which is not unconfirmed", func(t *testing.T) {
+		etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 0, 1, addr)
+
+		err := txm.CancelEthTx(etx.ID)
+		require.ErrorIs(t, err, txmgr.ErrEthTxNotCancellable)
+
+		etx, err = borm.FindEthTxWithAttempts(etx.ID)
+		require.NoError(t, err)
+		assert.Nil(t, etx.CancelRequestedAt)
+	})
+
+	t.Run("returns error for unknown eth_tx", func(t *testing.T) {
+		err := txm.CancelEthTx(-1)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
+
 func TestTxmgr_AssignsNonceOnStart(t *testing.T) {
 	var err error
 	db := pgtest.NewSqlxDB(t)
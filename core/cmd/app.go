@@ -251,6 +251,44 @@ func NewApp(client *Client) *cli.App {
 			},
 		},
 
+		{
+			Name:  "bhs",
+			Usage: "Commands for blockhash store jobs",
+			Subcommands: []cli.Command{
+				{
+					Name:   "backfill",
+					Usage:  "Stores the blockhashes of blocks with unfulfilled VRF requests in a range, e.g. after the node was down",
+					Action: client.BackfillBlockhashes,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "job-id",
+							Usage:    "ID of the blockhash store job whose coordinators, blockhash store and sending key are used",
+							Required: true,
+						},
+						cli.Uint64Flag{
+							Name:     "from",
+							Usage:    "Lowest block number to backfill",
+							Required: true,
+						},
+						cli.Uint64Flag{
+							Name:     "to",
+							Usage:    "Highest block number to backfill, the backfill proceeds backwards from it",
+							Required: true,
+						},
+						cli.Uint64Flag{
+							Name:  "batch-size",
+							Usage: "Number of blocks scanned, and of headers verified, per batch",
+							Value: 1000,
+						},
+						cli.Uint64Flag{
+							Name:  "anchor",
+							Usage: "(optional) anchor block reported by an interrupted backfill, to resume it",
+						},
+					},
+				},
+			},
+		},
+
 		{
 			Name:    "blocks",
 			Aliases: []string{},
@@ -272,6 +310,25 @@ func NewApp(client *Client) *cli.App {
 						},
 					},
 				},
+				{
+					Name:   "reorged",
+					Usage:  "Lists the logs which jobs consumed before they were removed by a reorg",
+					Action: client.ReorgedLogBroadcasts,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:  "from-block",
+							Usage: "(optional) lowest block number to list",
+						},
+						cli.Int64Flag{
+							Name:  "to-block",
+							Usage: "(optional) highest block number to list",
+						},
+						cli.StringFlag{
+							Name:  "evmChainID",
+							Usage: "(optional) specify the chain ID to list the logs of",
+						},
+					},
+				},
 			},
 		},
 
@@ -396,16 +453,128 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Create a job",
 					Action: client.CreateJob,
 				},
+				{
+					Name:   "update",
+					Usage:  "Replace the spec of a job, keeping its ID and runs",
+					Action: client.UpdateJob,
+				},
+				{
+					Name:   "history",
+					Usage:  "List the versions of a job's spec",
+					Action: client.ListJobVersions,
+				},
+				{
+					Name:   "diff",
+					Usage:  "Show the differences between two versions of a job's spec, e.g. 'diff JOB_ID 1 [2]'. Compares to the latest version by default",
+					Action: client.DiffJobVersions,
+				},
+				{
+					Name:   "rollback",
+					Usage:  "Restore an earlier version of a job's spec",
+					Action: client.RollbackJob,
+				},
 				{
 					Name:   "delete",
 					Usage:  "Delete a job",
 					Action: client.DeleteJob,
 				},
+				{
+					Name:   "pause",
+					Usage:  "Stop the services of a job until it is resumed, keeping its spec, keys and runs",
+					Action: client.PauseJob,
+				},
+				{
+					Name:   "resume",
+					Usage:  "Start the services of a paused job again",
+					Action: client.ResumeJob,
+				},
 				{
 					Name:   "run",
 					Usage:  "Trigger a job run",
 					Action: client.TriggerPipelineRun,
 				},
+				{
+					Name:   "poll",
+					Usage:  "Make a flux monitor job poll and submit an answer now, regardless of deviation",
+					Action: client.PollFluxMonitorJob,
+				},
+				{
+					Name:   "deviation",
+					Usage:  "Show the deviation of a flux monitor job's current answer from its latest submission, without submitting it",
+					Action: client.ShowFluxMonitorDeviation,
+				},
+				{
+					Name:   "rejections",
+					Usage:  "List the oracle requests a direct request job rejected without running them",
+					Action: client.ListDirectRequestRejections,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+					},
+				},
+				{
+					Name:   "simulate",
+					Usage:  "Execute a job spec's pipeline without creating the job or sending transactions. Bridge and http tasks are executed",
+					Action: client.SimulateJob,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "vars",
+							Usage: "JSON object of pipeline vars, e.g. '{\"jobRun\": {\"requestBody\": \"{}\"}}'",
+						},
+						cli.StringFlag{
+							Name:  "ethTxOutputs",
+							Usage: "JSON object of stubbed ethtx task outputs, keyed by task dot ID",
+						},
+					},
+				},
+				{
+					Name:   "export",
+					Usage:  "Export jobs, along with the bridges and external initiators they reference, to a bundle, e.g. 'export -o bundle.json [JOB_ID...]'. Exports all jobs by default",
+					Action: client.ExportJobs,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "output, o",
+							Usage: "Path where the bundle will be saved (required)",
+						},
+					},
+				},
+				{
+					Name:   "import",
+					Usage:  "Validate a bundle and create all of its bridges and jobs in a single transaction",
+					Action: client.ImportJobs,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "onConflict",
+							Usage: "What to do with jobs and bridges which already exist: 'fail' to reject the bundle, 'skip' to keep the existing ones",
+							Value: "fail",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "keeper",
+			Usage: "Commands for inspecting keeper registries",
+			Subcommands: []cli.Command{
+				{
+					Name:   "report",
+					Usage:  "Checks an upkeep at the latest head and reports whether this node would perform it, without submitting anything",
+					Action: client.UpkeepReport,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "registry",
+							Usage:    "Address of the keeper registry",
+							Required: true,
+						},
+						cli.StringFlag{
+							Name:     "upkeep-id",
+							Usage:    "ID of the upkeep, in decimal or 0x prefixed hex",
+							Required: true,
+						},
+					},
+				},
 			},
 		},
 		{
@@ -1038,6 +1207,17 @@ func NewApp(client *Client) *cli.App {
 							Usage:  "get information on a specific Ethereum Transaction",
 							Action: client.ShowTransaction,
 						},
+						{
+							Name:   "cancel",
+							Usage:  "Cancel an unconfirmed Ethereum Transaction, given its ID, by replacing its nonce with a zero-value self-send at a bumped gas price",
+							Action: client.CancelTransaction,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "hash",
+									Usage: "cancel the transaction of the attempt with this hash, instead of by ID",
+								},
+							},
+						},
 					},
 				},
 				{
@@ -1084,6 +1264,17 @@ func NewApp(client *Client) *cli.App {
 				},
 			},
 		},
+		{
+			Name:  "vrf",
+			Usage: "Commands for inspecting VRF requests",
+			Subcommands: []cli.Command{
+				{
+					Name:   "lifecycle",
+					Usage:  "Shows how each VRF job processed a VRF v2 request, given its ID in decimal or 0x prefixed hex",
+					Action: client.VRFRequestLifecycle,
+				},
+			},
+		},
 		{
 			Name:  "chains",
 			Usage: "Commands for handling chain configuration",
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// BlockhashStoreBackfillPresenter presents a BlockhashStoreBackfillResource
+type BlockhashStoreBackfillPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.BlockhashStoreBackfillResource
+}
+
+// ToRow presents the BlockhashStoreBackfillResource as a slice of strings.
+func (p *BlockhashStoreBackfillPresenter) ToRow() []string {
+	return []string{
+		strconv.FormatUint(p.FromBlock, 10),
+		strconv.FormatUint(p.ToBlock, 10),
+		strconv.Itoa(p.Unfulfilled),
+		strconv.Itoa(p.AlreadyStored),
+		strconv.Itoa(p.Stored),
+		strconv.Itoa(p.HeadersVerified),
+		strconv.FormatUint(p.Anchor, 10),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *BlockhashStoreBackfillPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"From Block", "To Block", "Unfulfilled", "Already Stored", "Stored", "Headers Verified", "Anchor"})
+	table.Append(p.ToRow())
+
+	render("Backfill Batch", table)
+	return nil
+}
+
+// BackfillBlockhashes stores the blockhashes of historical blocks with
+// unfulfilled VRF requests for a blockhash store job. The range is processed
+// by the node one batch at a time, from the latest block backwards, and the
+// progress is shown after each batch.
+func (cli *Client) BackfillBlockhashes(c *cli.Context) error {
+	jobID := c.String("job-id")
+	request := web.BackfillBlockhashesRequest{
+		FromBlock: c.Uint64("from"),
+		ToBlock:   c.Uint64("to"),
+		BatchSize: c.Uint64("batch-size"),
+		Anchor:    c.Uint64("anchor"),
+	}
+	for {
+		var progress BlockhashStoreBackfillPresenter
+		if err := cli.backfillBatch(jobID, request, &progress); err != nil {
+			return cli.errorOut(errors.Wrapf(err,
+				"backfill stopped, resume it with --to %d --anchor %d", request.ToBlock, request.Anchor))
+		}
+		if err := cli.Render(&progress); err != nil {
+			return cli.errorOut(err)
+		}
+		if progress.Done {
+			fmt.Println("Backfill completed")
+			return nil
+		}
+		request.ToBlock = progress.NextBlock
+		request.Anchor = progress.Anchor
+	}
+}
+
+func (cli *Client) backfillBatch(jobID string, request web.BackfillBlockhashesRequest, progress *BlockhashStoreBackfillPresenter) (err error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	resp, err := cli.HTTP.Post("/v2/jobs/"+jobID+"/blockhash_store/backfill", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var links jsonapi.Links
+	return cli.deserializeAPIResponse(resp, progress, &links)
+}
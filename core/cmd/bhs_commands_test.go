@@ -0,0 +1,42 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestBlockhashStoreBackfillPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.BlockhashStoreBackfillPresenter{
+		BlockhashStoreBackfillResource: presenters.BlockhashStoreBackfillResource{
+			FromBlock:       7000,
+			ToBlock:         7999,
+			NextBlock:       6999,
+			Anchor:          7123,
+			Unfulfilled:     3,
+			AlreadyStored:   1,
+			Stored:          2,
+			HeadersVerified: 456,
+		},
+	}
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "HEADERS VERIFIED")
+	assert.Contains(t, output, "7000")
+	assert.Contains(t, output, "7999")
+	assert.Contains(t, output, "7123")
+	assert.Contains(t, output, "456")
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// ReorgedLogBroadcastPresenter presents a ReorgedLogBroadcastResource
+type ReorgedLogBroadcastPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.ReorgedLogBroadcastResource
+}
+
+var reorgedLogBroadcastsHeaders = []string{"Job ID", "Block Number", "Block Hash", "Log Index", "Reorged At"}
+
+// ToRow presents the ReorgedLogBroadcastResource as a slice of strings.
+func (p *ReorgedLogBroadcastPresenter) ToRow() []string {
+	return []string{
+		strconv.FormatInt(int64(p.JobID), 10),
+		strconv.FormatInt(p.BlockNumber, 10),
+		p.BlockHash.Hex(),
+		strconv.FormatUint(uint64(p.LogIndex), 10),
+		p.ReorgedAt.Format(time.RFC3339),
+	}
+}
+
+// ReorgedLogBroadcastPresenters implements TableRenderer for a slice of ReorgedLogBroadcastPresenter.
+type ReorgedLogBroadcastPresenters []ReorgedLogBroadcastPresenter
+
+// RenderTable implements TableRenderer
+func (ps ReorgedLogBroadcastPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(reorgedLogBroadcastsHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// ReorgedLogBroadcasts lists the log broadcasts which jobs consumed before
+// their log was removed by a reorg.
+func (cli *Client) ReorgedLogBroadcasts(c *cli.Context) (err error) {
+	query := url.Values{}
+	if c.IsSet("from-block") {
+		query.Set("fromBlock", strconv.FormatInt(c.Int64("from-block"), 10))
+	}
+	if c.IsSet("to-block") {
+		query.Set("toBlock", strconv.FormatInt(c.Int64("to-block"), 10))
+	}
+	if c.IsSet("evmChainID") {
+		query.Set("evmChainID", c.String("evmChainID"))
+	}
+
+	resp, err := cli.HTTP.Get("/v2/reorged_log_broadcasts?" + query.Encode())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &ReorgedLogBroadcastPresenters{})
+}
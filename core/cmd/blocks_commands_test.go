@@ -0,0 +1,45 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestReorgedLogBroadcastPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		blockHash = common.HexToHash("0x3a4b5c")
+		reorgedAt = time.Now()
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.ReorgedLogBroadcastPresenter{
+		ReorgedLogBroadcastResource: presenters.ReorgedLogBroadcastResource{
+			JAID:        presenters.NewJAID("1"),
+			BlockHash:   blockHash,
+			BlockNumber: 1234,
+			LogIndex:    7,
+			JobID:       42,
+			ReorgedAt:   reorgedAt,
+		},
+	}
+
+	ps := cmd.ReorgedLogBroadcastPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "42")
+	assert.Contains(t, output, "1234")
+	assert.Contains(t, output, blockHash.Hex())
+	assert.Contains(t, output, reorgedAt.Format(time.RFC3339))
+}
@@ -1,8 +1,12 @@
 package cmd
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	"github.com/urfave/cli"
@@ -18,14 +22,72 @@ func (p *BridgePresenter) FriendlyConfirmations() string {
 	return strconv.FormatUint(uint64(p.Confirmations), 10)
 }
 
+// FriendlyRateLimit converts the rate limit to a string
+func (p *BridgePresenter) FriendlyRateLimit() string {
+	if p.RateLimit == 0 {
+		return "none"
+	}
+	s := strconv.FormatFloat(p.RateLimit, 'f', -1, 64) + "/s"
+	if p.RateLimitBurst > 0 {
+		s += fmt.Sprintf(" (burst %d)", p.RateLimitBurst)
+	}
+	return s
+}
+
+// FriendlyCircuitBreaker converts the circuit breaker settings to a string
+func (p *BridgePresenter) FriendlyCircuitBreaker() string {
+	if p.CircuitBreakerThreshold == 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%d failures, %s cooldown", p.CircuitBreakerThreshold, p.CircuitBreakerCooldown.Duration())
+}
+
+// FriendlyCacheTTL converts the cache TTL to a string
+func (p *BridgePresenter) FriendlyCacheTTL() string {
+	if p.CacheTTL.IsZero() {
+		return "none"
+	}
+	return p.CacheTTL.Duration().String()
+}
+
+// FriendlySigning returns whether requests are signed
+func (p *BridgePresenter) FriendlySigning() string {
+	if p.SignsRequests {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+// FriendlyClientCertificate returns the subject and expiry of the client
+// certificate
+func (p *BridgePresenter) FriendlyClientCertificate() string {
+	if p.ClientCertificate == "" {
+		return "none"
+	}
+	block, _ := pem.Decode([]byte(p.ClientCertificate))
+	if block == nil {
+		return "invalid"
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "invalid"
+	}
+	return fmt.Sprintf("%s (expires %s)", cert.Subject, cert.NotAfter.Format(time.RFC3339))
+}
+
 // RenderTable implements TableRenderer
 func (p *BridgePresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token"})
+	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token", "Rate Limit", "Circuit Breaker", "Cache TTL", "Request Signing", "Client Certificate"})
 	table.Append([]string{
 		p.Name,
 		p.URL,
 		p.FriendlyConfirmations(),
 		p.OutgoingToken,
+		p.FriendlyRateLimit(),
+		p.FriendlyCircuitBreaker(),
+		p.FriendlyCacheTTL(),
+		p.FriendlySigning(),
+		p.FriendlyClientCertificate(),
 	})
 	render("Bridge", table)
 	return nil
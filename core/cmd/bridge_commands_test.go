@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
@@ -48,6 +49,35 @@ func TestBridgePresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, url)
 	assert.Contains(t, output, "10")
 	assert.Contains(t, output, outgoingToken)
+	assert.Contains(t, output, "none")
+
+	// Render limits
+	buffer.Reset()
+	limited := p
+	limited.RateLimit = 2.5
+	limited.RateLimitBurst = 5
+	limited.CircuitBreakerThreshold = 3
+	limited.CircuitBreakerCooldown = models.Interval(time.Minute)
+	limited.CacheTTL = models.Interval(10 * time.Second)
+	require.NoError(t, limited.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, "2.5/s (burst 5)")
+	assert.Contains(t, output, "3 failures, 1m0s cooldown")
+	assert.Contains(t, output, "10s")
+
+	// Render credentials
+	buffer.Reset()
+	certPEM, _ := testutils.MustNewCertificate(t)
+	signed := p
+	signed.SignsRequests = true
+	signed.ClientCertificate = certPEM
+	require.NoError(t, signed.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, "enabled")
+	assert.Contains(t, output, "CN=localhost (expires")
+	assert.NotContains(t, output, "BEGIN CERTIFICATE")
 
 	// Render many resources
 	buffer.Reset()
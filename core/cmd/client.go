@@ -100,50 +100,10 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg config.Gene
 
 	keyStore := keystore.New(db, utils.GetScryptParams(cfg), appLggr, cfg)
 
-	// Set up the versioning ORM
-	verORM := versioning.NewORM(db, appLggr)
-
-	if static.Version != static.Unset {
-		var appv, dbv *semver.Version
-		appv, dbv, err = versioning.CheckVersion(db, appLggr, static.Version)
-		if err != nil {
-			// Exit immediately and don't touch the database if the app version is too old
-			return nil, errors.Wrap(err, "CheckVersion")
-		}
-
-		// Take backup if app version is newer than DB version
-		// Need to do this BEFORE migration
-		if cfg.DatabaseBackupMode() != config.DatabaseBackupModeNone && cfg.DatabaseBackupOnVersionUpgrade() {
-			if err = takeBackupIfVersionUpgrade(cfg, appLggr, appv, dbv); err != nil {
-				if errors.Is(err, sql.ErrNoRows) {
-					appLggr.Debugf("Failed to find any node version in the DB: %w", err)
-				} else if strings.Contains(err.Error(), "relation \"node_versions\" does not exist") {
-					appLggr.Debugf("Failed to find any node version in the DB, the node_versions table does not exist yet: %w", err)
-				} else {
-					return nil, errors.Wrap(err, "initializeORM#FindLatestNodeVersion")
-				}
-			}
-		}
-	}
-
-	// Migrate the database
-	if cfg.MigrateDatabase() {
-		if err = migrate.Migrate(db.DB, appLggr); err != nil {
-			return nil, errors.Wrap(err, "initializeORM#Migrate")
-		}
-	}
-
-	// Update to latest version
-	if static.Version != static.Unset {
-		version := versioning.NewNodeVersion(static.Version)
-		if err = verORM.UpsertNodeVersion(version); err != nil {
-			return nil, errors.Wrap(err, "UpsertNodeVersion")
-		}
-	}
-
-	// Upsert EVM chains/nodes from ENV, necessary for backwards compatibility
-	if cfg.EVMEnabled() {
-		if err = evm.ClobberDBFromEnv(db, cfg, appLggr); err != nil {
+	// Warm standbys must not write to the database before they get the lease,
+	// so they set it up once they do, see runNode.
+	if !cfg.LeaseLockWarmStandby() {
+		if err = setupDatabase(cfg, db, appLggr); err != nil {
 			return nil, err
 		}
 	}
@@ -164,9 +124,6 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg config.Gene
 
 	if cfg.TerraEnabled() {
 		terraLggr := appLggr.Named("Terra")
-		if err = terra.SetupNodes(db, cfg, terraLggr); err != nil {
-			return nil, errors.Wrap(err, "failed to setup Terra nodes")
-		}
 		opts := terra.ChainSetOpts{
 			Config:           cfg,
 			Logger:           terraLggr,
@@ -190,9 +147,6 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg config.Gene
 
 	if cfg.SolanaEnabled() {
 		solLggr := appLggr.Named("Solana")
-		if err = solana.SetupNodes(db, cfg, solLggr); err != nil {
-			return nil, errors.Wrap(err, "failed to setup Solana nodes")
-		}
 		opts := solana.ChainSetOpts{
 			Logger:   solLggr,
 			DB:       db,
@@ -215,9 +169,6 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg config.Gene
 
 	if cfg.StarkNetEnabled() {
 		starkLggr := appLggr.Named("StarkNet")
-		if err = starknet.SetupNodes(db, cfg, starkLggr); err != nil {
-			return nil, errors.Wrap(err, "failed to setup StarkNet nodes")
-		}
 		opts := starknet.ChainSetOpts{
 			Config:   cfg,
 			Logger:   starkLggr,
@@ -257,6 +208,101 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg config.Gene
 	})
 }
 
+// setupDatabase checks the database version and migrates it, then upserts the
+// chains and nodes configured from the environment.
+func setupDatabase(cfg config.GeneralConfig, db *sqlx.DB, lggr logger.Logger) error {
+	// Set up the versioning ORM
+	verORM := versioning.NewORM(db, lggr)
+
+	if static.Version != static.Unset {
+		appv, dbv, err := versioning.CheckVersion(db, lggr, static.Version)
+		if err != nil {
+			// Exit immediately and don't touch the database if the app version is too old
+			return errors.Wrap(err, "CheckVersion")
+		}
+
+		// Take backup if app version is newer than DB version
+		// Need to do this BEFORE migration
+		if cfg.DatabaseBackupMode() != config.DatabaseBackupModeNone && cfg.DatabaseBackupOnVersionUpgrade() {
+			if err := takeBackupIfVersionUpgrade(cfg, lggr, appv, dbv); err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					lggr.Debugf("Failed to find any node version in the DB: %w", err)
+				} else if strings.Contains(err.Error(), "relation \"node_versions\" does not exist") {
+					lggr.Debugf("Failed to find any node version in the DB, the node_versions table does not exist yet: %w", err)
+				} else {
+					return errors.Wrap(err, "initializeORM#FindLatestNodeVersion")
+				}
+			}
+		}
+	}
+
+	// Migrate the database
+	if cfg.MigrateDatabase() {
+		if err := migrate.Migrate(db.DB, lggr); err != nil {
+			return errors.Wrap(err, "initializeORM#Migrate")
+		}
+	}
+
+	// Update to latest version
+	if static.Version != static.Unset {
+		version := versioning.NewNodeVersion(static.Version)
+		if err := verORM.UpsertNodeVersion(version); err != nil {
+			return errors.Wrap(err, "UpsertNodeVersion")
+		}
+	}
+
+	// Upsert EVM chains/nodes from ENV, necessary for backwards compatibility
+	if cfg.EVMEnabled() {
+		if err := evm.ClobberDBFromEnv(db, cfg, lggr); err != nil {
+			return err
+		}
+	}
+
+	if cfg.TerraEnabled() {
+		if err := terra.SetupNodes(db, cfg, lggr.Named("Terra")); err != nil {
+			return errors.Wrap(err, "failed to setup Terra nodes")
+		}
+	}
+	if cfg.SolanaEnabled() {
+		if err := solana.SetupNodes(db, cfg, lggr.Named("Solana")); err != nil {
+			return errors.Wrap(err, "failed to setup Solana nodes")
+		}
+	}
+	if cfg.StarkNetEnabled() {
+		if err := starknet.SetupNodes(db, cfg, lggr.Named("StarkNet")); err != nil {
+			return errors.Wrap(err, "failed to setup StarkNet nodes")
+		}
+	}
+	return nil
+}
+
+// setupPromotedDatabase sets up the database of a warm standby once it gets
+// the lease, and reports whether the application it built beforehand is stale:
+// migrations may have changed the schema it was loaded from, and chains and
+// nodes configured from the environment may have been upserted.
+func setupPromotedDatabase(cfg config.GeneralConfig, db *sqlx.DB, lggr logger.Logger) (stale bool, err error) {
+	before, err := migrate.Current(db.DB, lggr)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get database version")
+	}
+	if err = setupDatabase(cfg, db, lggr); err != nil {
+		return false, err
+	}
+	after, err := migrate.Current(db.DB, lggr)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get database version")
+	}
+	if before != after {
+		return true, nil
+	}
+	if cfg.EVMEnabled() && (cfg.EthereumURL() != "" || cfg.EthereumNodes() != "") {
+		return true, nil
+	}
+	return cfg.TerraEnabled() && cfg.TerraNodes() != "" ||
+		cfg.SolanaEnabled() && cfg.SolanaNodes() != "" ||
+		cfg.StarkNetEnabled() && cfg.StarkNetNodes() != "", nil
+}
+
 func takeBackupIfVersionUpgrade(cfg config.GeneralConfig, lggr logger.Logger, appv, dbv *semver.Version) (err error) {
 	if appv == nil {
 		lggr.Debug("Application version is missing, skipping automatic DB backup.")
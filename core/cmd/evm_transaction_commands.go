@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -82,6 +83,34 @@ func (cli *Client) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+// CancelTransaction requests the cancellation of the unconfirmed transaction
+// with the given ID, or of the transaction of the attempt with the given hash
+func (cli *Client) CancelTransaction(c *cli.Context) (err error) {
+	idOrHash := c.String("hash")
+	if idOrHash == "" {
+		if !c.Args().Present() {
+			return cli.errorOut(errors.New("must pass the ID of the transaction"))
+		}
+		idOrHash = c.Args().First()
+		if _, err = stringutils.ToInt64(idOrHash); err != nil {
+			return cli.errorOut(fmt.Errorf("invalid transaction ID: %w", err))
+		}
+	} else if !strings.HasPrefix(idOrHash, "0x") {
+		return cli.errorOut(errors.New("the hash must be 0x prefixed"))
+	}
+	resp, err := cli.HTTP.Post("/v2/transactions/evm/"+idOrHash+"/cancel", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &EthTxPresenter{}, "Transaction cancellation requested")
+}
+
 // IndexTxAttempts returns the list of transactions in descending order,
 // taking an optional page parameter
 func (cli *Client) IndexTxAttempts(c *cli.Context) error {
@@ -3,6 +3,7 @@ package cmd_test
 import (
 	"flag"
 	"math/big"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -73,6 +74,65 @@ func TestClient_ShowTransaction(t *testing.T) {
 	assert.Equal(t, &tx.FromAddress, renderedTx.From)
 }
 
+func TestClient_CancelTransaction(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	db := app.GetSqlxDB()
+	_, from := cltest.MustAddRandomKeyToKeystore(t, app.KeyStore.Eth())
+
+	borm := cltest.NewTxmORM(t, db, app.GetConfig())
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, from)
+
+	set := flag.NewFlagSet("test cancel tx", 0)
+	set.String("hash", "", "")
+	set.Parse([]string{strconv.FormatInt(tx.ID, 10)})
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.CancelTransaction(c))
+
+	renderedTx := *r.Renders[0].(*cmd.EthTxPresenter)
+	assert.Equal(t, &tx.FromAddress, renderedTx.From)
+
+	tx, err := borm.FindEthTxWithAttempts(tx.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, tx.CancelRequestedAt)
+
+	set = flag.NewFlagSet("test cancel tx", 0)
+	set.String("hash", "", "")
+	set.Parse([]string{"not-an-id"})
+	c = cli.NewContext(nil, set, nil)
+	require.Error(t, client.CancelTransaction(c))
+}
+
+func TestClient_CancelTransaction_ByHash(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, r := app.NewClientAndRenderer()
+
+	db := app.GetSqlxDB()
+	_, from := cltest.MustAddRandomKeyToKeystore(t, app.KeyStore.Eth())
+
+	borm := cltest.NewTxmORM(t, db, app.GetConfig())
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 0, from)
+	attempt := tx.EthTxAttempts[0]
+
+	set := flag.NewFlagSet("test cancel tx", 0)
+	set.String("hash", "", "")
+	require.NoError(t, set.Set("hash", attempt.Hash.Hex()))
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.CancelTransaction(c))
+
+	renderedTx := *r.Renders[0].(*cmd.EthTxPresenter)
+	assert.Equal(t, &tx.FromAddress, renderedTx.From)
+
+	tx, err := borm.FindEthTxWithAttempts(tx.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, tx.CancelRequestedAt)
+}
+
 func TestClient_IndexTxAttempts(t *testing.T) {
 	t.Parallel()
 
@@ -1,6 +1,9 @@
 package cmd
 
-import "github.com/smartcontractkit/chainlink/core/logger"
+import (
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
 
 // CheckRemoteBuildCompatibility exposes checkRemoteBuildCompatibility for testing.
 func (cli *Client) CheckRemoteBuildCompatibility(lggr logger.Logger, onlyWarn bool, cliVersion, cliSha string) error {
@@ -11,3 +14,8 @@ func (cli *Client) CheckRemoteBuildCompatibility(lggr logger.Logger, onlyWarn bo
 func (cli *Client) ConfigDumpStr() (string, error) {
 	return cli.configDumpStr()
 }
+
+// DiffJobVersions exposes diffJobVersions for testing.
+func DiffJobVersions(versions []presenters.JobSpecVersionResource, from, to int32) (string, error) {
+	return diffJobVersions(versions, from, to)
+}
@@ -5,13 +5,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/shopspring/decimal"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
@@ -48,6 +56,7 @@ func (p JobPresenter) toRow(task string) []string {
 		p.Type.String(),
 		task,
 		p.FriendlyCreatedAt(),
+		p.FriendlyPausedAt(),
 	}
 }
 
@@ -125,9 +134,18 @@ func (p JobPresenter) FriendlyCreatedAt() string {
 	return "N/A"
 }
 
+// FriendlyPausedAt returns the time the job was paused at, or an empty string
+// if the job is running.
+func (p JobPresenter) FriendlyPausedAt() string {
+	if !p.PausedAt.Valid {
+		return ""
+	}
+	return p.PausedAt.Time.Format(time.RFC3339)
+}
+
 // RenderTable implements TableRenderer
 func (p *JobPresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"ID", "Name", "Type", "Tasks", "Created At"})
+	table := rt.newTable([]string{"ID", "Name", "Type", "Tasks", "Created At", "Paused At"})
 	table.SetAutoMergeCells(true)
 	for _, r := range p.ToRows() {
 		table.Append(r)
@@ -141,7 +159,7 @@ type JobPresenters []JobPresenter
 
 // RenderTable implements TableRenderer
 func (ps JobPresenters) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"ID", "Name", "Type", "Tasks", "Created At"})
+	table := rt.newTable([]string{"ID", "Name", "Type", "Tasks", "Created At", "Paused At"})
 	table.SetAutoMergeCells(true)
 	for _, p := range ps {
 		for _, r := range p.ToRows() {
@@ -238,6 +256,42 @@ func (cli *Client) DeleteJob(c *cli.Context) error {
 	return nil
 }
 
+// PauseJob stops the services of a job until it is resumed
+func (cli *Client) PauseJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the job id to be paused"))
+	}
+	resp, err := cli.HTTP.Post("/v2/jobs/"+c.Args().First()+"/pause", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Job paused")
+}
+
+// ResumeJob starts the services of a paused job again
+func (cli *Client) ResumeJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the job id to be resumed"))
+	}
+	resp, err := cli.HTTP.Post("/v2/jobs/"+c.Args().First()+"/resume", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Job resumed")
+}
+
 // TriggerPipelineRun triggers a job run based on a job ID
 func (cli *Client) TriggerPipelineRun(c *cli.Context) error {
 	if !c.Args().Present() {
@@ -257,3 +311,537 @@ func (cli *Client) TriggerPipelineRun(c *cli.Context) error {
 	err = cli.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// PollFluxMonitorJob makes a flux monitor job poll and submit an answer, even
+// if it is within the deviation thresholds
+func (cli *Client) PollFluxMonitorJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the job id to be polled"))
+	}
+	resp, err := cli.HTTP.Post("/v2/jobs/"+c.Args().First()+"/flux_monitor/poll", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Poll requested")
+}
+
+// DeviationPreviewPresenter wraps the JSONAPI DeviationPreview Resource
+type DeviationPreviewPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.DeviationPreviewResource
+}
+
+var deviationPreviewHeaders = []string{
+	"Job ID", "Round ID", "Eligible To Submit", "Hibernating",
+	"Pipeline Answer", "Pipeline Error", "Latest Answer", "Latest Submission",
+	"Threshold", "Absolute Threshold", "Absolute Deviation", "Relative Deviation",
+	"Outside Deviation", "Valid Submission",
+}
+
+// ToRow presents the DeviationPreviewResource as a slice of strings.
+func (p *DeviationPreviewPresenter) ToRow() []string {
+	return []string{
+		p.GetID(),
+		strconv.FormatUint(uint64(p.RoundID), 10),
+		strconv.FormatBool(p.EligibleToSubmit),
+		strconv.FormatBool(p.Hibernating),
+		optionalDecimalString(p.PipelineAnswer),
+		p.PipelineError,
+		optionalBigString(p.LatestAnswer),
+		optionalBigString(p.LatestSubmission),
+		strconv.FormatFloat(p.Threshold, 'f', -1, 64),
+		strconv.FormatFloat(p.AbsoluteThreshold, 'f', -1, 64),
+		optionalDecimalString(p.AbsoluteDeviation),
+		optionalDecimalString(p.RelativeDeviation),
+		strconv.FormatBool(p.OutsideDeviation),
+		strconv.FormatBool(p.ValidSubmission),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *DeviationPreviewPresenter) RenderTable(rt RendererTable) error {
+	renderList(deviationPreviewHeaders, [][]string{p.ToRow()}, rt.Writer)
+
+	return nil
+}
+
+func optionalDecimalString(d *decimal.Decimal) string {
+	if d == nil {
+		return ""
+	}
+	return d.String()
+}
+
+// ShowFluxMonitorDeviation shows how far the answer a flux monitor job's
+// pipeline currently returns deviates from its latest submission
+func (cli *Client) ShowFluxMonitorDeviation(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the job id"))
+	}
+	resp, err := cli.HTTP.Get("/v2/jobs/" + c.Args().First() + "/flux_monitor/deviation")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &DeviationPreviewPresenter{})
+}
+
+// DirectRequestRejectionPresenter wraps the JSONAPI DirectRequestRejection Resource
+type DirectRequestRejectionPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.DirectRequestRejectionResource
+}
+
+// ToRow presents the DirectRequestRejectionResource as a slice of strings.
+func (p DirectRequestRejectionPresenter) ToRow() []string {
+	return []string{
+		p.RequestID.Hex(),
+		p.Requester.Hex(),
+		optionalBigString(p.Payment),
+		string(p.Reason),
+		p.TxHash.Hex(),
+		strconv.FormatInt(p.BlockNumber, 10),
+		p.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// DirectRequestRejectionPresenters implements TableRenderer for a slice of
+// DirectRequestRejectionPresenter
+type DirectRequestRejectionPresenters []DirectRequestRejectionPresenter
+
+// RenderTable implements TableRenderer
+func (ps DirectRequestRejectionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Request ID", "Requester", "Payment", "Reason", "Tx Hash", "Block Number", "Rejected At"})
+	for _, p := range ps {
+		table.Append(p.ToRow())
+	}
+
+	render("Rejected Requests", table)
+	return nil
+}
+
+// ListDirectRequestRejections lists the oracle requests which a direct request
+// job rejected without running them
+func (cli *Client) ListDirectRequestRejections(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the job id"))
+	}
+	return cli.getPage("/v2/jobs/"+c.Args().First()+"/direct_request/rejections", c.Int("page"), &DirectRequestRejectionPresenters{})
+}
+
+// SimulatedRunPresenter wraps the JSONAPI PipelineRun Resource of a simulated run
+type SimulatedRunPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.PipelineRunResource
+}
+
+// RenderTable implements TableRenderer
+func (p *SimulatedRunPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Output", "Error"})
+	for _, tr := range p.TaskRuns {
+		table.Append([]string{tr.DotID, string(tr.Type), stringOrEmpty(tr.Output), stringOrEmpty(tr.Error)})
+	}
+	render("Simulated Task Runs", table)
+
+	table = rt.newTable([]string{"Outputs", "Fatal Errors"})
+	for i := range p.Outputs {
+		var fatalErr string
+		if i < len(p.FatalErrors) {
+			fatalErr = stringOrEmpty(p.FatalErrors[i])
+		}
+		table.Append([]string{stringOrEmpty(p.Outputs[i]), fatalErr})
+	}
+	render("Simulated Run Result", table)
+	return nil
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// SimulateJob executes the pipeline of a job spec without creating the job
+// Valid input is a TOML string or a path to TOML file
+func (cli *Client) SimulateJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	request := web.SimulateJobRequest{TOML: tomlString}
+	if c.IsSet("vars") {
+		if err = json.Unmarshal([]byte(c.String("vars")), &request.Vars); err != nil {
+			return cli.errorOut(errors.Wrap(err, "invalid vars"))
+		}
+	}
+	if c.IsSet("ethTxOutputs") {
+		if err = json.Unmarshal([]byte(c.String("ethTxOutputs")), &request.EthTxOutputs); err != nil {
+			return cli.errorOut(errors.Wrap(err, "invalid ethTxOutputs"))
+		}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/jobs/simulate", bytes.NewReader(body))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &SimulatedRunPresenter{}, "Job simulated")
+}
+
+// UpdateJob replaces the spec of a job
+// Valid input is the job ID and a TOML string or a path to TOML file
+func (cli *Client) UpdateJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must pass the job id and TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().Get(1))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	request, err := json.Marshal(web.UpdateJobRequest{
+		TOML: tomlString,
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Put("/v2/jobs/"+c.Args().First(), bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, "Job updated")
+}
+
+// JobSpecVersionPresenter wraps the JSONAPI Job Spec Version Resource
+type JobSpecVersionPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.JobSpecVersionResource
+}
+
+// FriendlyTOML returns whether the spec of the version was recorded
+func (p JobSpecVersionPresenter) FriendlyTOML() string {
+	if p.TOML.Valid {
+		return "yes"
+	}
+	return "no"
+}
+
+func (p JobSpecVersionPresenter) toRow() []string {
+	return []string{
+		strconv.Itoa(int(p.Version)),
+		p.ExternalJobID.String(),
+		strconv.Itoa(int(p.PipelineSpecID)),
+		p.FriendlyTOML(),
+		p.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+var jobSpecVersionHeaders = []string{"Version", "External Job ID", "Pipeline Spec ID", "Spec Recorded", "Created At"}
+
+// RenderTable implements TableRenderer
+func (p *JobSpecVersionPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable(jobSpecVersionHeaders)
+	table.Append(p.toRow())
+	render("Job Spec Version", table)
+	return nil
+}
+
+// JobSpecVersionPresenters implements TableRenderer for a slice of
+// JobSpecVersionPresenter
+type JobSpecVersionPresenters []JobSpecVersionPresenter
+
+// RenderTable implements TableRenderer
+func (ps JobSpecVersionPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable(jobSpecVersionHeaders)
+	for _, p := range ps {
+		table.Append(p.toRow())
+	}
+	render("Job Spec Versions", table)
+	return nil
+}
+
+// ListJobVersions lists the versions of a job's spec, latest first
+func (cli *Client) ListJobVersions(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the job id"))
+	}
+	resp, err := cli.HTTP.Get("/v2/jobs/" + c.Args().First() + "/versions")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobSpecVersionPresenters{})
+}
+
+// DiffJobVersions prints the differences between two versions of a job's
+// spec. The second version defaults to the latest one.
+func (cli *Client) DiffJobVersions(c *cli.Context) (err error) {
+	if c.NArg() < 2 || c.NArg() > 3 {
+		return cli.errorOut(errors.New("must pass the job id, the version to compare and optionally the version to compare it to"))
+	}
+	from, err := strconv.ParseInt(c.Args().Get(1), 10, 32)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "invalid version"))
+	}
+
+	resp, err := cli.HTTP.Get("/v2/jobs/" + c.Args().First() + "/versions")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	body, err := cli.parseResponse(resp)
+	if err != nil {
+		return err
+	}
+	var versions []presenters.JobSpecVersionResource
+	if err = web.ParseJSONAPIResponse(body, &versions); err != nil {
+		return cli.errorOut(err)
+	}
+	if len(versions) == 0 {
+		return cli.errorOut(errors.New("job has no versions"))
+	}
+
+	to := int64(versions[0].Version)
+	if c.NArg() == 3 {
+		to, err = strconv.ParseInt(c.Args().Get(2), 10, 32)
+		if err != nil {
+			return cli.errorOut(errors.Wrap(err, "invalid version"))
+		}
+	}
+
+	diff, err := diffJobVersions(versions, int32(from), int32(to))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	if diff == "" {
+		fmt.Printf("Versions %d and %d are identical\n", from, to)
+		return nil
+	}
+	fmt.Print(diff)
+	return nil
+}
+
+// diffJobVersions returns a unified diff of the specs of two versions.
+func diffJobVersions(versions []presenters.JobSpecVersionResource, from, to int32) (string, error) {
+	spec := func(version int32) (string, error) {
+		for _, v := range versions {
+			if v.Version != version {
+				continue
+			}
+			if !v.TOML.Valid {
+				return "", errors.Errorf("the spec of version %d was not recorded", version)
+			}
+			return v.TOML.String, nil
+		}
+		return "", errors.Errorf("version %d not found", version)
+	}
+	fromSpec, err := spec(from)
+	if err != nil {
+		return "", err
+	}
+	toSpec, err := spec(to)
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(fromSpec),
+		B:        splitLines(toSpec),
+		FromFile: fmt.Sprintf("version %d", from),
+		ToFile:   fmt.Sprintf("version %d", to),
+		Context:  3,
+	})
+}
+
+// splitLines splits s into lines for diffing, ignoring its trailing newline.
+func splitLines(s string) []string {
+	return difflib.SplitLines(strings.TrimSuffix(s, "\n"))
+}
+
+// RollbackJob restores an earlier version of a job's spec
+func (cli *Client) RollbackJob(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must pass the job id and the version to restore"))
+	}
+	version, err := strconv.ParseInt(c.Args().Get(1), 10, 32)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "invalid version"))
+	}
+
+	request, err := json.Marshal(web.RollbackJobRequest{
+		Version: int32(version),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/jobs/"+c.Args().First()+"/rollback", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobPresenter{}, fmt.Sprintf("Job rolled back to version %d", version))
+}
+
+// ExportJobs writes a bundle of jobs, along with the bridges and external
+// initiators they reference, to a file. All jobs are exported if no job ids
+// are passed.
+func (cli *Client) ExportJobs(c *cli.Context) (err error) {
+	filepath := c.String("output")
+	if len(filepath) == 0 {
+		return cli.errorOut(errors.New("must specify --output/-o flag"))
+	}
+
+	exportURL := url.URL{Path: "/v2/jobs/export"}
+	if c.Args().Present() {
+		query := exportURL.Query()
+		query.Set("ids", strings.Join(c.Args(), ","))
+		exportURL.RawQuery = query.Encode()
+	}
+	resp, err := cli.HTTP.Get(exportURL.String())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	body, err := cli.parseResponse(resp)
+	if err != nil {
+		return err
+	}
+	var resource presenters.JobBundleResource
+	if err = web.ParseJSONAPIResponse(body, &resource); err != nil {
+		return cli.errorOut(err)
+	}
+
+	bundleJSON, err := json.MarshalIndent(resource.Bundle, "", "  ")
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	if err = utils.WriteFileWithMaxPerms(filepath, bundleJSON, 0600); err != nil {
+		return cli.errorOut(errors.Wrapf(err, "could not write %v", filepath))
+	}
+
+	_, err = os.Stderr.WriteString(fmt.Sprintf("Exported %d jobs and %d bridges to %s\n", len(resource.Jobs), len(resource.Bridges), filepath))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	for _, skipped := range resource.SkippedJobs {
+		_, err = os.Stderr.WriteString(fmt.Sprintf("Skipped job %d (%s): %s\n", skipped.ID, skipped.Name, skipped.Reason))
+		if err != nil {
+			return cli.errorOut(err)
+		}
+	}
+	return nil
+}
+
+// JobImportResultPresenter wraps the JSONAPI Job Import Result Resource
+type JobImportResultPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.JobImportResultResource
+}
+
+func (p JobImportResultPresenter) toRow() []string {
+	return []string{p.Kind, p.GetID(), p.Name, p.Status, p.IncomingToken}
+}
+
+// JobImportResultPresenters implements TableRenderer for a slice of
+// JobImportResultPresenter
+type JobImportResultPresenters []JobImportResultPresenter
+
+// RenderTable implements TableRenderer
+func (ps JobImportResultPresenters) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Kind", "ID", "Name", "Status", "Incoming Token"})
+	for _, p := range ps {
+		table.Append(p.toRow())
+	}
+	render("Imported Jobs and Bridges", table)
+	return nil
+}
+
+// ImportJobs creates the bridges and jobs of a bundle written by ExportJobs.
+// Either everything is created or nothing is.
+func (cli *Client) ImportJobs(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the path of the bundle"))
+	}
+
+	bundleJSON, err := os.ReadFile(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	var bundle job.Bundle
+	if err = json.Unmarshal(bundleJSON, &bundle); err != nil {
+		return cli.errorOut(errors.Wrap(err, "invalid bundle"))
+	}
+
+	request, err := json.Marshal(web.ImportJobsRequest{
+		Bundle:     bundle,
+		OnConflict: job.BundleConflictPolicy(c.String("onConflict")),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post("/v2/jobs/import", bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &JobImportResultPresenters{}, "Bundle imported")
+}
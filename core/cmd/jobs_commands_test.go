@@ -2,10 +2,15 @@ package cmd_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"flag"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/urfave/cli"
@@ -13,9 +18,12 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
@@ -270,15 +278,21 @@ func TestJob_ToRows(t *testing.T) {
 	}
 
 	assert.Equal(t, [][]string{
-		{"1", "Test Job", "directrequest", "ds1 http", now.Format(time.RFC3339)},
-		{"1", "Test Job", "directrequest", "ds1_parse jsonparse", now.Format(time.RFC3339)},
-		{"1", "Test Job", "directrequest", "ds1_multiply multiply", now.Format(time.RFC3339)},
+		{"1", "Test Job", "directrequest", "ds1 http", now.Format(time.RFC3339), ""},
+		{"1", "Test Job", "directrequest", "ds1_parse jsonparse", now.Format(time.RFC3339), ""},
+		{"1", "Test Job", "directrequest", "ds1_multiply multiply", now.Format(time.RFC3339), ""},
 	}, job.ToRows())
 
 	// Produce a single row even if there is not DAG
 	job.PipelineSpec.DotDAGSource = ""
 	assert.Equal(t, [][]string{
-		{"1", "Test Job", "directrequest", "", now.Format(time.RFC3339)},
+		{"1", "Test Job", "directrequest", "", now.Format(time.RFC3339), ""},
+	}, job.ToRows())
+
+	// Show when the job was paused
+	job.PausedAt = null.TimeFrom(now)
+	assert.Equal(t, [][]string{
+		{"1", "Test Job", "directrequest", "", now.Format(time.RFC3339), now.Format(time.RFC3339)},
 	}, job.ToRows())
 }
 
@@ -400,6 +414,290 @@ func TestClient_DeleteJob(t *testing.T) {
 	requireJobsCount(t, app.JobORM(), 0)
 }
 
+func TestJobSpecVersionPresenters_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt = time.Now()
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	ps := cmd.JobSpecVersionPresenters{
+		{JobSpecVersionResource: presenters.JobSpecVersionResource{Version: 2, PipelineSpecID: 20, TOML: null.StringFrom("name = 'foo'"), CreatedAt: createdAt}},
+		{JobSpecVersionResource: presenters.JobSpecVersionResource{Version: 1, PipelineSpecID: 10, CreatedAt: createdAt}},
+	}
+	require.NoError(t, ps.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "SPEC RECORDED")
+	assert.Contains(t, output, "20")
+	assert.Contains(t, output, "yes")
+	assert.Contains(t, output, "no")
+	assert.Contains(t, output, createdAt.Format(time.RFC3339))
+}
+
+func TestJobImportResultPresenters_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	ps := cmd.JobImportResultPresenters{
+		{JAID: cmd.JAID{ID: "fetch"}, JobImportResultResource: presenters.JobImportResultResource{Kind: "bridge", Name: "fetch", Status: "created", IncomingToken: "token"}},
+		{JAID: cmd.JAID{ID: "7"}, JobImportResultResource: presenters.JobImportResultResource{Kind: "job", Name: "foo", Status: "skipped"}},
+	}
+	require.NoError(t, ps.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "INCOMING TOKEN")
+	assert.Contains(t, output, "fetch")
+	assert.Contains(t, output, "token")
+	assert.Contains(t, output, "skipped")
+	assert.Contains(t, output, "7")
+}
+
+func TestDeviationPreviewPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+		answer = decimal.NewFromInt(110)
+		abs    = decimal.NewFromInt(10)
+		rel    = decimal.NewFromInt(10)
+	)
+
+	p := cmd.DeviationPreviewPresenter{
+		JAID: cmd.JAID{ID: "42"},
+		DeviationPreviewResource: presenters.DeviationPreviewResource{
+			RoundID:           7,
+			EligibleToSubmit:  true,
+			PipelineAnswer:    &answer,
+			LatestAnswer:      utils.NewBigI(100),
+			LatestSubmission:  utils.NewBigI(100),
+			Threshold:         0.5,
+			AbsoluteThreshold: 0.01,
+			AbsoluteDeviation: &abs,
+			RelativeDeviation: &rel,
+			OutsideDeviation:  true,
+			ValidSubmission:   true,
+		},
+	}
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "Relative Deviation")
+	assert.Contains(t, output, "42")
+	assert.Contains(t, output, "110")
+	assert.Contains(t, output, "0.5")
+	assert.Contains(t, output, "0.01")
+}
+
+func TestDirectRequestRejectionPresenters_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt = time.Now()
+		requester = testutils.NewAddress()
+		requestID = utils.NewHash()
+		buffer    = bytes.NewBufferString("")
+		r         = cmd.RendererTable{Writer: buffer}
+	)
+
+	ps := cmd.DirectRequestRejectionPresenters{
+		{DirectRequestRejectionResource: presenters.DirectRequestRejectionResource{
+			RequestID:   requestID,
+			Requester:   requester,
+			Payment:     utils.NewBigI(99),
+			Reason:      directrequest.RejectionReasonInsufficientPayment,
+			BlockNumber: 1234,
+			CreatedAt:   createdAt,
+		}},
+	}
+	require.NoError(t, ps.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "REJECTED AT")
+	assert.Contains(t, output, requestID.Hex())
+	assert.Contains(t, output, requester.Hex())
+	assert.Contains(t, output, "insufficient_payment")
+	assert.Contains(t, output, "1234")
+	assert.Contains(t, output, createdAt.Format(time.RFC3339))
+}
+
+func TestDiffJobVersions(t *testing.T) {
+	t.Parallel()
+
+	versions := []presenters.JobSpecVersionResource{
+		{Version: 3, TOML: null.StringFrom("name = \"foo\"\ntimes = 1000\n")},
+		{Version: 2, TOML: null.StringFrom("name = \"foo\"\ntimes = 100\n")},
+		{Version: 1},
+	}
+
+	diff, err := cmd.DiffJobVersions(versions, 2, 3)
+	require.NoError(t, err)
+	assert.Equal(t, `--- version 2
++++ version 3
+@@ -1,2 +1,2 @@
+ name = "foo"
+-times = 100
++times = 1000
+`, diff)
+
+	diff, err = cmd.DiffJobVersions(versions, 3, 3)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	_, err = cmd.DiffJobVersions(versions, 1, 3)
+	require.EqualError(t, err, "the spec of version 1 was not recorded")
+
+	_, err = cmd.DiffJobVersions(versions, 4, 3)
+	require.EqualError(t, err, "version 4 not found")
+}
+
+func TestClient_UpdateJob_Rollback(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t, withConfigSet(func(c *configtest.TestGeneralConfig) {
+		c.Overrides.EVMEnabled = null.BoolFrom(true)
+	}))
+	client, r := app.NewClientAndRenderer()
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.Parse([]string{"../testdata/tomlspecs/direct-request-spec.toml"})
+	require.NoError(t, client.CreateJob(cli.NewContext(nil, fs, nil)))
+	created := *r.Renders[0].(*cmd.JobPresenter)
+
+	// Must supply the job id and a spec
+	set := flag.NewFlagSet("test", 0)
+	set.Parse([]string{created.ID})
+	require.Equal(t, "must pass the job id and TOML or filepath", client.UpdateJob(cli.NewContext(nil, set, nil)).Error())
+
+	updatedTOML := strings.Replace(string(cltest.MustReadFile(t, "../testdata/tomlspecs/direct-request-spec.toml")), "times=100", "times=1000", 1)
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{created.ID, updatedTOML})
+	require.NoError(t, client.UpdateJob(cli.NewContext(nil, set, nil)))
+	updated := *r.Renders[1].(*cmd.JobPresenter)
+	assert.Equal(t, created.ID, updated.ID)
+	assert.Contains(t, updated.PipelineSpec.DotDAGSource, "times=1000")
+	requireJobsCount(t, app.JobORM(), 1)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{created.ID})
+	require.NoError(t, client.ListJobVersions(cli.NewContext(nil, set, nil)))
+	versions := *r.Renders[2].(*cmd.JobSpecVersionPresenters)
+	require.Len(t, versions, 2)
+	assert.Equal(t, int32(2), versions[0].Version)
+	assert.Equal(t, int32(1), versions[1].Version)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{created.ID, "1"})
+	require.NoError(t, client.RollbackJob(cli.NewContext(nil, set, nil)))
+	rolledBack := *r.Renders[3].(*cmd.JobPresenter)
+	assert.Equal(t, created.ID, rolledBack.ID)
+	assert.Equal(t, created.PipelineSpec.DotDAGSource, rolledBack.PipelineSpec.DotDAGSource)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{created.ID, "1"})
+	require.NoError(t, client.DiffJobVersions(cli.NewContext(nil, set, nil)))
+}
+
+func TestClient_PauseResumeJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t, withConfigSet(func(c *configtest.TestGeneralConfig) {
+		c.Overrides.EVMEnabled = null.BoolFrom(true)
+	}))
+	client, r := app.NewClientAndRenderer()
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.Parse([]string{"../testdata/tomlspecs/direct-request-spec.toml"})
+	require.NoError(t, client.CreateJob(cli.NewContext(nil, fs, nil)))
+	created := *r.Renders[0].(*cmd.JobPresenter)
+	jobID, err := strconv.ParseInt(created.ID, 10, 32)
+	require.NoError(t, err)
+	cltest.AwaitJobActive(t, app.JobSpawner(), int32(jobID), 3*time.Second)
+
+	// Must supply job id
+	set := flag.NewFlagSet("test", 0)
+	require.Equal(t, "must pass the job id to be paused", client.PauseJob(cli.NewContext(nil, set, nil)).Error())
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{created.ID})
+	require.NoError(t, client.PauseJob(cli.NewContext(nil, set, nil)))
+	paused := *r.Renders[1].(*cmd.JobPresenter)
+	assert.True(t, paused.PausedAt.Valid)
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), int32(jobID))
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{created.ID})
+	require.NoError(t, client.ResumeJob(cli.NewContext(nil, set, nil)))
+	resumed := *r.Renders[2].(*cmd.JobPresenter)
+	assert.False(t, resumed.PausedAt.Valid)
+	cltest.AwaitJobActive(t, app.JobSpawner(), int32(jobID), 3*time.Second)
+}
+
+func TestClient_ExportImportJobs(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t, withConfigSet(func(c *configtest.TestGeneralConfig) {
+		c.Overrides.EVMEnabled = null.BoolFrom(true)
+	}))
+	client, r := app.NewClientAndRenderer()
+
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.Parse([]string{"../testdata/tomlspecs/direct-request-spec.toml"})
+	require.NoError(t, client.CreateJob(cli.NewContext(nil, fs, nil)))
+	created := *r.Renders[0].(*cmd.JobPresenter)
+
+	// Must supply the output path
+	set := flag.NewFlagSet("test", 0)
+	set.String("output", "", "")
+	require.Equal(t, "must specify --output/-o flag", client.ExportJobs(cli.NewContext(nil, set, nil)).Error())
+
+	path := filepath.Join(t.TempDir(), "bundle.json")
+	set = flag.NewFlagSet("test", 0)
+	set.String("output", path, "")
+	set.Parse([]string{created.ID})
+	require.NoError(t, client.ExportJobs(cli.NewContext(nil, set, nil)))
+
+	var bundle job.Bundle
+	require.NoError(t, json.Unmarshal(cltest.MustReadFile(t, path), &bundle))
+	require.Len(t, bundle.Jobs, 1)
+	assert.Equal(t, string(cltest.MustReadFile(t, "../testdata/tomlspecs/direct-request-spec.toml")), bundle.Jobs[0].TOML)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("onConflict", "fail", "")
+	set.Parse([]string{path})
+	require.Error(t, client.ImportJobs(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("onConflict", "skip", "")
+	set.Parse([]string{path})
+	require.NoError(t, client.ImportJobs(cli.NewContext(nil, set, nil)))
+	results := *r.Renders[len(r.Renders)-1].(*cmd.JobImportResultPresenters)
+	require.Len(t, results, 1)
+	assert.Equal(t, "skipped", results[0].Status)
+	assert.Equal(t, created.ID, results[0].ID)
+
+	set = flag.NewFlagSet("test", 0)
+	set.Parse([]string{created.ID})
+	require.NoError(t, client.DeleteJob(cli.NewContext(nil, set, nil)))
+	requireJobsCount(t, app.JobORM(), 0)
+
+	set = flag.NewFlagSet("test", 0)
+	set.String("onConflict", "fail", "")
+	set.Parse([]string{path})
+	require.NoError(t, client.ImportJobs(cli.NewContext(nil, set, nil)))
+	results = *r.Renders[len(r.Renders)-1].(*cmd.JobImportResultPresenters)
+	require.Len(t, results, 1)
+	assert.Equal(t, "created", results[0].Status)
+	requireJobsCount(t, app.JobORM(), 1)
+}
+
 func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	jobs, _, err := orm.FindJobs(0, 1000)
 	require.NoError(t, err)
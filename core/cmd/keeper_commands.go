@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// UpkeepReportPresenter presents an UpkeepReportResource
+type UpkeepReportPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.UpkeepReportResource
+}
+
+var upkeepReportHeaders = []string{
+	"Job ID", "Registry", "Upkeep ID", "Head Number",
+	"Keeper Index", "Num Keepers", "Block Count Per Turn", "Last Run Block Height", "Last Keeper Index",
+	"Turn Flag Enabled", "Turn Block Number", "Turn Keeper Index", "Eligible",
+	"Gas Price", "Gas Tip Cap", "Gas Fee Cap",
+	"Performable", "Perform Data", "Error",
+}
+
+// ToRow presents the UpkeepReportResource as a slice of strings.
+func (p *UpkeepReportPresenter) ToRow() []string {
+	var lastKeeperIndex, turnBlockNumber string
+	if p.LastKeeperIndex.Valid {
+		lastKeeperIndex = strconv.FormatInt(p.LastKeeperIndex.Int64, 10)
+	}
+	if p.TurnFlagEnabled {
+		turnBlockNumber = strconv.FormatInt(p.TurnBlockNumber, 10)
+	}
+	return []string{
+		strconv.FormatInt(int64(p.JobID), 10),
+		p.RegistryAddress.String(),
+		p.UpkeepID,
+		strconv.FormatInt(p.HeadNumber, 10),
+		strconv.FormatInt(int64(p.KeeperIndex), 10),
+		strconv.FormatInt(int64(p.NumKeepers), 10),
+		strconv.FormatInt(int64(p.BlockCountPerTurn), 10),
+		strconv.FormatInt(p.LastRunBlockHeight, 10),
+		lastKeeperIndex,
+		strconv.FormatBool(p.TurnFlagEnabled),
+		turnBlockNumber,
+		strconv.FormatInt(int64(p.TurnKeeperIndex), 10),
+		strconv.FormatBool(p.Eligible),
+		optionalBigString(p.GasPrice),
+		optionalBigString(p.GasTipCap),
+		optionalBigString(p.GasFeeCap),
+		strconv.FormatBool(p.Performable),
+		p.PerformData.String(),
+		p.Error,
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *UpkeepReportPresenter) RenderTable(rt RendererTable) error {
+	renderList(upkeepReportHeaders, [][]string{p.ToRow()}, rt.Writer)
+
+	return nil
+}
+
+func optionalBigString(b *utils.Big) string {
+	if b == nil {
+		return ""
+	}
+	return b.String()
+}
+
+// UpkeepReport checks an upkeep of a keeper registry at the latest head and
+// shows whether this node would perform it.
+func (cli *Client) UpkeepReport(c *cli.Context) (err error) {
+	resp, err := cli.HTTP.Get(fmt.Sprintf(
+		"/v2/keeper_registries/%s/upkeeps/%s/report",
+		url.PathEscape(c.String("registry")),
+		url.PathEscape(c.String("upkeep-id")),
+	))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &UpkeepReportPresenter{})
+}
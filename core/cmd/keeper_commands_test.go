@@ -0,0 +1,56 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestUpkeepReportPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		registryAddress = ethkey.EIP55Address("0x5431F5F973781809D18643b87B44921b11355d81")
+		buffer          = bytes.NewBufferString("")
+		r               = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.UpkeepReportPresenter{
+		UpkeepReportResource: presenters.UpkeepReportResource{
+			JAID:               presenters.NewJAID("1"),
+			JobID:              42,
+			RegistryAddress:    registryAddress,
+			UpkeepID:           "UPx0000000000000000000000000000000000000000000000000000000000000010",
+			HeadNumber:         1234,
+			NumKeepers:         2,
+			BlockCountPerTurn:  20,
+			LastRunBlockHeight: 1200,
+			LastKeeperIndex:    null.Int64From(1),
+			TurnFlagEnabled:    true,
+			TurnBlockNumber:    1220,
+			Eligible:           true,
+			GasPrice:           utils.NewBigI(5000),
+			Performable:        false,
+			PerformData:        []byte{0x12, 0x34},
+			Error:              "check_upkeep_tx: execution reverted",
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "42")
+	assert.Contains(t, output, registryAddress.String())
+	assert.Contains(t, output, "1220")
+	assert.Contains(t, output, "5000")
+	assert.Contains(t, output, "0x1234")
+	assert.Contains(t, output, "check_upkeep_tx: execution reverted")
+}
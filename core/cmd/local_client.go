@@ -132,6 +132,41 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 		return cli.errorOut(errors.Wrap(err, "fatal error instantiating application"))
 	}
 
+	if cli.Config.LeaseLockWarmStandby() {
+		// Warm standbys must not write to the database before they get the lease,
+		// so the database, keystore and API user are only set up once they do.
+		// This also has the keystore load keys the active node created meanwhile.
+		if err = app.StartStandby(rootCtx); err != nil {
+			return errors.Wrap(err, "error starting app as warm standby")
+		}
+		var stale bool
+		err = ldb.AwaitLease(rootCtx)
+		if err == nil {
+			stale, err = setupPromotedDatabase(cli.Config, ldb.DB(), lggr)
+		}
+		if err == nil && stale {
+			// The application was loaded from a database that changed since, so
+			// rebuild it rather than run with stale chains.
+			lggr.Info("Database changed while setting it up, rebuilding warm standby application")
+			if err = app.Stop(); err != nil {
+				return errors.Wrap(err, "error stopping warm standby")
+			}
+			if app, err = cli.AppFactory.NewApplication(rootCtx, cli.Config, ldb.DB()); err != nil {
+				return cli.errorOut(errors.Wrap(err, "fatal error instantiating application"))
+			}
+		}
+		if err != nil {
+			if errStop := app.Stop(); errStop != nil {
+				lggr.Errorw("Error stopping warm standby", "err", errStop)
+			}
+			if rootCtx.Err() != nil {
+				// Shut down while waiting for the lease
+				return nil
+			}
+			return errors.Wrap(err, "error promoting warm standby")
+		}
+	}
+
 	sessionORM := app.SessionORM()
 	keyStore := app.GetKeyStore()
 	err = cli.KeyStoreAuthenticator.authenticate(c, keyStore, cli.Config)
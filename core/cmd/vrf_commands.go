@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// VRFRequestLifecyclePresenter presents a VRFRequestLifecycleResource
+type VRFRequestLifecyclePresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.VRFRequestLifecycleResource
+}
+
+var vrfRequestLifecycleHeaders = []string{
+	"Job ID", "Request ID", "Subscription ID", "Status",
+	"Request Tx Hash", "Request Block Number", "Confirmed At Block", "Log Seen At", "Attempts",
+	"Subscription Balance", "Subscription Checked At", "Skip Reason",
+	"Pipeline Run ID", "Eth Tx ID", "Eth Tx State", "Fulfillment Tx Hash", "Fulfillment Receipt Block",
+	"Fulfillment Log Tx Hash", "Fulfillment Log Block", "Fulfillment Success", "Updated At",
+}
+
+// ToRow presents the VRFRequestLifecycleResource as a slice of strings.
+func (p *VRFRequestLifecyclePresenter) ToRow() []string {
+	var subscriptionBalance, subscriptionCheckedAt, fulfillmentSuccess string
+	if p.SubscriptionBalance != nil {
+		subscriptionBalance = p.SubscriptionBalance.String()
+	}
+	if p.SubscriptionCheckedAt.Valid {
+		subscriptionCheckedAt = p.SubscriptionCheckedAt.Time.Format(time.RFC3339)
+	}
+	if p.FulfillmentSuccess.Valid {
+		fulfillmentSuccess = strconv.FormatBool(p.FulfillmentSuccess.Bool)
+	}
+	return []string{
+		strconv.FormatInt(int64(p.JobID), 10),
+		p.RequestID,
+		strconv.FormatUint(p.SubID, 10),
+		string(p.Status),
+		p.RequestTxHash.Hex(),
+		strconv.FormatUint(p.RequestBlockNumber, 10),
+		strconv.FormatUint(p.ConfirmedAtBlock, 10),
+		p.LogSeenAt.Format(time.RFC3339),
+		strconv.FormatInt(int64(p.Attempts), 10),
+		subscriptionBalance,
+		subscriptionCheckedAt,
+		p.SkipReason.ValueOrZero(),
+		optionalIntString(p.PipelineRunID),
+		optionalIntString(p.EthTxID),
+		p.EthTxState.ValueOrZero(),
+		optionalHashString(p.FulfillmentTxHash),
+		optionalIntString(p.FulfillmentReceiptBlockNumber),
+		optionalHashString(p.FulfillmentLogTxHash),
+		optionalIntString(p.FulfillmentLogBlockNumber),
+		fulfillmentSuccess,
+		p.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// VRFRequestLifecyclePresenters implements TableRenderer for a slice of
+// VRFRequestLifecyclePresenter.
+type VRFRequestLifecyclePresenters []VRFRequestLifecyclePresenter
+
+// RenderTable implements TableRenderer
+func (ps VRFRequestLifecyclePresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	renderList(vrfRequestLifecycleHeaders, rows, rt.Writer)
+	_, err := rt.Write([]byte("\n"))
+	return err
+}
+
+func optionalIntString(i null.Int) string {
+	if !i.Valid {
+		return ""
+	}
+	return strconv.FormatInt(i.Int64, 10)
+}
+
+func optionalHashString(h *common.Hash) string {
+	if h == nil {
+		return ""
+	}
+	return h.Hex()
+}
+
+// VRFRequestLifecycle shows how each VRF job which saw a VRF v2 request
+// processed it, from its log to its fulfillment.
+func (cli *Client) VRFRequestLifecycle(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the ID of the request"))
+	}
+
+	resp, err := cli.HTTP.Get(fmt.Sprintf("/v2/vrf/requests/%s/lifecycle", url.PathEscape(c.Args().First())))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var presenters VRFRequestLifecyclePresenters
+	return cli.renderAPIResponse(resp, &presenters)
+}
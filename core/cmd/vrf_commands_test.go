@@ -0,0 +1,73 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestVRFRequestLifecyclePresenters_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		requestTxHash     = common.HexToHash("0x1")
+		fulfillmentTxHash = common.HexToHash("0x2")
+		buffer            = bytes.NewBufferString("")
+		r                 = cmd.RendererTable{Writer: buffer}
+	)
+
+	ps := cmd.VRFRequestLifecyclePresenters{
+		{
+			VRFRequestLifecycleResource: presenters.VRFRequestLifecycleResource{
+				JAID:                          presenters.NewJAID("1-16"),
+				JobID:                         42,
+				RequestID:                     "16",
+				SubID:                         7,
+				Status:                        vrf.RequestStatusEnqueued,
+				RequestTxHash:                 requestTxHash,
+				RequestBlockNumber:            100,
+				ConfirmedAtBlock:              103,
+				LogSeenAt:                     time.Now(),
+				Attempts:                      2,
+				SubscriptionBalance:           utils.NewBigI(5000),
+				PipelineRunID:                 null.IntFrom(3),
+				EthTxID:                       null.IntFrom(4),
+				EthTxState:                    null.StringFrom("confirmed"),
+				FulfillmentTxHash:             &fulfillmentTxHash,
+				FulfillmentReceiptBlockNumber: null.IntFrom(105),
+				UpdatedAt:                     time.Now(),
+			},
+		},
+		{
+			VRFRequestLifecycleResource: presenters.VRFRequestLifecycleResource{
+				JAID:       presenters.NewJAID("2-16"),
+				JobID:      43,
+				RequestID:  "16",
+				Status:     vrf.RequestStatusRetrying,
+				SkipReason: null.StringFrom("insufficient subscription balance"),
+			},
+		},
+	}
+
+	require.NoError(t, ps.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "42")
+	assert.Contains(t, output, "enqueued")
+	assert.Contains(t, output, requestTxHash.Hex())
+	assert.Contains(t, output, fulfillmentTxHash.Hex())
+	assert.Contains(t, output, "5000")
+	assert.Contains(t, output, "confirmed")
+	assert.Contains(t, output, "43")
+	assert.Contains(t, output, "insufficient subscription balance")
+}
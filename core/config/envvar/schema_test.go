@@ -86,6 +86,7 @@ func TestConfigSchema(t *testing.T) {
 		"EvmHeadTrackerMaxBufferSize":                    "ETH_HEAD_TRACKER_MAX_BUFFER_SIZE",
 		"EvmHeadTrackerSamplingInterval":                 "ETH_HEAD_TRACKER_SAMPLING_INTERVAL",
 		"EvmLogBackfillBatchSize":                        "ETH_LOG_BACKFILL_BATCH_SIZE",
+		"EvmLogKeepBlocksDepth":                          "ETH_LOG_KEEP_BLOCKS_DEPTH",
 		"EvmLogPollInterval":                             "ETH_LOG_POLL_INTERVAL",
 		"EvmMaxGasPriceWei":                              "ETH_MAX_GAS_PRICE_WEI",
 		"EvmMaxInFlightTransactions":                     "ETH_MAX_IN_FLIGHT_TRANSACTIONS",
@@ -133,6 +134,7 @@ func TestConfigSchema(t *testing.T) {
 		"KeeperTurnFlagEnabled":                          "KEEPER_TURN_FLAG_ENABLED",
 		"LeaseLockDuration":                              "LEASE_LOCK_DURATION",
 		"LeaseLockRefreshInterval":                       "LEASE_LOCK_REFRESH_INTERVAL",
+		"LeaseLockWarmStandby":                           "LEASE_LOCK_WARM_STANDBY",
 		"LinkContractAddress":                            "LINK_CONTRACT_ADDRESS",
 		"OperatorFactoryAddress":                         "OPERATOR_FACTORY_ADDRESS",
 		"LogFileDir":                                     "LOG_FILE_DIR",
@@ -151,6 +153,8 @@ func TestConfigSchema(t *testing.T) {
 		"NodePollFailureThreshold":                       "NODE_POLL_FAILURE_THRESHOLD",
 		"NodePollInterval":                               "NODE_POLL_INTERVAL",
 		"NodeSelectionMode":                              "NODE_SELECTION_MODE",
+		"NodeQuorumNodes":                                "NODE_QUORUM_NODES",
+		"NodeQuorumThreshold":                            "NODE_QUORUM_THRESHOLD",
 		"ORMMaxIdleConns":                                "ORM_MAX_IDLE_CONNS",
 		"ORMMaxOpenConns":                                "ORM_MAX_OPEN_CONNS",
 		"OptimismGasFees":                                "OPTIMISM_GAS_FEES",
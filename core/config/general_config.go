@@ -140,6 +140,7 @@ type BasicConfig interface {
 	KeystorePassword() string
 	LeaseLockDuration() time.Duration
 	LeaseLockRefreshInterval() time.Duration
+	LeaseLockWarmStandby() bool
 	LogFileDir() string
 	LogLevel() zapcore.Level
 	LogSQL() bool
@@ -230,6 +231,7 @@ type GlobalConfig interface {
 	GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool)
 	GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool)
 	GlobalEvmLogBackfillBatchSize() (uint32, bool)
+	GlobalEvmLogKeepBlocksDepth() (uint32, bool)
 	GlobalEvmLogPollInterval() (time.Duration, bool)
 	GlobalEvmMaxGasPriceWei() (*big.Int, bool)
 	GlobalEvmMaxInFlightTransactions() (uint32, bool)
@@ -252,6 +254,8 @@ type GlobalConfig interface {
 	GlobalNodePollFailureThreshold() (uint32, bool)
 	GlobalNodePollInterval() (time.Duration, bool)
 	GlobalNodeSelectionMode() (string, bool)
+	GlobalNodeQuorumNodes() (uint32, bool)
+	GlobalNodeQuorumThreshold() (uint32, bool)
 }
 
 type GeneralConfig interface {
@@ -416,6 +420,10 @@ EVM_ENABLED=false
 		return errors.Errorf("LEASE_LOCK_REFRESH_INTERVAL must be less than or equal to half of LEASE_LOCK_DURATION (got LEASE_LOCK_REFRESH_INTERVAL=%s, LEASE_LOCK_DURATION=%s)", c.LeaseLockRefreshInterval().String(), c.LeaseLockDuration().String())
 	}
 
+	if c.LeaseLockWarmStandby() && c.DatabaseLockingMode() != "lease" {
+		return errors.Errorf("LEASE_LOCK_WARM_STANDBY requires DATABASE_LOCKING_MODE=lease (got DATABASE_LOCKING_MODE=%s)", c.DatabaseLockingMode())
+	}
+
 	if c.viper.GetString(envvar.Name("LogFileDir")) != "" && c.LogFileMaxSize() <= 0 {
 		c.lggr.Warn("LOG_FILE_DIR is ignored and has no effect when LOG_FILE_MAX_SIZE is not set to a value greater than zero")
 	}
@@ -1333,6 +1341,9 @@ func (c *generalConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, b
 func (c *generalConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return lookupEnv(c, envvar.Name("EvmLogBackfillBatchSize"), parse.Uint32)
 }
+func (c *generalConfig) GlobalEvmLogKeepBlocksDepth() (uint32, bool) {
+	return lookupEnv(c, envvar.Name("EvmLogKeepBlocksDepth"), parse.Uint32)
+}
 func (c *generalConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
 	return lookupEnv(c, envvar.Name("EvmLogPollInterval"), time.ParseDuration)
 }
@@ -1407,6 +1418,14 @@ func (c *generalConfig) GlobalNodeSelectionMode() (string, bool) {
 	return lookupEnv(c, envvar.Name("NodeSelectionMode"), parse.String)
 }
 
+func (c *generalConfig) GlobalNodeQuorumNodes() (uint32, bool) {
+	return lookupEnv(c, envvar.Name("NodeQuorumNodes"), parse.Uint32)
+}
+
+func (c *generalConfig) GlobalNodeQuorumThreshold() (uint32, bool) {
+	return lookupEnv(c, envvar.Name("NodeQuorumThreshold"), parse.Uint32)
+}
+
 // DatabaseLockingMode can be one of 'dual', 'advisorylock', 'lease' or 'none'
 // It controls which mode to use to enforce that only one Chainlink application can use the database
 func (c *generalConfig) DatabaseLockingMode() string {
@@ -1425,6 +1444,13 @@ func (c *generalConfig) LeaseLockDuration() time.Duration {
 	return c.getDuration("LeaseLockDuration")
 }
 
+// LeaseLockWarmStandby runs the node as a warm standby while another node
+// holds the lease: EVM nodes are dialed and heads tracked, so that the node
+// can take over quickly once it gets the lease.
+func (c *generalConfig) LeaseLockWarmStandby() bool {
+	return c.viper.GetBool(envvar.Name("LeaseLockWarmStandby"))
+}
+
 // AdvisoryLockID is the application advisory lock ID. Should match all other
 // chainlink applications that might access this database
 func (c *generalConfig) AdvisoryLockID() int64 {
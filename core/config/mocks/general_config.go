@@ -1551,6 +1551,27 @@ func (_m *GeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmLogKeepBlocksDepth provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmLogKeepBlocksDepth() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmLogPollInterval provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
 	ret := _m.Called()
@@ -1893,6 +1914,48 @@ func (_m *GeneralConfig) GlobalNodePollInterval() (time.Duration, bool) {
 	return r0, r1
 }
 
+// GlobalNodeQuorumNodes provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalNodeQuorumNodes() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalNodeQuorumThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalNodeQuorumThreshold() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalNodeSelectionMode provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalNodeSelectionMode() (string, bool) {
 	ret := _m.Called()
@@ -2355,6 +2418,20 @@ func (_m *GeneralConfig) LeaseLockRefreshInterval() time.Duration {
 	return r0
 }
 
+// LeaseLockWarmStandby provides a mock function with given fields:
+func (_m *GeneralConfig) LeaseLockWarmStandby() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // LogConfiguration provides a mock function with given fields: log
 func (_m *GeneralConfig) LogConfiguration(log config.LogFn) {
 	_m.Called(log)
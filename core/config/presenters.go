@@ -74,6 +74,7 @@ type EnvPrinter struct {
 	KeeperTurnFlagEnabled                      bool            `json:"KEEPER_TURN_FLAG_ENABLED"`
 	LeaseLockDuration                          time.Duration   `json:"LEASE_LOCK_DURATION"`
 	LeaseLockRefreshInterval                   time.Duration   `json:"LEASE_LOCK_REFRESH_INTERVAL"`
+	LeaseLockWarmStandby                       bool            `json:"LEASE_LOCK_WARM_STANDBY"`
 	FlagsContractAddress                       string          `json:"FLAGS_CONTRACT_ADDRESS"`
 	LinkContractAddress                        string          `json:"LINK_CONTRACT_ADDRESS"`
 	LogFileDir                                 string          `json:"LOG_FILE_DIR"`
@@ -191,6 +192,7 @@ func NewConfigPrinter(cfg GeneralConfig) ConfigPrinter {
 
 			LeaseLockDuration:        cfg.LeaseLockDuration(),
 			LeaseLockRefreshInterval: cfg.LeaseLockRefreshInterval(),
+			LeaseLockWarmStandby:     cfg.LeaseLockWarmStandby(),
 			LogFileDir:               cfg.LogFileDir(),
 			LogFileMaxSize:           cfg.LogFileMaxSize(),
 			LogFileMaxAge:            cfg.LogFileMaxAge(),
@@ -338,6 +338,7 @@ func (d *DatabaseListener) setFrom(f *DatabaseListener) {
 type DatabaseLock struct {
 	LeaseDuration        *models.Duration
 	LeaseRefreshInterval *models.Duration
+	WarmStandby          *bool
 }
 
 func (l *DatabaseLock) ValidateConfig() (err error) {
@@ -355,6 +356,9 @@ func (l *DatabaseLock) setFrom(f *DatabaseLock) {
 	if v := f.LeaseRefreshInterval; v != nil {
 		l.LeaseRefreshInterval = v
 	}
+	if v := f.WarmStandby; v != nil {
+		l.WarmStandby = v
+	}
 }
 
 // DatabaseBackup
@@ -41,6 +41,18 @@ import (
 	webhook "github.com/smartcontractkit/chainlink/core/services/webhook"
 
 	zapcore "go.uber.org/zap/zapcore"
+
+	keeper "github.com/smartcontractkit/chainlink/core/services/keeper"
+
+	ethkey "github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+
+	fluxmonitorv2 "github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+
+	directrequest "github.com/smartcontractkit/chainlink/core/services/directrequest"
+
+	blockhashstore "github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+
+	vrf "github.com/smartcontractkit/chainlink/core/services/vrf"
 )
 
 // Application is an autogenerated mock type for the Application type
@@ -62,6 +74,27 @@ func (_m *Application) AddJobV2(ctx context.Context, _a1 *job.Job) error {
 	return r0
 }
 
+// BackfillBlockhashes provides a mock function with given fields: ctx, jobID, fromBlock, toBlock, batchSize, anchor
+func (_m *Application) BackfillBlockhashes(ctx context.Context, jobID int32, fromBlock uint64, toBlock uint64, batchSize uint64, anchor uint64) (blockhashstore.BackfillProgress, error) {
+	ret := _m.Called(ctx, jobID, fromBlock, toBlock, batchSize, anchor)
+
+	var r0 blockhashstore.BackfillProgress
+	if rf, ok := ret.Get(0).(func(context.Context, int32, uint64, uint64, uint64, uint64) blockhashstore.BackfillProgress); ok {
+		r0 = rf(ctx, jobID, fromBlock, toBlock, batchSize, anchor)
+	} else {
+		r0 = ret.Get(0).(blockhashstore.BackfillProgress)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32, uint64, uint64, uint64, uint64) error); ok {
+		r1 = rf(ctx, jobID, fromBlock, toBlock, batchSize, anchor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BridgeORM provides a mock function with given fields:
 func (_m *Application) BridgeORM() bridges.ORM {
 	ret := _m.Called()
@@ -113,6 +146,22 @@ func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	return r0
 }
 
+// DirectRequestORM provides a mock function with given fields:
+func (_m *Application) DirectRequestORM() directrequest.ORM {
+	ret := _m.Called()
+
+	var r0 directrequest.ORM
+	if rf, ok := ret.Get(0).(func() directrequest.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(directrequest.ORM)
+		}
+	}
+
+	return r0
+}
+
 // EVMORM provides a mock function with given fields:
 func (_m *Application) EVMORM() types.ORM {
 	ret := _m.Called()
@@ -301,6 +350,20 @@ func (_m *Application) ID() uuid.UUID {
 	return r0
 }
 
+// ImportJobs provides a mock function with given fields: ctx, jobs, bridgeTypes
+func (_m *Application) ImportJobs(ctx context.Context, jobs []*job.Job, bridgeTypes []*bridges.BridgeType) error {
+	ret := _m.Called(ctx, jobs, bridgeTypes)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*job.Job, []*bridges.BridgeType) error); ok {
+		r0 = rf(ctx, jobs, bridgeTypes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // JobORM provides a mock function with given fields:
 func (_m *Application) JobORM() job.ORM {
 	ret := _m.Called()
@@ -333,6 +396,20 @@ func (_m *Application) JobSpawner() job.Spawner {
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) PauseJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PipelineORM provides a mock function with given fields:
 func (_m *Application) PipelineORM() pipeline.ORM {
 	ret := _m.Called()
@@ -349,6 +426,43 @@ func (_m *Application) PipelineORM() pipeline.ORM {
 	return r0
 }
 
+// PipelineRunner provides a mock function with given fields:
+func (_m *Application) PipelineRunner() pipeline.Runner {
+	ret := _m.Called()
+
+	var r0 pipeline.Runner
+	if rf, ok := ret.Get(0).(func() pipeline.Runner); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pipeline.Runner)
+		}
+	}
+
+	return r0
+}
+
+// PreviewFluxMonitorDeviation provides a mock function with given fields: ctx, jobID
+func (_m *Application) PreviewFluxMonitorDeviation(ctx context.Context, jobID int32) (fluxmonitorv2.DeviationPreview, error) {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 fluxmonitorv2.DeviationPreview
+	if rf, ok := ret.Get(0).(func(context.Context, int32) fluxmonitorv2.DeviationPreview); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Get(0).(fluxmonitorv2.DeviationPreview)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReplayFromBlock provides a mock function with given fields: chainID, number, forceBroadcast
 func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error {
 	ret := _m.Called(chainID, number, forceBroadcast)
@@ -363,6 +477,41 @@ func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBro
 	return r0
 }
 
+// ReportUpkeep provides a mock function with given fields: ctx, registryAddress, upkeepID
+func (_m *Application) ReportUpkeep(ctx context.Context, registryAddress ethkey.EIP55Address, upkeepID *big.Int) (keeper.UpkeepReport, error) {
+	ret := _m.Called(ctx, registryAddress, upkeepID)
+
+	var r0 keeper.UpkeepReport
+	if rf, ok := ret.Get(0).(func(context.Context, ethkey.EIP55Address, *big.Int) keeper.UpkeepReport); ok {
+		r0 = rf(ctx, registryAddress, upkeepID)
+	} else {
+		r0 = ret.Get(0).(keeper.UpkeepReport)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ethkey.EIP55Address, *big.Int) error); ok {
+		r1 = rf(ctx, registryAddress, upkeepID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestFluxMonitorPoll provides a mock function with given fields: ctx, jobID
+func (_m *Application) RequestFluxMonitorPoll(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -377,6 +526,20 @@ func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result
 	return r0
 }
 
+// ResumeJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) ResumeJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RunJobV2 provides a mock function with given fields: ctx, jobID, meta
 func (_m *Application) RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error) {
 	ret := _m.Called(ctx, jobID, meta)
@@ -465,6 +628,27 @@ func (_m *Application) SetLogLevel(lvl zapcore.Level) error {
 	return r0
 }
 
+// SimulateJobV2 provides a mock function with given fields: ctx, toml, vars, ethTxOutputs
+func (_m *Application) SimulateJobV2(ctx context.Context, toml string, vars map[string]interface{}, ethTxOutputs map[string]interface{}) (pipeline.Run, error) {
+	ret := _m.Called(ctx, toml, vars, ethTxOutputs)
+
+	var r0 pipeline.Run
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]interface{}, map[string]interface{}) pipeline.Run); ok {
+		r0 = rf(ctx, toml, vars, ethTxOutputs)
+	} else {
+		r0 = ret.Get(0).(pipeline.Run)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, map[string]interface{}, map[string]interface{}) error); ok {
+		r1 = rf(ctx, toml, vars, ethTxOutputs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *Application) Start(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -479,6 +663,20 @@ func (_m *Application) Start(ctx context.Context) error {
 	return r0
 }
 
+// StartStandby provides a mock function with given fields: ctx
+func (_m *Application) StartStandby(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Stop provides a mock function with given fields:
 func (_m *Application) Stop() error {
 	ret := _m.Called()
@@ -509,6 +707,36 @@ func (_m *Application) TxmORM() txmgr.ORM {
 	return r0
 }
 
+// UpdateJobV2 provides a mock function with given fields: ctx, jobID, _a2
+func (_m *Application) UpdateJobV2(ctx context.Context, jobID int32, _a2 *job.Job) error {
+	ret := _m.Called(ctx, jobID, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, *job.Job) error); ok {
+		r0 = rf(ctx, jobID, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VRFORM provides a mock function with given fields:
+func (_m *Application) VRFORM() vrf.ORM {
+	ret := _m.Called()
+
+	var r0 vrf.ORM
+	if rf, ok := ret.Get(0).(func() vrf.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(vrf.ORM)
+		}
+	}
+
+	return r0
+}
+
 // WakeSessionReaper provides a mock function with given fields:
 func (_m *Application) WakeSessionReaper() {
 	_m.Called()
@@ -57,13 +57,16 @@ func ExampleRun() {
 	// COMMANDS:
 	//    admin           Commands for remotely taking admin related actions
 	//    attempts, txas  Commands for managing Ethereum Transaction Attempts
+	//    bhs             Commands for blockhash store jobs
 	//    blocks          Commands for managing blocks
 	//    bridges         Commands for Bridges communicating with External Adapters
 	//    config          Commands for the node's configuration
 	//    jobs            Commands for managing Jobs
+	//    keeper          Commands for inspecting keeper registries
 	//    keys            Commands for managing various types of keys used by the Chainlink node
 	//    node, local     Commands for admin actions that must be run locally
 	//    txs             Commands for handling transactions
+	//    vrf             Commands for inspecting VRF requests
 	//    chains          Commands for handling chain configuration
 	//    nodes           Commands for handling node configuration
 	//    forwarders      Commands for managing forwarder addresses.
@@ -114,6 +117,22 @@ func ExampleRun_attempts() {
 	//    --help, -h  show help
 }
 
+func ExampleRun_bhs() {
+	Run("bhs", "--help")
+	// Output:
+	// NAME:
+	//    core.test bhs - Commands for blockhash store jobs
+	//
+	// USAGE:
+	//    core.test bhs command [command options] [arguments...]
+	//
+	// COMMANDS:
+	//    backfill  Stores the blockhashes of blocks with unfulfilled VRF requests in a range, e.g. after the node was down
+	//
+	// OPTIONS:
+	//    --help, -h  show help
+}
+
 func ExampleRun_blocks() {
 	Run("blocks", "--help")
 	// Output:
@@ -124,7 +143,8 @@ func ExampleRun_blocks() {
 	//    core.test blocks command [command options] [arguments...]
 	//
 	// COMMANDS:
-	//    replay  Replays block data from the given number
+	//    replay   Replays block data from the given number
+	//    reorged  Lists the logs which jobs consumed before they were removed by a reorg
 	//
 	// OPTIONS:
 	//    --help, -h  show help
@@ -180,11 +200,39 @@ func ExampleRun_jobs() {
 	//    core.test jobs command [command options] [arguments...]
 	//
 	// COMMANDS:
-	//    list    List all jobs
-	//    show    Show a job
-	//    create  Create a job
-	//    delete  Delete a job
-	//    run     Trigger a job run
+	//    list        List all jobs
+	//    show        Show a job
+	//    create      Create a job
+	//    update      Replace the spec of a job, keeping its ID and runs
+	//    history     List the versions of a job's spec
+	//    diff        Show the differences between two versions of a job's spec, e.g. 'diff JOB_ID 1 [2]'. Compares to the latest version by default
+	//    rollback    Restore an earlier version of a job's spec
+	//    delete      Delete a job
+	//    pause       Stop the services of a job until it is resumed, keeping its spec, keys and runs
+	//    resume      Start the services of a paused job again
+	//    run         Trigger a job run
+	//    poll        Make a flux monitor job poll and submit an answer now, regardless of deviation
+	//    deviation   Show the deviation of a flux monitor job's current answer from its latest submission, without submitting it
+	//    rejections  List the oracle requests a direct request job rejected without running them
+	//    simulate    Execute a job spec's pipeline without creating the job or sending transactions. Bridge and http tasks are executed
+	//    export      Export jobs, along with the bridges and external initiators they reference, to a bundle, e.g. 'export -o bundle.json [JOB_ID...]'. Exports all jobs by default
+	//    import      Validate a bundle and create all of its bridges and jobs in a single transaction
+	//
+	// OPTIONS:
+	//    --help, -h  show help
+}
+
+func ExampleRun_keeper() {
+	Run("keeper", "--help")
+	// Output:
+	// NAME:
+	//    core.test keeper - Commands for inspecting keeper registries
+	//
+	// USAGE:
+	//    core.test keeper command [command options] [arguments...]
+	//
+	// COMMANDS:
+	//    report  Checks an upkeep at the latest head and reports whether this node would perform it, without submitting anything
 	//
 	// OPTIONS:
 	//    --help, -h  show help
@@ -537,6 +585,7 @@ func ExampleRun_txs_evm() {
 	//    create  Send <amount> ETH (or wei) from node ETH account <fromAddress> to destination <toAddress>.
 	//    list    List the Ethereum Transactions in descending order
 	//    show    get information on a specific Ethereum Transaction
+	//    cancel  Cancel an unconfirmed Ethereum Transaction, given its ID, by replacing its nonce with a zero-value self-send at a bumped gas price
 	//
 	// OPTIONS:
 	//    --help, -h  show help
@@ -574,6 +623,22 @@ func ExampleRun_txs_terra() {
 	//    --help, -h  show help
 }
 
+func ExampleRun_vrf() {
+	Run("vrf", "--help")
+	// Output:
+	// NAME:
+	//    core.test vrf - Commands for inspecting VRF requests
+	//
+	// USAGE:
+	//    core.test vrf command [command options] [arguments...]
+	//
+	// COMMANDS:
+	//    lifecycle  Shows how each VRF job processed a VRF v2 request, given its ID in decimal or 0x prefixed hex
+	//
+	// OPTIONS:
+	//    --help, -h  show help
+}
+
 func ExampleRun_chains() {
 	Run("chains", "--help")
 	// Output:
@@ -0,0 +1,204 @@
+package blockhashstore
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// storeLookback is the age, in blocks, up to which a blockhash is stored with the
+// BlockhashStore's store function. It leaves time for the transaction to be included before
+// the block falls out of the 256 blocks available to the BLOCKHASH opcode. Older blockhashes
+// are stored by verifying the header of the following block.
+const storeLookback = 200
+
+// BackfillProgress reports the state of a backfill after one of its batches.
+type BackfillProgress struct {
+	// FromBlock and ToBlock are the blocks scanned by the batch.
+	FromBlock uint64
+	ToBlock   uint64
+	// NextBlock is the highest block left to scan, where the next batch starts. It is only
+	// set if Done is false.
+	NextBlock uint64
+	// Anchor is the lowest block whose hash is stored, or will be once the transactions sent
+	// by the backfill are confirmed. Earlier blockhashes are stored back from it. It is zero
+	// until the first blockhash is found or stored.
+	Anchor uint64
+	// Unfulfilled is the number of blocks of the batch with unfulfilled requests.
+	Unfulfilled int
+	// AlreadyStored is the number of those blocks whose hash was already stored.
+	AlreadyStored int
+	// Stored is the number of those blocks whose hash was stored by the batch.
+	Stored int
+	// HeadersVerified is the number of blockhashes stored by verifying a block header. It
+	// includes the blocks without requests between the anchor and a block with unfulfilled
+	// requests.
+	HeadersVerified int
+	Done            bool
+}
+
+// NewBackfiller creates a new Backfiller instance.
+func NewBackfiller(
+	logger logger.Logger,
+	coordinator Coordinator,
+	bhs BHS,
+	latestBlock func(ctx context.Context) (uint64, error),
+	header func(ctx context.Context, blockNum uint64) ([]byte, error),
+) *Backfiller {
+	return &Backfiller{
+		lggr:        logger,
+		coordinator: coordinator,
+		bhs:         bhs,
+		latestBlock: latestBlock,
+		header:      header,
+	}
+}
+
+// Backfiller stores the blockhashes of historical blocks with unfulfilled VRF requests, which
+// are out of the range watched by the Feeder, e.g. after the node was down. The range is
+// scanned backwards in batches, so that blockhashes older than 256 blocks can be stored from
+// the header of the following block, whose hash is stored first.
+type Backfiller struct {
+	lggr        logger.Logger
+	coordinator Coordinator
+	bhs         BHS
+	latestBlock func(ctx context.Context) (uint64, error)
+	// header returns the RLP encoded header of a block.
+	header func(ctx context.Context, blockNum uint64) ([]byte, error)
+}
+
+// Backfill runs the batch of at most batchSize blocks ending at toBlock, storing the missing
+// blockhashes of its blocks with unfulfilled requests. anchor is the Anchor of the previous
+// batch, or zero for the first one. At most batchSize headers are verified per batch; when
+// more are needed, the next batch starts at toBlock again.
+//
+// Blocks which are already stored are skipped, so a backfill can be resumed from the
+// NextBlock and Anchor of its last batch, or by running it again.
+func (b *Backfiller) Backfill(ctx context.Context, fromBlock, toBlock, batchSize, anchor uint64) (BackfillProgress, error) {
+	if batchSize == 0 {
+		return BackfillProgress{}, errors.New("batch size must be greater than 0")
+	}
+	if fromBlock > toBlock {
+		return BackfillProgress{}, errors.Errorf("from block %d is after to block %d", fromBlock, toBlock)
+	}
+	latestBlock, err := b.latestBlock(ctx)
+	if err != nil {
+		return BackfillProgress{}, errors.Wrap(err, "fetching block number")
+	}
+	if toBlock > latestBlock {
+		return BackfillProgress{}, errors.Errorf("to block %d is after the latest block %d", toBlock, latestBlock)
+	}
+
+	progress := BackfillProgress{FromBlock: fromBlock, ToBlock: toBlock, Anchor: anchor}
+	if toBlock-fromBlock >= batchSize {
+		progress.FromBlock = toBlock - batchSize + 1
+	}
+
+	blocks, err := b.unfulfilledBlocks(ctx, progress.FromBlock, progress.ToBlock, latestBlock, batchSize)
+	if err != nil {
+		return progress, err
+	}
+	progress.Unfulfilled = len(blocks)
+
+	for _, block := range blocks {
+		if progress.Anchor != 0 && block >= progress.Anchor {
+			// Stored back from the anchor by an earlier batch
+			continue
+		}
+		stored, err := b.bhs.IsStored(ctx, block)
+		if err != nil {
+			return progress, errors.Wrap(err, "checking if stored")
+		}
+		if stored {
+			progress.AlreadyStored++
+			progress.Anchor = block
+			continue
+		}
+
+		if block+storeLookback > latestBlock {
+			if err = b.bhs.Store(ctx, block); err != nil {
+				return progress, errors.Wrap(err, "storing block")
+			}
+			b.lggr.Infow("Stored blockhash", "block", block, "latestBlock", latestBlock)
+			progress.Stored++
+			progress.Anchor = block
+			continue
+		}
+
+		if progress.Anchor == 0 || progress.Anchor > latestBlock-storeLookback {
+			// Verifying headers from a recent block is cheaper than from a later anchor
+			anchorBlock := latestBlock - storeLookback
+			if err = b.bhs.Store(ctx, anchorBlock); err != nil {
+				return progress, errors.Wrap(err, "storing anchor block")
+			}
+			b.lggr.Infow("Stored anchor blockhash", "block", anchorBlock, "latestBlock", latestBlock)
+			progress.Anchor = anchorBlock
+		}
+		for progress.Anchor > block {
+			if progress.HeadersVerified >= int(batchSize) {
+				progress.NextBlock = toBlock
+				return progress, nil
+			}
+			header, err := b.header(ctx, progress.Anchor)
+			if err != nil {
+				return progress, errors.Wrapf(err, "getting header of block %d", progress.Anchor)
+			}
+			if err = b.bhs.StoreVerifyHeader(ctx, progress.Anchor-1, header); err != nil {
+				return progress, errors.Wrap(err, "storing block from header")
+			}
+			progress.Anchor--
+			progress.HeadersVerified++
+		}
+		b.lggr.Infow("Stored blockhash from header", "block", block, "latestBlock", latestBlock)
+		progress.Stored++
+	}
+
+	if progress.FromBlock == fromBlock {
+		progress.Done = true
+	} else {
+		progress.NextBlock = progress.FromBlock - 1
+	}
+	return progress, nil
+}
+
+// unfulfilledBlocks returns the blocks between fromBlock and toBlock with unfulfilled
+// requests, the latest first. Fulfillments are fetched in windows of at most windowSize
+// blocks from fromBlock up to latestBlock, until all the requests are found fulfilled.
+func (b *Backfiller) unfulfilledBlocks(ctx context.Context, fromBlock, toBlock, latestBlock, windowSize uint64) ([]uint64, error) {
+	reqs, err := b.coordinator.Requests(ctx, fromBlock, toBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching VRF requests")
+	}
+
+	pending := make(map[string]uint64, len(reqs))
+	for _, req := range reqs {
+		pending[req.ID] = req.Block
+	}
+	for start := fromBlock; start <= latestBlock && len(pending) > 0; start += windowSize {
+		end := start + windowSize - 1
+		if end > latestBlock {
+			end = latestBlock
+		}
+		fuls, err := b.coordinator.Fulfillments(ctx, start, end)
+		if err != nil {
+			return nil, errors.Wrap(err, "fetching VRF fulfillments")
+		}
+		for _, ful := range fuls {
+			delete(pending, ful.ID)
+		}
+	}
+
+	unfulfilled := make(map[uint64]struct{})
+	for _, block := range pending {
+		unfulfilled[block] = struct{}{}
+	}
+	blocks := make([]uint64, 0, len(unfulfilled))
+	for block := range unfulfilled {
+		blocks = append(blocks, block)
+	}
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i] > blocks[j] })
+	return blocks, nil
+}
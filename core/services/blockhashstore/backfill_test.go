@@ -0,0 +1,215 @@
+package blockhashstore
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func newTestBackfiller(t *testing.T, coordinator Coordinator, bhs BHS, latest uint64) *Backfiller {
+	return NewBackfiller(
+		logger.TestLogger(t),
+		coordinator,
+		bhs,
+		func(ctx context.Context) (uint64, error) {
+			return latest, nil
+		},
+		func(ctx context.Context, blockNum uint64) ([]byte, error) {
+			return []byte(strconv.FormatUint(blockNum, 10)), nil
+		})
+}
+
+func TestBackfiller_Backfill(t *testing.T) {
+	tests := []struct {
+		name             string
+		requests         []Event
+		fulfillments     []Event
+		bhs              testBHS
+		from, to         uint64
+		expectedStored   []uint64
+		expectedProgress BackfillProgress
+	}{
+		{
+			name:           "recent unfulfilled request",
+			requests:       []Event{{Block: 900, ID: "request"}},
+			from:           850,
+			to:             950,
+			expectedStored: []uint64{900},
+			expectedProgress: BackfillProgress{
+				FromBlock: 850, ToBlock: 950, Anchor: 900, Unfulfilled: 1, Stored: 1, Done: true},
+		},
+		{
+			name:             "fulfilled request",
+			requests:         []Event{{Block: 795, ID: "request"}},
+			fulfillments:     []Event{{Block: 900, ID: "request"}},
+			from:             700,
+			to:               799,
+			expectedStored:   nil,
+			expectedProgress: BackfillProgress{FromBlock: 700, ToBlock: 799, Done: true},
+		},
+		{
+			name:           "old unfulfilled request stored from headers",
+			requests:       []Event{{Block: 795, ID: "request"}},
+			from:           700,
+			to:             799,
+			expectedStored: []uint64{800, 799, 798, 797, 796, 795},
+			expectedProgress: BackfillProgress{
+				FromBlock: 700, ToBlock: 799, Anchor: 795, Unfulfilled: 1, Stored: 1, HeadersVerified: 5, Done: true},
+		},
+		{
+			name:           "already stored block is used as anchor",
+			requests:       []Event{{Block: 790, ID: "request1"}, {Block: 785, ID: "request2"}},
+			bhs:            testBHS{stored: []uint64{790}},
+			from:           700,
+			to:             799,
+			expectedStored: []uint64{790, 789, 788, 787, 786, 785},
+			expectedProgress: BackfillProgress{
+				FromBlock: 700, ToBlock: 799, Anchor: 785, Unfulfilled: 2, AlreadyStored: 1, Stored: 1, HeadersVerified: 5, Done: true},
+		},
+		{
+			name:           "multiple requests same block",
+			requests:       []Event{{Block: 900, ID: "request1"}, {Block: 900, ID: "request2"}},
+			fulfillments:   []Event{{Block: 910, ID: "request1"}},
+			from:           850,
+			to:             950,
+			expectedStored: []uint64{900},
+			expectedProgress: BackfillProgress{
+				FromBlock: 850, ToBlock: 950, Anchor: 900, Unfulfilled: 1, Stored: 1, Done: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			coordinator := &testCoordinator{
+				requests:     test.requests,
+				fulfillments: test.fulfillments,
+			}
+			backfiller := newTestBackfiller(t, coordinator, &test.bhs, 1000)
+
+			progress, err := backfiller.Backfill(testutils.Context(t), test.from, test.to, 1000, 0)
+			require.NoError(t, err)
+			require.Equal(t, test.expectedProgress, progress)
+			require.Equal(t, test.expectedStored, test.bhs.stored)
+		})
+	}
+}
+
+func TestBackfiller_Batches(t *testing.T) {
+	coordinator := &testCoordinator{
+		requests: []Event{{Block: 760, ID: "request1"}, {Block: 720, ID: "request2"}},
+	}
+	bhs := &testBHS{}
+	backfiller := newTestBackfiller(t, coordinator, bhs, 1000)
+	ctx := testutils.Context(t)
+
+	progress, err := backfiller.Backfill(ctx, 700, 799, 50, 0)
+	require.NoError(t, err)
+	require.Equal(t, BackfillProgress{
+		FromBlock: 750, ToBlock: 799, NextBlock: 749, Anchor: 760, Unfulfilled: 1, Stored: 1, HeadersVerified: 40,
+	}, progress)
+
+	progress, err = backfiller.Backfill(ctx, 700, progress.NextBlock, 50, progress.Anchor)
+	require.NoError(t, err)
+	require.Equal(t, BackfillProgress{
+		FromBlock: 700, ToBlock: 749, Anchor: 720, Unfulfilled: 1, Stored: 1, HeadersVerified: 40, Done: true,
+	}, progress)
+
+	require.Len(t, bhs.stored, 81)
+	require.Equal(t, uint64(800), bhs.stored[0])
+	require.Equal(t, uint64(720), bhs.stored[80])
+	require.Equal(t, []byte("800"), bhs.headers[0])
+}
+
+func TestBackfiller_FulfillmentWindows(t *testing.T) {
+	coordinator := &testCoordinator{
+		requests:     []Event{{Block: 760, ID: "request1"}, {Block: 720, ID: "request2"}},
+		fulfillments: []Event{{Block: 770, ID: "request1"}, {Block: 860, ID: "request2"}},
+	}
+	bhs := &testBHS{}
+	backfiller := newTestBackfiller(t, coordinator, bhs, 1000)
+	ctx := testutils.Context(t)
+
+	progress, err := backfiller.Backfill(ctx, 700, 799, 50, 0)
+	require.NoError(t, err)
+	require.Equal(t, BackfillProgress{FromBlock: 750, ToBlock: 799, NextBlock: 749}, progress)
+	require.Equal(t, [][2]uint64{{750, 799}}, coordinator.fulfillmentRanges)
+
+	// Fulfillments are fetched in bounded windows until the request is found fulfilled
+	coordinator.fulfillmentRanges = nil
+	progress, err = backfiller.Backfill(ctx, 700, progress.NextBlock, 50, progress.Anchor)
+	require.NoError(t, err)
+	require.Equal(t, BackfillProgress{FromBlock: 700, ToBlock: 749, Done: true}, progress)
+	require.Equal(t, [][2]uint64{{700, 749}, {750, 799}, {800, 849}, {850, 899}}, coordinator.fulfillmentRanges)
+	require.Empty(t, bhs.stored)
+}
+
+func TestBackfiller_LimitsHeadersPerBatch(t *testing.T) {
+	coordinator := &testCoordinator{
+		requests: []Event{{Block: 785, ID: "request"}},
+	}
+	bhs := &testBHS{}
+	backfiller := newTestBackfiller(t, coordinator, bhs, 1000)
+	ctx := testutils.Context(t)
+
+	progress, err := backfiller.Backfill(ctx, 780, 789, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, BackfillProgress{
+		FromBlock: 780, ToBlock: 789, NextBlock: 789, Anchor: 790, Unfulfilled: 1, HeadersVerified: 10,
+	}, progress)
+
+	// The same batch is resumed from the anchor
+	progress, err = backfiller.Backfill(ctx, 780, progress.NextBlock, 10, progress.Anchor)
+	require.NoError(t, err)
+	require.Equal(t, BackfillProgress{
+		FromBlock: 780, ToBlock: 789, Anchor: 785, Unfulfilled: 1, Stored: 1, HeadersVerified: 5, Done: true,
+	}, progress)
+	require.Len(t, bhs.stored, 16)
+}
+
+func TestBackfiller_Resume(t *testing.T) {
+	coordinator := &testCoordinator{
+		requests: []Event{{Block: 900, ID: "request1"}, {Block: 795, ID: "request2"}},
+	}
+	bhs := &testBHS{}
+	backfiller := newTestBackfiller(t, coordinator, bhs, 1000)
+	ctx := testutils.Context(t)
+
+	_, err := backfiller.Backfill(ctx, 700, 950, 1000, 0)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{900, 800, 799, 798, 797, 796, 795}, bhs.stored)
+
+	// Running the backfill again does not store anything
+	progress, err := backfiller.Backfill(ctx, 700, 950, 1000, 0)
+	require.NoError(t, err)
+	require.Equal(t, BackfillProgress{
+		FromBlock: 700, ToBlock: 950, Anchor: 795, Unfulfilled: 2, AlreadyStored: 2, Done: true,
+	}, progress)
+	require.Len(t, bhs.stored, 7)
+}
+
+func TestBackfiller_Errors(t *testing.T) {
+	ctx := testutils.Context(t)
+
+	t.Run("invalid range", func(t *testing.T) {
+		backfiller := newTestBackfiller(t, &testCoordinator{}, &testBHS{}, 1000)
+		_, err := backfiller.Backfill(ctx, 800, 700, 100, 0)
+		require.EqualError(t, err, "from block 800 is after to block 700")
+		_, err = backfiller.Backfill(ctx, 700, 1001, 100, 0)
+		require.EqualError(t, err, "to block 1001 is after the latest block 1000")
+		_, err = backfiller.Backfill(ctx, 700, 800, 0, 0)
+		require.EqualError(t, err, "batch size must be greater than 0")
+	})
+
+	t.Run("error storing", func(t *testing.T) {
+		coordinator := &testCoordinator{requests: []Event{{Block: 900, ID: "request"}}}
+		bhs := &testBHS{errorsStore: []uint64{900}}
+		backfiller := newTestBackfiller(t, coordinator, bhs, 1000)
+		_, err := backfiller.Backfill(ctx, 850, 950, 100, 0)
+		require.EqualError(t, err, "storing block: error storing")
+	})
+}
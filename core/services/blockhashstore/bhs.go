@@ -79,6 +79,30 @@ func (c *BulletproofBHS) Store(ctx context.Context, blockNum uint64) error {
 	return nil
 }
 
+// StoreVerifyHeader satisfies the BHS interface.
+func (c *BulletproofBHS) StoreVerifyHeader(ctx context.Context, blockNum uint64, header []byte) error {
+	payload, err := c.abi.Pack("storeVerifyHeader", new(big.Int).SetUint64(blockNum), header)
+	if err != nil {
+		return errors.Wrap(err, "packing args")
+	}
+
+	_, err = c.txm.CreateEthTransaction(txmgr.NewTx{
+		FromAddress:    c.fromAddress,
+		ToAddress:      c.bhs.Address(),
+		EncodedPayload: payload,
+		GasLimit:       c.config.EvmGasLimitDefault(),
+
+		// Every transaction is needed, each one stores the blockhash the next one verifies a
+		// header against.
+		Strategy: txmgr.NewSendEveryStrategy(),
+	}, pg.WithParentCtx(ctx))
+	if err != nil {
+		return errors.Wrap(err, "creating transaction")
+	}
+
+	return nil
+}
+
 // IsStored satisfies the BHS interface.
 func (c *BulletproofBHS) IsStored(ctx context.Context, blockNum uint64) (bool, error) {
 	_, err := c.bhs.GetBlockhash(&bind.CallOpts{Context: ctx}, big.NewInt(int64(blockNum)))
@@ -48,10 +48,10 @@ func (m MultiCoordinator) Requests(
 }
 
 // Fulfillments satisfies the Coordinator interface.
-func (m MultiCoordinator) Fulfillments(ctx context.Context, fromBlock uint64) ([]Event, error) {
+func (m MultiCoordinator) Fulfillments(ctx context.Context, fromBlock uint64, toBlock uint64) ([]Event, error) {
 	var fuls []Event
 	for _, c := range m {
-		f, err := c.Fulfillments(ctx, fromBlock)
+		f, err := c.Fulfillments(ctx, fromBlock, toBlock)
 		if err != nil {
 			return nil, fmt.Errorf("%w", err)
 		}
@@ -96,9 +96,10 @@ func (v *V1Coordinator) Requests(
 }
 
 // Fulfillments satisfies the Coordinator interface.
-func (v *V1Coordinator) Fulfillments(ctx context.Context, fromBlock uint64) ([]Event, error) {
+func (v *V1Coordinator) Fulfillments(ctx context.Context, fromBlock uint64, toBlock uint64) ([]Event, error) {
 	iter, err := v.c.FilterRandomnessRequestFulfilled(&bind.FilterOpts{
 		Start:   fromBlock,
+		End:     &toBlock,
 		Context: ctx,
 	})
 	if err != nil {
@@ -151,9 +152,10 @@ func (v *V2Coordinator) Requests(
 }
 
 // Fulfillments satisfies the Coordinator interface.
-func (v *V2Coordinator) Fulfillments(ctx context.Context, fromBlock uint64) ([]Event, error) {
+func (v *V2Coordinator) Fulfillments(ctx context.Context, fromBlock uint64, toBlock uint64) ([]Event, error) {
 	iter, err := v.c.FilterRandomWordsFulfilled(&bind.FilterOpts{
 		Start:   fromBlock,
+		End:     &toBlock,
 		Context: ctx,
 	}, nil)
 	if err != nil {
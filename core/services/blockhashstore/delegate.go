@@ -3,8 +3,10 @@ package blockhashstore
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
@@ -63,71 +65,137 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 			chain.Config().EvmFinalityDepth(), jb.BlockhashStoreSpec.WaitBlocks)
 	}
 
+	coordinator, bpBHS, err := d.contracts(chain, jb.BlockhashStoreSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	log := d.logger.Named("BHS Feeder").With("jobID", jb.ID, "externalJobID", jb.ExternalJobID)
+	feeder := NewFeeder(
+		log,
+		coordinator,
+		bpBHS,
+		int(jb.BlockhashStoreSpec.WaitBlocks),
+		int(jb.BlockhashStoreSpec.LookbackBlocks),
+		latestBlock(chain))
+
+	return []job.ServiceCtx{&service{
+		feeder:     feeder,
+		pollPeriod: jb.BlockhashStoreSpec.PollPeriod,
+		runTimeout: jb.BlockhashStoreSpec.RunTimeout,
+		logger:     log,
+		done:       make(chan struct{}),
+	}}, nil
+}
+
+// Backfill runs a batch of a backfill of historical blockhashes for a BlockhashStore job, using
+// its coordinators, BlockhashStore contract and sending key. See Backfiller.Backfill.
+func (d *Delegate) Backfill(ctx context.Context, jb job.Job, fromBlock, toBlock, batchSize, anchor uint64) (BackfillProgress, error) {
+	if jb.BlockhashStoreSpec == nil {
+		return BackfillProgress{}, errors.Errorf(
+			"blockhashstore.Delegate expects a BlockhashStoreSpec to be present, got %+v", jb)
+	}
+
+	chain, err := d.chains.Get(jb.BlockhashStoreSpec.EVMChainID.ToInt())
+	if err != nil {
+		return BackfillProgress{}, fmt.Errorf(
+			"getting chain ID %d: %w", jb.BlockhashStoreSpec.EVMChainID.ToInt(), err)
+	}
+
+	coordinator, bpBHS, err := d.contracts(chain, jb.BlockhashStoreSpec)
+	if err != nil {
+		return BackfillProgress{}, err
+	}
+
+	log := d.logger.Named("BHS Backfiller").With("jobID", jb.ID, "externalJobID", jb.ExternalJobID)
+	backfiller := NewBackfiller(
+		log,
+		coordinator,
+		bpBHS,
+		latestBlock(chain),
+		func(ctx context.Context, blockNum uint64) ([]byte, error) {
+			n := new(big.Int).SetUint64(blockNum)
+			header, err := chain.Client().HeaderByNumber(ctx, n)
+			if err != nil {
+				return nil, errors.Wrap(err, "getting header")
+			}
+			// Some chains hash their headers differently, in which case the BlockhashStore
+			// can not verify them either.
+			head, err := chain.Client().HeadByNumber(ctx, n)
+			if err != nil {
+				return nil, errors.Wrap(err, "getting block")
+			}
+			if header.Hash() != head.Hash {
+				return nil, errors.Errorf(
+					"hash %s of the header does not match blockhash %s", header.Hash(), head.Hash)
+			}
+			return rlp.EncodeToBytes(header)
+		})
+
+	progress, err := backfiller.Backfill(ctx, fromBlock, toBlock, batchSize, anchor)
+	if err != nil {
+		log.Errorw("BHS backfill batch failed", "error", err, "progress", progress)
+		return progress, err
+	}
+	log.Infow("BHS backfill batch completed", "progress", progress)
+	return progress, nil
+}
+
+// contracts builds the coordinators and the BlockhashStore of a BlockhashStore job.
+func (d *Delegate) contracts(chain evm.Chain, spec *job.BlockhashStoreSpec) (Coordinator, *BulletproofBHS, error) {
 	keys, err := d.ks.EnabledKeysForChain(chain.ID())
 	if err != nil {
-		return nil, errors.Wrap(err, "getting sending keys")
+		return nil, nil, errors.Wrap(err, "getting sending keys")
 	}
 	if len(keys) == 0 {
-		return nil, fmt.Errorf("missing sending keys for chain ID: %v", chain.ID())
+		return nil, nil, fmt.Errorf("missing sending keys for chain ID: %v", chain.ID())
 	}
 	fromAddress := keys[0].Address
-	if jb.BlockhashStoreSpec.FromAddress != nil {
-		fromAddress = jb.BlockhashStoreSpec.FromAddress.Address()
+	if spec.FromAddress != nil {
+		fromAddress = spec.FromAddress.Address()
 	}
 
 	bhs, err := blockhash_store.NewBlockhashStore(
-		jb.BlockhashStoreSpec.BlockhashStoreAddress.Address(), chain.Client())
+		spec.BlockhashStoreAddress.Address(), chain.Client())
 	if err != nil {
-		return nil, errors.Wrap(err, "building BHS")
+		return nil, nil, errors.Wrap(err, "building BHS")
 	}
 
 	var coordinators []Coordinator
-	if jb.BlockhashStoreSpec.CoordinatorV1Address != nil {
+	if spec.CoordinatorV1Address != nil {
 		var c *v1.VRFCoordinator
 		if c, err = v1.NewVRFCoordinator(
-			jb.BlockhashStoreSpec.CoordinatorV1Address.Address(), chain.Client()); err != nil {
+			spec.CoordinatorV1Address.Address(), chain.Client()); err != nil {
 
-			return nil, errors.Wrap(err, "building V1 coordinator")
+			return nil, nil, errors.Wrap(err, "building V1 coordinator")
 		}
 		coordinators = append(coordinators, NewV1Coordinator(c))
 	}
-	if jb.BlockhashStoreSpec.CoordinatorV2Address != nil {
+	if spec.CoordinatorV2Address != nil {
 		var c *v2.VRFCoordinatorV2
 		if c, err = v2.NewVRFCoordinatorV2(
-			jb.BlockhashStoreSpec.CoordinatorV2Address.Address(), chain.Client()); err != nil {
+			spec.CoordinatorV2Address.Address(), chain.Client()); err != nil {
 
-			return nil, errors.Wrap(err, "building V2 coordinator")
+			return nil, nil, errors.Wrap(err, "building V2 coordinator")
 		}
 		coordinators = append(coordinators, NewV2Coordinator(c))
 	}
 
 	bpBHS, err := NewBulletproofBHS(chain.Config(), fromAddress, chain.TxManager(), bhs)
 	if err != nil {
-		return nil, errors.Wrap(err, "building bulletproof bhs")
+		return nil, nil, errors.Wrap(err, "building bulletproof bhs")
 	}
+	return NewMultiCoordinator(coordinators...), bpBHS, nil
+}
 
-	log := d.logger.Named("BHS Feeder").With("jobID", jb.ID, "externalJobID", jb.ExternalJobID)
-	feeder := NewFeeder(
-		log,
-		NewMultiCoordinator(coordinators...),
-		bpBHS,
-		int(jb.BlockhashStoreSpec.WaitBlocks),
-		int(jb.BlockhashStoreSpec.LookbackBlocks),
-		func(ctx context.Context) (uint64, error) {
-			head, err := chain.Client().HeadByNumber(ctx, nil)
-			if err != nil {
-				return 0, errors.Wrap(err, "getting chain head")
-			}
-			return uint64(head.Number), nil
-		})
-
-	return []job.ServiceCtx{&service{
-		feeder:     feeder,
-		pollPeriod: jb.BlockhashStoreSpec.PollPeriod,
-		runTimeout: jb.BlockhashStoreSpec.RunTimeout,
-		logger:     log,
-		done:       make(chan struct{}),
-	}}, nil
+func latestBlock(chain evm.Chain) func(ctx context.Context) (uint64, error) {
+	return func(ctx context.Context) (uint64, error) {
+		head, err := chain.Client().HeadByNumber(ctx, nil)
+		if err != nil {
+			return 0, errors.Wrap(err, "getting chain head")
+		}
+		return uint64(head.Number), nil
+	}
 }
 
 // AfterJobCreated satisfies the job.Delegate interface.
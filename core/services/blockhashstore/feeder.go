@@ -25,8 +25,8 @@ type Coordinator interface {
 	// Requests fetches VRF requests that occurred within the specified blocks.
 	Requests(ctx context.Context, fromBlock uint64, toBlock uint64) ([]Event, error)
 
-	// Fulfillments fetches VRF fulfillments that occurred since the specified block.
-	Fulfillments(ctx context.Context, fromBlock uint64) ([]Event, error)
+	// Fulfillments fetches VRF fulfillments that occurred within the specified blocks.
+	Fulfillments(ctx context.Context, fromBlock uint64, toBlock uint64) ([]Event, error)
 }
 
 // BHS defines an interface for interacting with a BlockhashStore contract.
@@ -36,6 +36,10 @@ type BHS interface {
 
 	// IsStored checks whether the hash associated with blockNum is already stored.
 	IsStored(ctx context.Context, blockNum uint64) (bool, error)
+
+	// StoreVerifyHeader stores the hash associated with blockNum, taken from the RLP encoded
+	// header of block blockNum+1, whose hash must already be stored.
+	StoreVerifyHeader(ctx context.Context, blockNum uint64, header []byte) error
 }
 
 // NewFeeder creates a new Feeder instance.
@@ -111,7 +115,7 @@ func (f *Feeder) Run(ctx context.Context) error {
 		requestIDToBlock[req.ID] = req.Block
 	}
 
-	fuls, err := f.coordinator.Fulfillments(ctx, uint64(fromBlock))
+	fuls, err := f.coordinator.Fulfillments(ctx, uint64(fromBlock), latestBlock)
 	if err != nil {
 		f.lggr.Errorw("Failed to fetch VRF fulfillments",
 			"error", err,
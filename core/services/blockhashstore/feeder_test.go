@@ -258,6 +258,8 @@ func TestFeeder_CachesStoredBlocks(t *testing.T) {
 type testCoordinator struct {
 	requests     []Event
 	fulfillments []Event
+	// fulfillmentRanges are the block ranges Fulfillments was called with.
+	fulfillmentRanges [][2]uint64
 }
 
 func (t *testCoordinator) Requests(_ context.Context, fromBlock uint64, toBlock uint64) ([]Event, error) {
@@ -270,10 +272,11 @@ func (t *testCoordinator) Requests(_ context.Context, fromBlock uint64, toBlock
 	return result, nil
 }
 
-func (t *testCoordinator) Fulfillments(_ context.Context, fromBlock uint64) ([]Event, error) {
+func (t *testCoordinator) Fulfillments(_ context.Context, fromBlock uint64, toBlock uint64) ([]Event, error) {
+	t.fulfillmentRanges = append(t.fulfillmentRanges, [2]uint64{fromBlock, toBlock})
 	var result []Event
 	for _, ful := range t.fulfillments {
-		if ful.Block >= fromBlock {
+		if ful.Block >= fromBlock && ful.Block <= toBlock {
 			result = append(result, ful)
 		}
 	}
@@ -288,6 +291,9 @@ type testBHS struct {
 
 	// errorsIsStored defines which block numbers should return errors on IsStored.
 	errorsIsStored []uint64
+
+	// headers are the headers passed to StoreVerifyHeader.
+	headers [][]byte
 }
 
 func (t *testBHS) Store(_ context.Context, blockNum uint64) error {
@@ -301,6 +307,16 @@ func (t *testBHS) Store(_ context.Context, blockNum uint64) error {
 	return nil
 }
 
+func (t *testBHS) StoreVerifyHeader(ctx context.Context, blockNum uint64, header []byte) error {
+	if stored, _ := t.IsStored(ctx, blockNum+1); !stored {
+		return errors.New("parent block not stored")
+	}
+
+	t.stored = append(t.stored, blockNum)
+	t.headers = append(t.headers, header)
+	return nil
+}
+
 func (t *testBHS) IsStored(_ context.Context, blockNum uint64) (bool, error) {
 	for _, e := range t.errorsIsStored {
 		if e == blockNum {
@@ -42,6 +42,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/core/services/ocr2"
 	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
@@ -65,6 +66,9 @@ import (
 // Application implements the common functions used in the core node.
 type Application interface {
 	Start(ctx context.Context) error
+	// StartStandby starts the application as a warm standby, see
+	// ChainlinkApplication.StartStandby.
+	StartStandby(ctx context.Context) error
 	Stop() error
 	GetLogger() logger.Logger
 	GetHealthChecker() services.Checker
@@ -86,13 +90,35 @@ type Application interface {
 	JobORM() job.ORM
 	EVMORM() evmtypes.ORM
 	PipelineORM() pipeline.ORM
+	PipelineRunner() pipeline.Runner
 	BridgeORM() bridges.ORM
 	SessionORM() sessions.ORM
 	TxmORM() txmgr.ORM
+	DirectRequestORM() directrequest.ORM
+	VRFORM() vrf.ORM
 	AddJobV2(ctx context.Context, job *job.Job) error
+	// ImportJobs creates the bridges and jobs of a bundle in a single transaction
+	ImportJobs(ctx context.Context, jobs []*job.Job, bridgeTypes []*bridges.BridgeType) error
+	// UpdateJobV2 replaces the spec of a job, keeping its ID and run history
+	UpdateJobV2(ctx context.Context, jobID int32, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
+	// PauseJob stops the services of a job until it is resumed
+	PauseJob(ctx context.Context, jobID int32) error
+	// ResumeJob starts the services of a paused job again. Not to be confused
+	// with ResumeJobV2, which resumes a suspended pipeline run.
+	ResumeJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
+	// SimulateJobV2 executes the pipeline of a TOML job spec without creating the job or persisting the run
+	SimulateJobV2(ctx context.Context, toml string, vars map[string]interface{}, ethTxOutputs map[string]interface{}) (pipeline.Run, error)
+	// RequestFluxMonitorPoll makes the running flux monitor of a job poll and submit an answer
+	RequestFluxMonitorPoll(ctx context.Context, jobID int32) error
+	// PreviewFluxMonitorDeviation computes the deviation of a flux monitor job's current answer without submitting it
+	PreviewFluxMonitorDeviation(ctx context.Context, jobID int32) (fluxmonitorv2.DeviationPreview, error)
+	// BackfillBlockhashes runs a batch of a backfill of historical blockhashes for a BlockhashStore job
+	BackfillBlockhashes(ctx context.Context, jobID int32, fromBlock, toBlock, batchSize, anchor uint64) (blockhashstore.BackfillProgress, error)
+	// ReportUpkeep checks an upkeep of a keeper registry at the latest head without performing it
+	ReportUpkeep(ctx context.Context, registryAddress ethkey.EIP55Address, upkeepID *big.Int) (keeper.UpkeepReport, error)
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
 
@@ -122,8 +148,13 @@ type ChainlinkApplication struct {
 	bridgeORM                bridges.ORM
 	sessionORM               sessions.ORM
 	txmORM                   txmgr.ORM
+	directRequestORM         directrequest.ORM
+	vrfORM                   vrf.ORM
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
+	fluxMonitorDelegate      *fluxmonitorv2.Delegate
+	blockhashStoreDelegate   *blockhashstore.Delegate
+	keeperDelegate           *keeper.Delegate
 	Config                   config.GeneralConfig
 	KeyStore                 keystore.Master
 	ExternalInitiatorManager webhook.ExternalInitiatorManager
@@ -140,6 +171,7 @@ type ChainlinkApplication struct {
 	profiler                 *pyroscope.Profiler
 
 	started     bool
+	standby     bool
 	startStopMu sync.Mutex
 }
 
@@ -272,12 +304,14 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	srvcs = append(srvcs, promReporter)
 
 	var (
-		pipelineORM    = pipeline.NewORM(db, globalLogger, cfg)
-		bridgeORM      = bridges.NewORM(db, globalLogger, cfg)
-		sessionORM     = sessions.NewORM(db, cfg.SessionTimeout().Duration(), globalLogger, cfg)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, chains.EVM, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
-		jobORM         = job.NewORM(db, chains.EVM, pipelineORM, keyStore, globalLogger, cfg)
-		txmORM         = txmgr.NewORM(db, globalLogger, cfg)
+		pipelineORM      = pipeline.NewORM(db, globalLogger, cfg)
+		bridgeORM        = bridges.NewORM(db, globalLogger, cfg)
+		sessionORM       = sessions.NewORM(db, cfg.SessionTimeout().Duration(), globalLogger, cfg)
+		pipelineRunner   = pipeline.NewRunner(pipelineORM, cfg, chains.EVM, keyStore.Eth(), keyStore.VRF(), keyStore, globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
+		jobORM           = job.NewORM(db, chains.EVM, pipelineORM, keyStore, globalLogger, cfg)
+		txmORM           = txmgr.NewORM(db, globalLogger, cfg)
+		directRequestORM = directrequest.NewORM(db, globalLogger, cfg)
+		vrfORM           = vrf.NewORM(db, globalLogger, cfg)
 	)
 
 	for _, chain := range chains.EVM.Chains() {
@@ -291,6 +325,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				globalLogger,
 				pipelineRunner,
 				pipelineORM,
+				directRequestORM,
 				chains.EVM),
 			job.Keeper: keeper.NewDelegate(
 				db,
@@ -303,6 +338,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				keyStore,
 				pipelineRunner,
 				pipelineORM,
+				vrfORM,
 				chains.EVM,
 				globalLogger,
 				cfg),
@@ -322,10 +358,11 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	)
 
 	// Flux monitor requires ethereum just to boot, silence errors with a null delegate
+	var fluxMonitorDelegate *fluxmonitorv2.Delegate
 	if !cfg.EVMRPCEnabled() {
 		delegates[job.FluxMonitor] = &job.NullDelegate{Type: job.FluxMonitor}
 	} else {
-		delegates[job.FluxMonitor] = fluxmonitorv2.NewDelegate(
+		fluxMonitorDelegate = fluxmonitorv2.NewDelegate(
 			keyStore.Eth(),
 			jobORM,
 			pipelineORM,
@@ -334,6 +371,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			chains.EVM,
 			globalLogger,
 		)
+		delegates[job.FluxMonitor] = fluxMonitorDelegate
 	}
 
 	var peerWrapper *ocrcommon.SingletonPeerWrapper
@@ -452,9 +490,14 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		bridgeORM:                bridgeORM,
 		sessionORM:               sessionORM,
 		txmORM:                   txmORM,
+		directRequestORM:         directRequestORM,
+		vrfORM:                   vrfORM,
 		FeedsService:             feedsService,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
+		fluxMonitorDelegate:      fluxMonitorDelegate,
+		blockhashStoreDelegate:   delegates[job.BlockhashStore].(*blockhashstore.Delegate),
+		keeperDelegate:           delegates[job.Keeper].(*keeper.Delegate),
 		KeyStore:                 keyStore,
 		SessionReaper:            sessions.NewSessionReaper(db.DB, cfg, globalLogger),
 		ExternalInitiatorManager: externalInitiatorManager,
@@ -490,6 +533,29 @@ func (app *ChainlinkApplication) SetLogLevel(lvl zapcore.Level) error {
 	return nil
 }
 
+// StartStandby starts the application as a warm standby, for when another
+// node holds the database lease. Only the EVM chains run, dialing their nodes,
+// tracking heads and polling blocks without writing to the database. Start then
+// promotes the application to active once this node gets the lease.
+//
+// The log pollers only cache the headers of the blocks they poll while read-only,
+// since their filters are registered by the jobs, which start on promotion.
+func (app *ChainlinkApplication) StartStandby(ctx context.Context) error {
+	app.startStopMu.Lock()
+	defer app.startStopMu.Unlock()
+	if app.started || app.standby {
+		panic("application is already started")
+	}
+
+	app.logger.Info("Starting as warm standby...")
+	if err := app.Chains.EVM.StartStandby(ctx); err != nil {
+		return err
+	}
+	app.standby = true
+
+	return nil
+}
+
 // Start all necessary services. If successful, nil will be returned.
 // Start sequence is aborted if the context gets cancelled.
 func (app *ChainlinkApplication) Start(ctx context.Context) error {
@@ -498,6 +564,9 @@ func (app *ChainlinkApplication) Start(ctx context.Context) error {
 	if app.started {
 		panic("application is already started")
 	}
+	if app.standby {
+		app.logger.Info("Promoting warm standby to active...")
+	}
 
 	if app.FeedsService != nil {
 		if err := app.FeedsService.Start(ctx); err != nil {
@@ -526,6 +595,7 @@ func (app *ChainlinkApplication) Start(ctx context.Context) error {
 	}
 
 	app.started = true
+	app.standby = false
 
 	return nil
 }
@@ -533,7 +603,7 @@ func (app *ChainlinkApplication) Start(ctx context.Context) error {
 func (app *ChainlinkApplication) StopIfStarted() error {
 	app.startStopMu.Lock()
 	defer app.startStopMu.Unlock()
-	if app.started {
+	if app.started || app.standby {
 		return app.stop()
 	}
 	return nil
@@ -548,7 +618,7 @@ func (app *ChainlinkApplication) Stop() error {
 }
 
 func (app *ChainlinkApplication) stop() (err error) {
-	if !app.started {
+	if !app.started && !app.standby {
 		panic("application is already stopped")
 	}
 	app.shutdownOnce.Do(func() {
@@ -559,6 +629,14 @@ func (app *ChainlinkApplication) stop() (err error) {
 		}()
 		app.logger.Info("Gracefully exiting...")
 
+		if !app.started {
+			// Warm standbys only run the EVM chains
+			err = app.Chains.EVM.Close()
+			app.standby = false
+			app.logger.Info("Exited warm standby")
+			return
+		}
+
 		// Stop services in the reverse order from which they were started
 		for i := len(app.srvcs) - 1; i >= 0; i-- {
 			service := app.srvcs[i]
@@ -630,10 +708,22 @@ func (app *ChainlinkApplication) PipelineORM() pipeline.ORM {
 	return app.pipelineORM
 }
 
+func (app *ChainlinkApplication) PipelineRunner() pipeline.Runner {
+	return app.pipelineRunner
+}
+
 func (app *ChainlinkApplication) TxmORM() txmgr.ORM {
 	return app.txmORM
 }
 
+func (app *ChainlinkApplication) DirectRequestORM() directrequest.ORM {
+	return app.directRequestORM
+}
+
+func (app *ChainlinkApplication) VRFORM() vrf.ORM {
+	return app.vrfORM
+}
+
 func (app *ChainlinkApplication) GetExternalInitiatorManager() webhook.ExternalInitiatorManager {
 	return app.ExternalInitiatorManager
 }
@@ -651,6 +741,39 @@ func (app *ChainlinkApplication) AddJobV2(ctx context.Context, j *job.Job) error
 	return app.jobSpawner.CreateJob(j, pg.WithParentCtx(ctx))
 }
 
+func (app *ChainlinkApplication) ImportJobs(ctx context.Context, jobs []*job.Job, bridgeTypes []*bridges.BridgeType) error {
+	return app.jobSpawner.CreateJobs(jobs, func(tx pg.Queryer) error {
+		for _, bt := range bridgeTypes {
+			if err := app.bridgeORM.CreateBridgeType(bt, pg.WithQueryer(tx)); err != nil {
+				return errors.Wrapf(err, "failed to create bridge %s", bt.Name)
+			}
+		}
+		return nil
+	}, pg.WithParentCtx(ctx))
+}
+
+func (app *ChainlinkApplication) UpdateJobV2(ctx context.Context, jobID int32, j *job.Job) error {
+	// Do not allow the job to be updated if it is managed by the Feeds Manager
+	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(jobID))
+	if err != nil {
+		return err
+	}
+
+	if isManaged {
+		return errors.New("job must be updated in the feeds manager")
+	}
+
+	return app.jobSpawner.UpdateJob(jobID, j, pg.WithParentCtx(ctx))
+}
+
+func (app *ChainlinkApplication) PauseJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.PauseJob(jobID, pg.WithParentCtx(ctx))
+}
+
+func (app *ChainlinkApplication) ResumeJob(ctx context.Context, jobID int32) error {
+	return app.jobSpawner.ResumeJob(jobID, pg.WithParentCtx(ctx))
+}
+
 func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) error {
 	// Do not allow the job to be deleted if it is managed by the Feeds Manager
 	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(jobID))
@@ -741,6 +864,62 @@ func (app *ChainlinkApplication) ResumeJobV2(
 	return app.pipelineRunner.ResumeRun(taskID, result.Value, result.Error)
 }
 
+// SimulateJobV2 parses and validates a TOML job spec, then executes its
+// pipeline in-memory with the given vars. ethtx tasks do not send transactions,
+// their outputs are taken from ethTxOutputs, keyed by task dot ID.
+func (app *ChainlinkApplication) SimulateJobV2(
+	ctx context.Context,
+	toml string,
+	vars map[string]interface{},
+	ethTxOutputs map[string]interface{},
+) (pipeline.Run, error) {
+	spec, err := job.ValidatedPipelineSpec(toml)
+	if err != nil {
+		return pipeline.Run{}, errors.Wrap(err, "failed to parse TOML")
+	}
+	run, _, err := app.pipelineRunner.SimulateRun(ctx, spec, pipeline.NewVarsFrom(vars), ethTxOutputs, app.logger)
+	return run, err
+}
+
+// RequestFluxMonitorPoll makes the running flux monitor of a job poll and
+// submit an answer, regardless of deviation.
+func (app *ChainlinkApplication) RequestFluxMonitorPoll(ctx context.Context, jobID int32) error {
+	if app.fluxMonitorDelegate == nil {
+		return fluxmonitorv2.ErrJobNotRunning
+	}
+	return app.fluxMonitorDelegate.RequestPoll(ctx, jobID)
+}
+
+// PreviewFluxMonitorDeviation runs the pipeline of a running flux monitor job
+// and computes the deviation of its answer, without submitting it.
+func (app *ChainlinkApplication) PreviewFluxMonitorDeviation(ctx context.Context, jobID int32) (fluxmonitorv2.DeviationPreview, error) {
+	if app.fluxMonitorDelegate == nil {
+		return fluxmonitorv2.DeviationPreview{}, fluxmonitorv2.ErrJobNotRunning
+	}
+	return app.fluxMonitorDelegate.PreviewDeviation(ctx, jobID)
+}
+
+// BackfillBlockhashes runs a batch of a backfill of the blockhashes of
+// historical blocks with unfulfilled VRF requests, for a BlockhashStore job.
+// See blockhashstore.Backfiller.
+func (app *ChainlinkApplication) BackfillBlockhashes(ctx context.Context, jobID int32, fromBlock, toBlock, batchSize, anchor uint64) (blockhashstore.BackfillProgress, error) {
+	jb, err := app.jobORM.FindJob(ctx, jobID)
+	if err != nil {
+		return blockhashstore.BackfillProgress{}, errors.Wrap(err, "failed to load job")
+	}
+	return app.blockhashStoreDelegate.Backfill(ctx, jb, fromBlock, toBlock, batchSize, anchor)
+}
+
+// ReportUpkeep checks an upkeep of a keeper registry at the latest head, the
+// same way the registry's keeper job does, but never performs it.
+func (app *ChainlinkApplication) ReportUpkeep(
+	ctx context.Context,
+	registryAddress ethkey.EIP55Address,
+	upkeepID *big.Int,
+) (keeper.UpkeepReport, error) {
+	return app.keeperDelegate.ReportUpkeep(ctx, registryAddress, upkeepID)
+}
+
 func (app *ChainlinkApplication) GetFeedsService() feeds.Service {
 	return app.FeedsService
 }
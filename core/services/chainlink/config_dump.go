@@ -255,6 +255,11 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].LogBackfillBatchSize = e
 		}
 	}
+	if e := envvar.NewUint32("EvmLogKeepBlocksDepth").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			c.EVM[i].LogKeepBlocksDepth = e
+		}
+	}
 	if e := envvar.NewDuration("EvmLogPollInterval").ParsePtr(); e != nil {
 		d := models.MustNewDuration(*e)
 		for i := range c.EVM {
@@ -330,6 +335,22 @@ func (c *Config) loadLegacyEVMEnv() {
 			c.EVM[i].NodePool.SelectionMode = e
 		}
 	}
+	if e := envvar.NewUint32("NodeQuorumNodes").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			if c.EVM[i].NodePool == nil {
+				c.EVM[i].NodePool = &evmcfg.NodePool{}
+			}
+			c.EVM[i].NodePool.QuorumNodes = e
+		}
+	}
+	if e := envvar.NewUint32("NodeQuorumThreshold").ParsePtr(); e != nil {
+		for i := range c.EVM {
+			if c.EVM[i].NodePool == nil {
+				c.EVM[i].NodePool = &evmcfg.NodePool{}
+			}
+			c.EVM[i].NodePool.QuorumThreshold = e
+		}
+	}
 	for i := range c.EVM {
 		if isZeroPtr(c.EVM[i].NodePool) {
 			c.EVM[i].NodePool = nil
@@ -702,6 +723,7 @@ func (c *Config) loadLegacyCoreEnv() {
 		Lock: &config.DatabaseLock{
 			LeaseDuration:        envDuration("LeaseLockDuration"),
 			LeaseRefreshInterval: envDuration("LeaseLockRefreshInterval"),
+			WarmStandby:          envvar.NewBool("LeaseLockWarmStandby").ParsePtr(),
 		},
 		Backup: &config.DatabaseBackup{
 			Dir:              envvar.NewString("DatabaseBackupDir").ParsePtr(),
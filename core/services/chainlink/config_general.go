@@ -419,6 +419,10 @@ func (g *generalConfig) LeaseLockRefreshInterval() time.Duration {
 	return g.c.Database.Lock.LeaseRefreshInterval.Duration()
 }
 
+func (g *generalConfig) LeaseLockWarmStandby() bool {
+	return *g.c.Database.Lock.WarmStandby
+}
+
 func (g *generalConfig) LogFileDir() string {
 	s := *g.c.Log.File.Dir
 	if s == "" {
@@ -63,6 +63,7 @@ func (g *generalConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, b
 	panic(v2.ErrUnsupported)
 }
 func (g *generalConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalEvmLogKeepBlocksDepth() (uint32, bool) { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalEvmLogPollInterval() (time.Duration, bool) {
 	panic(v2.ErrUnsupported)
 }
@@ -89,6 +90,8 @@ func (g *generalConfig) GlobalNodeNoNewHeadsThreshold() (time.Duration, bool) {
 func (g *generalConfig) GlobalNodePollFailureThreshold() (uint32, bool) { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalNodePollInterval() (time.Duration, bool)  { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalNodeSelectionMode() (string, bool)        { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalNodeQuorumNodes() (uint32, bool)          { panic(v2.ErrUnsupported) }
+func (g *generalConfig) GlobalNodeQuorumThreshold() (uint32, bool)      { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalOCRContractConfirmations() (uint16, bool) { panic(v2.ErrUnsupported) }
 func (g *generalConfig) GlobalOCRContractTransmitterTransmitTimeout() (time.Duration, bool) {
 	panic(v2.ErrUnsupported)
@@ -228,6 +228,7 @@ func TestConfig_Marshal(t *testing.T) {
 		Lock: &config.DatabaseLock{
 			LeaseDuration:        &minute,
 			LeaseRefreshInterval: &second,
+			WarmStandby:          ptr(true),
 		},
 		Backup: &config.DatabaseBackup{
 			Dir:              ptr("test/backup/dir"),
@@ -451,6 +452,7 @@ func TestConfig_Marshal(t *testing.T) {
 
 				LinkContractAddress:      mustAddress("0x538aAaB4ea120b2bC2fe5D296852D948F07D849e"),
 				LogBackfillBatchSize:     ptr[uint32](17),
+				LogKeepBlocksDepth:       ptr[uint32](1000),
 				LogPollInterval:          &minute,
 				MinContractPayment:       assets.NewLinkFromJuels(math.MaxInt64),
 				MinIncomingConfirmations: ptr[uint32](13),
@@ -479,6 +481,8 @@ func TestConfig_Marshal(t *testing.T) {
 					PollFailureThreshold: ptr[uint32](5),
 					PollInterval:         &minute,
 					SelectionMode:        &selectionMode,
+					QuorumNodes:          ptr[uint32](5),
+					QuorumThreshold:      ptr[uint32](3),
 				},
 				OCR: &evmcfg.OCR{
 					ContractConfirmations:              ptr[uint16](11),
@@ -492,11 +496,13 @@ func TestConfig_Marshal(t *testing.T) {
 					Name:    ptr("foo"),
 					HTTPURL: mustURL("https://foo.web"),
 					WSURL:   mustURL("wss://web.socket/test"),
+					Order:   ptr[int32](1),
 				},
 				{
 					Name:    ptr("bar"),
 					HTTPURL: mustURL("https://bar.com"),
 					WSURL:   mustURL("wss://web.socket/test"),
+					Order:   ptr[int32](2),
 				},
 				{
 					Name:     ptr("broadcast"),
@@ -607,6 +613,7 @@ FallbackPollInterval = '2m0s'
 [Database.Lock]
 LeaseDuration = '1m0s'
 LeaseRefreshInterval = '1s'
+WarmStandby = true
 `},
 		{"TelemetryIngress", Config{Core: config.Core{TelemetryIngress: full.TelemetryIngress}}, `[TelemetryIngress]
 UniConn = true
@@ -771,6 +778,7 @@ FinalityDepth = 42
 FlagsContractAddress = '0xae4E781a6218A8031764928E88d457937A954fC3'
 LinkContractAddress = '0x538aAaB4ea120b2bC2fe5D296852D948F07D849e'
 LogBackfillBatchSize = 17
+LogKeepBlocksDepth = 1000
 LogPollInterval = '1m0s'
 MinIncomingConfirmations = 13
 MinContractPayment = '9.223372036854775807 link'
@@ -837,6 +845,8 @@ PriceMax = '79.228162514264337593543950335 gether'
 PollFailureThreshold = 5
 PollInterval = '1m0s'
 SelectionMode = 'HighestHead'
+QuorumNodes = 5
+QuorumThreshold = 3
 
 [EVM.OCR]
 ContractConfirmations = 11
@@ -848,11 +858,13 @@ ObservationGracePeriod = '1s'
 Name = 'foo'
 WSURL = 'wss://web.socket/test'
 HTTPURL = 'https://foo.web'
+Order = 1
 
 [[EVM.Nodes]]
 Name = 'bar'
 WSURL = 'wss://web.socket/test'
 HTTPURL = 'https://bar.com'
+Order = 2
 
 [[EVM.Nodes]]
 Name = 'broadcast'
@@ -955,6 +967,9 @@ func TestConfig_full(t *testing.T) {
 			if got.EVM[c].Nodes[n].SendOnly == nil {
 				got.EVM[c].Nodes[n].SendOnly = ptr(true)
 			}
+			if got.EVM[c].Nodes[n].Order == nil {
+				got.EVM[c].Nodes[n].Order = ptr[int32](100)
+			}
 		}
 	}
 	cfgtest.AssertFieldsNotNil(t, got)
@@ -1001,13 +1016,16 @@ func TestConfig_Validate(t *testing.T) {
 				- FeeCapDefault: invalid value (101 wei): must be equal to PriceMax (99 wei) since you are using FixedPrice estimation with gas bumping disabled in EIP1559 mode - PriceMax will be used as the FeeCap for transactions instead of FeeCapDefault
 				- PriceMax: invalid value (1 gwei): must be greater than or equal to PriceDefault
 			- KeySpecific.Key: invalid value (0xde709f2102306220921060314715629080e2fb77): duplicate - must be unique
-		- 2: 5 errors:
+		- 2: 6 errors:
 			- ChainType: invalid value (Arbitrum): only "optimism" can be used with this chain id
 			- Nodes: missing: must have at least one node
 			- ChainType: invalid value (Arbitrum): must be one of arbitrum, metis, optimism, xdai or omitted
 			- FinalityDepth: invalid value (0): must be greater than or equal to 1
 			- MinIncomingConfirmations: invalid value (0): must be greater than or equal to 1
-		- 3.Nodes: 5 errors:
+			- NodePool.QuorumThreshold: invalid value (3): must be less than or equal to NodePool.QuorumNodes
+		- 3: 2 errors:
+			- GasEstimator.Mode: invalid value (OptimismL1Fee): only allowed with ChainType "optimism"
+			- Nodes: 5 errors:
 				- 0: 2 errors:
 					- Name: missing: required for all nodes
 					- HTTPURL: empty: required for all nodes
@@ -1018,7 +1036,9 @@ func TestConfig_Validate(t *testing.T) {
 				- 2: 2 errors:
 					- Name: empty: required for all nodes
 					- HTTPURL: invalid value (ws): must be http or https
-				- 3.HTTPURL: missing: required for all nodes
+				- 3: 2 errors:
+					- HTTPURL: missing: required for all nodes
+					- Order: invalid value (101): must be between 1 and 100
 				- 4.HTTPURL: missing: required for all nodes
 		- 4: 2 errors:
 			- ChainID: missing: required for all chains
@@ -27,6 +27,7 @@ type (
 		logger         logger.Logger
 		pipelineRunner pipeline.Runner
 		pipelineORM    pipeline.ORM
+		orm            ORM
 		chHeads        chan *evmtypes.Head
 		chainSet       evm.ChainSet
 	}
@@ -43,12 +44,14 @@ func NewDelegate(
 	logger logger.Logger,
 	pipelineRunner pipeline.Runner,
 	pipelineORM pipeline.ORM,
+	orm ORM,
 	chainSet evm.ChainSet,
 ) *Delegate {
 	return &Delegate{
 		logger.Named("DirectRequest"),
 		pipelineRunner,
 		pipelineORM,
+		orm,
 		make(chan *evmtypes.Head, 1),
 		chainSet,
 	}
@@ -92,12 +95,14 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 		oracle:                   oracle,
 		pipelineRunner:           d.pipelineRunner,
 		pipelineORM:              d.pipelineORM,
+		orm:                      d.orm,
 		job:                      jb,
 		mbOracleRequests:         utils.NewHighCapacityMailbox[log.Broadcast](),
 		mbOracleCancelRequests:   utils.NewHighCapacityMailbox[log.Broadcast](),
 		minIncomingConfirmations: concreteSpec.MinIncomingConfirmations.Uint32,
 		requesters:               concreteSpec.Requesters,
 		minContractPayment:       concreteSpec.MinContractPayment,
+		requesterLimits:          concreteSpec.RequesterLimits,
 		chStop:                   make(chan struct{}),
 	}
 	var services []job.ServiceCtx
@@ -118,6 +123,7 @@ type listener struct {
 	oracle                   operator_wrapper.OperatorInterface
 	pipelineRunner           pipeline.Runner
 	pipelineORM              pipeline.ORM
+	orm                      ORM
 	job                      job.Job
 	runs                     sync.Map
 	shutdownWaitGroup        sync.WaitGroup
@@ -126,6 +132,7 @@ type listener struct {
 	minIncomingConfirmations uint32
 	requesters               models.AddressCollection
 	minContractPayment       *assets.Link
+	requesterLimits          job.RequesterLimits
 	chStop                   chan struct{}
 	utils.StartStopOnce
 }
@@ -290,12 +297,15 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 			"requester", request.Requester,
 			"allowedRequesters", l.requesters.ToStrings(),
 		)
-		l.markLogConsumed(lb)
+		l.rejectRequest(request, lb, RejectionReasonRequesterNotAllowed)
 		return
 	}
 
+	limit, _ := l.requesterLimits.Find(request.Requester)
 	var minContractPayment *assets.Link
-	if l.minContractPayment != nil {
+	if limit.MinContractPayment != nil {
+		minContractPayment = limit.MinContractPayment
+	} else if l.minContractPayment != nil {
 		minContractPayment = l.minContractPayment
 	} else {
 		minContractPayment = l.config.MinimumContractPayment()
@@ -307,11 +317,27 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 				"minContractPayment", minContractPayment.String(),
 				"requestPayment", requestPayment.String(),
 			)
-			l.markLogConsumed(lb)
+			l.rejectRequest(request, lb, RejectionReasonInsufficientPayment)
 			return
 		}
 	}
 
+	accepted, err := l.acceptRequest(request, limit.MaxRequestsPerHour)
+	if err != nil {
+		// The log is not marked consumed, so that the request is handled
+		// again when it is delivered again.
+		l.logger.Errorw("Unable to record request", "err", err, "requestId", formatRequestId(request.RequestId))
+		return
+	}
+	if !accepted {
+		l.logger.Warnw("Rejected run for exceeding the requests per hour of the requester",
+			"requester", request.Requester,
+			"maxRequestsPerHour", limit.MaxRequestsPerHour,
+		)
+		l.rejectRequest(request, lb, RejectionReasonRateLimited)
+		return
+	}
+
 	meta := make(map[string]interface{})
 	meta["oracleRequest"] = oracleRequestToMap(request)
 
@@ -343,7 +369,7 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 		},
 	})
 	run := pipeline.NewRun(*l.job.PipelineSpec, vars)
-	_, err := l.pipelineRunner.Run(ctx, &run, l.logger, true, func(tx pg.Queryer) error {
+	_, err = l.pipelineRunner.Run(ctx, &run, l.logger, true, func(tx pg.Queryer) error {
 		l.markLogConsumed(lb, pg.WithQueryer(tx))
 		return nil
 	})
@@ -355,7 +381,10 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 }
 
 func (l *listener) allowRequester(requester common.Address) bool {
-	if len(l.requesters) == 0 {
+	if len(l.requesters) == 0 && len(l.requesterLimits) == 0 {
+		return true
+	}
+	if _, ok := l.requesterLimits.Find(requester); ok {
 		return true
 	}
 	for _, addr := range l.requesters {
@@ -366,6 +395,36 @@ func (l *listener) allowRequester(requester common.Address) bool {
 	return false
 }
 
+// acceptRequest returns whether a request can be run without exceeding the
+// maxPerHour of its requester. Zero means unlimited, in which case the request
+// is not recorded.
+func (l *listener) acceptRequest(request *operator_wrapper.OperatorOracleRequest, maxPerHour uint32) (bool, error) {
+	if maxPerHour == 0 {
+		return true, nil
+	}
+	return l.orm.AcceptRequest(l.job.ID, request.RequestId, request.Requester, maxPerHour)
+}
+
+// rejectRequest records a request which is not run, and marks its log
+// consumed.
+func (l *listener) rejectRequest(request *operator_wrapper.OperatorOracleRequest, lb log.Broadcast, reason RejectionReason) {
+	rejection := Rejection{
+		JobID:       l.job.ID,
+		RequestID:   request.RequestId,
+		Requester:   request.Requester,
+		Reason:      reason,
+		TxHash:      request.Raw.TxHash,
+		BlockNumber: int64(request.Raw.BlockNumber),
+	}
+	if request.Payment != nil {
+		rejection.Payment = utils.NewBig(request.Payment)
+	}
+	if err := l.orm.CreateRejection(&rejection); err != nil {
+		l.logger.Errorw("Unable to record rejected request", "err", err, "requestId", formatRequestId(request.RequestId))
+	}
+	l.markLogConsumed(lb)
+}
+
 // Cancels runs that haven't been started yet, with the given request ID
 func (l *listener) handleCancelOracleRequest(request *operator_wrapper.OperatorCancelOracleRequest, lb log.Broadcast) {
 	runCloserChannelIf, loaded := l.runs.LoadAndDelete(formatRequestId(request.RequestId))
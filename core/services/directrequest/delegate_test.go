@@ -26,6 +26,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	pipeline_mocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
@@ -40,7 +41,7 @@ func TestDelegate_ServicesForSpec(t *testing.T) {
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, Client: ethClient})
 
 	lggr := logger.TestLogger(t)
-	delegate := directrequest.NewDelegate(lggr, runner, nil, cc)
+	delegate := directrequest.NewDelegate(lggr, runner, nil, nil, cc)
 
 	t.Run("Spec without DirectRequestSpec", func(t *testing.T) {
 		spec := job.Job{}
@@ -61,6 +62,7 @@ type DirectRequestUniverse struct {
 	runner         *pipeline_mocks.Runner
 	service        job.ServiceCtx
 	jobORM         job.ORM
+	orm            directrequest.ORM
 	listener       log.Listener
 	logBroadcaster *log_mocks.Broadcaster
 	cleanup        func()
@@ -79,7 +81,8 @@ func NewDirectRequestUniverseWithConfig(t *testing.T, cfg *configtest.TestGenera
 
 	keyStore := cltest.NewKeyStore(t, db, cfg)
 	jobORM := job.NewORM(db, cc, orm, keyStore, lggr, cfg)
-	delegate := directrequest.NewDelegate(lggr, runner, orm, cc)
+	drORM := directrequest.NewORM(db, lggr, cfg)
+	delegate := directrequest.NewDelegate(lggr, runner, orm, drORM, cc)
 
 	jb := cltest.MakeDirectRequestJobSpec(t)
 	jb.ExternalJobID = uuid.NewV4()
@@ -98,6 +101,7 @@ func NewDirectRequestUniverseWithConfig(t *testing.T, cfg *configtest.TestGenera
 		runner:         runner,
 		service:        service,
 		jobORM:         jobORM,
+		orm:            drORM,
 		listener:       nil,
 		logBroadcaster: broadcaster,
 		cleanup:        func() { jobORM.Close() },
@@ -425,6 +429,12 @@ func TestDelegate_ServicesListenerHandleLog(t *testing.T) {
 
 		markConsumedLogAwaiter.AwaitOrFail(t, 5*time.Second)
 
+		rejections, count, err := uni.orm.FindRejections(uni.spec.ID, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		assert.Equal(t, directrequest.RejectionReasonInsufficientPayment, rejections[0].Reason)
+		assert.Equal(t, "99", rejections[0].Payment.String())
+
 		uni.service.Close()
 	})
 
@@ -523,6 +533,135 @@ func TestDelegate_ServicesListenerHandleLog(t *testing.T) {
 
 		markConsumedLogAwaiter.AwaitOrFail(t, 5*time.Second)
 
+		rejections, count, err := uni.orm.FindRejections(uni.spec.ID, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		assert.Equal(t, directrequest.RejectionReasonRequesterNotAllowed, rejections[0].Reason)
+		assert.Equal(t, requester, rejections[0].Requester)
+
+		uni.service.Close()
+	})
+
+	t.Run("requesterLimits overrides the minimum payment of a requester", func(t *testing.T) {
+		requester := testutils.NewAddress()
+		cfg := configtest.NewTestGeneralConfig(t)
+		cfg.Overrides.GlobalMinIncomingConfirmations = null.IntFrom(1)
+		cfg.Overrides.GlobalMinimumContractPayment = assets.NewLinkFromJuels(100)
+		uni := NewDirectRequestUniverseWithConfig(t, cfg, func(jb *job.Job) {
+			jb.DirectRequestSpec.RequesterLimits = job.RequesterLimits{
+				{Address: ethkey.EIP55AddressFromAddress(requester), MinContractPayment: assets.NewLinkFromJuels(50)},
+			}
+		})
+		defer uni.Cleanup()
+
+		log := log_mocks.NewBroadcast(t)
+		log.On("ReceiptsRoot").Return(common.Hash{})
+		log.On("TransactionsRoot").Return(common.Hash{})
+		log.On("StateRoot").Return(common.Hash{})
+
+		uni.logBroadcaster.On("WasAlreadyConsumed", mock.Anything, mock.Anything).Return(false, nil)
+		logOracleRequest := operator_wrapper.OperatorOracleRequest{
+			CancelExpiration: big.NewInt(0),
+			Payment:          big.NewInt(50),
+			Requester:        requester,
+		}
+		log.On("RawLog").Return(types.Log{
+			Topics: []common.Hash{
+				{},
+				uni.spec.ExternalIDEncodeStringToTopic(),
+			},
+		})
+		log.On("DecodedLog").Return(&logOracleRequest)
+		uni.logBroadcaster.On("MarkConsumed", mock.Anything, mock.Anything).Return(nil)
+
+		runBeganAwaiter := cltest.NewAwaiter()
+		uni.runner.On("Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			runBeganAwaiter.ItHappened()
+			fn := args.Get(4).(func(pg.Queryer) error)
+			fn(nil)
+		}).Once().Return(false, nil)
+
+		err := uni.service.Start(testutils.Context(t))
+		require.NoError(t, err)
+
+		uni.listener.HandleLog(log)
+
+		runBeganAwaiter.AwaitOrFail(t, 5*time.Second)
+
+		uni.service.Close()
+	})
+
+	t.Run("requesterLimits rate limits the requests of a requester", func(t *testing.T) {
+		requester := testutils.NewAddress()
+		cfg := configtest.NewTestGeneralConfig(t)
+		cfg.Overrides.GlobalMinIncomingConfirmations = null.IntFrom(1)
+		uni := NewDirectRequestUniverseWithConfig(t, cfg, func(jb *job.Job) {
+			jb.DirectRequestSpec.RequesterLimits = job.RequesterLimits{
+				{Address: ethkey.EIP55AddressFromAddress(requester), MaxRequestsPerHour: 1},
+			}
+		})
+		defer uni.Cleanup()
+
+		newLog := func(requestID common.Hash) *log_mocks.Broadcast {
+			log := log_mocks.NewBroadcast(t)
+			log.On("ReceiptsRoot").Return(common.Hash{}).Maybe()
+			log.On("TransactionsRoot").Return(common.Hash{}).Maybe()
+			log.On("StateRoot").Return(common.Hash{}).Maybe()
+			log.On("RawLog").Return(types.Log{
+				Topics: []common.Hash{
+					{},
+					uni.spec.ExternalIDEncodeStringToTopic(),
+				},
+			})
+			log.On("DecodedLog").Return(&operator_wrapper.OperatorOracleRequest{
+				RequestId:        requestID,
+				CancelExpiration: big.NewInt(0),
+				Payment:          big.NewInt(100),
+				Requester:        requester,
+			})
+			log.On("String").Return("").Maybe()
+			return log
+		}
+
+		uni.logBroadcaster.On("WasAlreadyConsumed", mock.Anything, mock.Anything).Return(false, nil)
+		chConsumed := make(chan struct{}, 2)
+		uni.logBroadcaster.On("MarkConsumed", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			chConsumed <- struct{}{}
+		})
+		chRunBegan := make(chan struct{}, 2)
+		uni.runner.On("Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			chRunBegan <- struct{}{}
+		}).Twice().Return(false, nil)
+		await := func(ch chan struct{}) {
+			select {
+			case <-ch:
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out")
+			}
+		}
+
+		err := uni.service.Start(testutils.Context(t))
+		require.NoError(t, err)
+
+		uni.listener.HandleLog(newLog(common.HexToHash("0x01")))
+		await(chRunBegan)
+
+		uni.listener.HandleLog(newLog(common.HexToHash("0x02")))
+		await(chConsumed)
+
+		// Delivering the logs again neither counts the accepted request twice
+		// nor records the rejected one twice.
+		uni.listener.HandleLog(newLog(common.HexToHash("0x01")))
+		await(chRunBegan)
+		uni.listener.HandleLog(newLog(common.HexToHash("0x02")))
+		await(chConsumed)
+
+		rejections, count, err := uni.orm.FindRejections(uni.spec.ID, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		assert.Equal(t, directrequest.RejectionReasonRateLimited, rejections[0].Reason)
+		assert.Equal(t, common.HexToHash("0x02"), rejections[0].RequestID)
+
 		uni.service.Close()
 	})
 }
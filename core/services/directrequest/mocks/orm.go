@@ -0,0 +1,111 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	directrequest "github.com/smartcontractkit/chainlink/core/services/directrequest"
+	mock "github.com/stretchr/testify/mock"
+
+	pg "github.com/smartcontractkit/chainlink/core/services/pg"
+
+	common "github.com/ethereum/go-ethereum/common"
+)
+
+// ORM is an autogenerated mock type for the ORM type
+type ORM struct {
+	mock.Mock
+}
+
+// AcceptRequest provides a mock function with given fields: jobID, requestID, requester, maxPerHour, qopts
+func (_m *ORM) AcceptRequest(jobID int32, requestID common.Hash, requester common.Address, maxPerHour uint32, qopts ...pg.QOpt) (bool, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID, requestID, requester, maxPerHour)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(int32, common.Hash, common.Address, uint32, ...pg.QOpt) bool); ok {
+		r0 = rf(jobID, requestID, requester, maxPerHour, qopts...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, common.Hash, common.Address, uint32, ...pg.QOpt) error); ok {
+		r1 = rf(jobID, requestID, requester, maxPerHour, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRejection provides a mock function with given fields: r, qopts
+func (_m *ORM) CreateRejection(r *directrequest.Rejection, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, r)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*directrequest.Rejection, ...pg.QOpt) error); ok {
+		r0 = rf(r, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindRejections provides a mock function with given fields: jobID, offset, limit
+func (_m *ORM) FindRejections(jobID int32, offset int, limit int) ([]directrequest.Rejection, int, error) {
+	ret := _m.Called(jobID, offset, limit)
+
+	var r0 []directrequest.Rejection
+	if rf, ok := ret.Get(0).(func(int32, int, int) []directrequest.Rejection); ok {
+		r0 = rf(jobID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]directrequest.Rejection)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(int32, int, int) int); ok {
+		r1 = rf(jobID, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(int32, int, int) error); ok {
+		r2 = rf(jobID, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+type mockConstructorTestingTNewORM interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewORM creates a new instance of ORM. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewORM(t mockConstructorTestingTNewORM) *ORM {
+	mock := &ORM{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,131 @@
+package directrequest
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+//go:generate mockery --name ORM --output ./mocks --case=underscore
+
+// ORM stores the oracle requests accepted and rejected by direct request
+// jobs.
+type ORM interface {
+	AcceptRequest(jobID int32, requestID common.Hash, requester common.Address, maxPerHour uint32, qopts ...pg.QOpt) (bool, error)
+	CreateRejection(r *Rejection, qopts ...pg.QOpt) error
+	FindRejections(jobID int32, offset, limit int) ([]Rejection, int, error)
+}
+
+// RejectionReason is the reason an oracle request was not run.
+type RejectionReason string
+
+const (
+	// RejectionReasonRequesterNotAllowed is used for requesters which are
+	// neither in the requesters nor the requesterLimits of the spec.
+	RejectionReasonRequesterNotAllowed RejectionReason = "requester_not_allowed"
+	// RejectionReasonInsufficientPayment is used for requests paying less
+	// than the minimum contract payment of their requester.
+	RejectionReasonInsufficientPayment RejectionReason = "insufficient_payment"
+	// RejectionReasonRateLimited is used for requests over the
+	// maxRequestsPerHour of their requester.
+	RejectionReasonRateLimited RejectionReason = "rate_limited"
+)
+
+// Rejection records an oracle request which was rejected before its run was
+// started.
+type Rejection struct {
+	ID          int64
+	JobID       int32
+	RequestID   common.Hash
+	Requester   common.Address
+	Payment     *utils.Big
+	Reason      RejectionReason
+	TxHash      common.Hash
+	BlockNumber int64
+	CreatedAt   time.Time
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ORM {
+	namedLogger := lggr.Named("DirectRequestORM")
+	return &orm{pg.NewQ(db, namedLogger, cfg)}
+}
+
+// AcceptRequest records a request of the requester and returns true if fewer
+// than maxPerHour of its requests were accepted by the job in the last hour.
+// The requests are kept in the database so that they are counted across
+// restarts, and a request which was already accepted, e.g. as its log is
+// delivered again, is accepted again without being counted twice.
+func (o *orm) AcceptRequest(jobID int32, requestID common.Hash, requester common.Address, maxPerHour uint32, qopts ...pg.QOpt) (accepted bool, err error) {
+	q := o.q.WithOpts(qopts...)
+	err = q.Transaction(func(tx pg.Queryer) error {
+		var exists bool
+		if err = tx.Get(&exists, `SELECT EXISTS (SELECT 1 FROM direct_request_accepted_requests WHERE job_id = $1 AND request_id = $2)`, jobID, requestID); err != nil {
+			return errors.Wrap(err, "failed to find request")
+		}
+		if exists {
+			accepted = true
+			return nil
+		}
+		if _, err = tx.Exec(`DELETE FROM direct_request_accepted_requests WHERE job_id = $1 AND requester = $2 AND created_at <= now() - interval '1 hour'`, jobID, requester); err != nil {
+			return errors.Wrap(err, "failed to delete expired requests")
+		}
+		var count uint32
+		if err = tx.Get(&count, `SELECT COUNT(*) FROM direct_request_accepted_requests WHERE job_id = $1 AND requester = $2`, jobID, requester); err != nil {
+			return errors.Wrap(err, "failed to count requests")
+		}
+		if count >= maxPerHour {
+			return nil
+		}
+		if _, err = tx.Exec(`INSERT INTO direct_request_accepted_requests (job_id, request_id, requester, created_at) VALUES ($1, $2, $3, now())`, jobID, requestID, requester); err != nil {
+			return errors.Wrap(err, "failed to insert request")
+		}
+		accepted = true
+		return nil
+	})
+	return accepted, errors.Wrap(err, "AcceptRequest failed")
+}
+
+// CreateRejection records a rejected request. A request which was already
+// rejected by the job is not recorded again.
+func (o *orm) CreateRejection(r *Rejection, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	stmt := `INSERT INTO direct_request_rejections (job_id, request_id, requester, payment, reason, tx_hash, block_number, created_at)
+	VALUES (:job_id, :request_id, :requester, :payment, :reason, :tx_hash, :block_number, now())
+	ON CONFLICT (job_id, request_id) DO NOTHING
+	RETURNING id, created_at;`
+	err := q.GetNamed(stmt, r, r)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	return errors.Wrap(err, "CreateRejection failed")
+}
+
+// FindRejections returns the rejected requests of a job, the most recent
+// first, along with their total count.
+func (o *orm) FindRejections(jobID int32, offset, limit int) (rejections []Rejection, count int, err error) {
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&count, "SELECT COUNT(*) FROM direct_request_rejections WHERE job_id = $1", jobID); err != nil {
+			return errors.Wrap(err, "FindRejections failed to get count")
+		}
+		stmt := `SELECT * FROM direct_request_rejections WHERE job_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2 OFFSET $3;`
+		if err = tx.Select(&rejections, stmt, jobID, limit, offset); err != nil {
+			return errors.Wrap(err, "FindRejections failed to load direct_request_rejections")
+		}
+		return nil
+	}, pg.OptReadOnlyTx())
+
+	return
+}
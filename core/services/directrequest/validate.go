@@ -1,6 +1,7 @@
 package directrequest
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 
@@ -16,6 +17,7 @@ type DirectRequestToml struct {
 	ContractAddress          ethkey.EIP55Address      `toml:"contractAddress"`
 	Requesters               models.AddressCollection `toml:"requesters"`
 	MinContractPayment       *assets.Link             `toml:"minContractPaymentLinkJuels"`
+	RequesterLimits          job.RequesterLimits      `toml:"requesterLimits"`
 	EVMChainID               *utils.Big               `toml:"evmChainID"`
 	MinIncomingConfirmations null.Uint32              `toml:"minIncomingConfirmations"`
 }
@@ -39,6 +41,7 @@ func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
 		ContractAddress:          spec.ContractAddress,
 		Requesters:               spec.Requesters,
 		MinContractPayment:       spec.MinContractPayment,
+		RequesterLimits:          spec.RequesterLimits,
 		EVMChainID:               spec.EVMChainID,
 		MinIncomingConfirmations: spec.MinIncomingConfirmations,
 	}
@@ -46,5 +49,22 @@ func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
 	if jb.Type != job.DirectRequest {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
+	if err := validateRequesterLimits(spec.RequesterLimits); err != nil {
+		return jb, err
+	}
 	return jb, nil
 }
+
+func validateRequesterLimits(limits job.RequesterLimits) error {
+	seen := make(map[common.Address]struct{}, len(limits))
+	for _, l := range limits {
+		if l.Address == "" {
+			return errors.New("requesterLimits: address is required")
+		}
+		if _, ok := seen[l.Address.Address()]; ok {
+			return errors.Errorf("requesterLimits: duplicate address %s", l.Address)
+		}
+		seen[l.Address.Address()] = struct{}{}
+	}
+	return nil
+}
@@ -76,3 +76,85 @@ func TestValidatedDirectRequestSpec_MinIncomingConfirmations(t *testing.T) {
 		assert.Equal(t, uint32(100), s.DirectRequestSpec.MinIncomingConfirmations.Uint32)
 	})
 }
+
+func TestValidatedDirectRequestSpec_RequesterLimits(t *testing.T) {
+	t.Parallel()
+
+	t.Run("requesterLimits specified", func(t *testing.T) {
+		t.Parallel()
+
+		toml := `
+		type                = "directrequest"
+		schemaVersion       = 1
+		name                = "example eth request event spec"
+		minContractPaymentLinkJuels = "100"
+		observationSource   = """
+		"""
+
+		[[requesterLimits]]
+		address = "0x613a38AC1659769640aaE063C651F48E0250454C"
+		minContractPaymentLinkJuels = "1000"
+		maxRequestsPerHour = 10
+
+		[[requesterLimits]]
+		address = "0x5431F5F973781809D18643b87B44921b11355d81"
+		`
+
+		s, err := ValidatedDirectRequestSpec(toml)
+		require.NoError(t, err)
+
+		limits := s.DirectRequestSpec.RequesterLimits
+		require.Len(t, limits, 2)
+		assert.Equal(t, "0x613a38AC1659769640aaE063C651F48E0250454C", limits[0].Address.Hex())
+		assert.Equal(t, "1000", limits[0].MinContractPayment.String())
+		assert.Equal(t, uint32(10), limits[0].MaxRequestsPerHour)
+		assert.Equal(t, "0x5431F5F973781809D18643b87B44921b11355d81", limits[1].Address.Hex())
+		assert.Nil(t, limits[1].MinContractPayment)
+		assert.Zero(t, limits[1].MaxRequestsPerHour)
+
+		limit, ok := limits.Find(limits[1].Address.Address())
+		assert.True(t, ok)
+		assert.Equal(t, limits[1], limit)
+	})
+
+	t.Run("duplicate address", func(t *testing.T) {
+		t.Parallel()
+
+		toml := `
+		type                = "directrequest"
+		schemaVersion       = 1
+		name                = "example eth request event spec"
+		observationSource   = """
+		"""
+
+		[[requesterLimits]]
+		address = "0x613a38AC1659769640aaE063C651F48E0250454C"
+		maxRequestsPerHour = 10
+
+		[[requesterLimits]]
+		address = "0x613a38AC1659769640aaE063C651F48E0250454C"
+		maxRequestsPerHour = 20
+		`
+
+		_, err := ValidatedDirectRequestSpec(toml)
+		require.EqualError(t, err, "requesterLimits: duplicate address 0x613a38AC1659769640aaE063C651F48E0250454C")
+	})
+
+	t.Run("missing address", func(t *testing.T) {
+		t.Parallel()
+
+		toml := `
+		type                = "directrequest"
+		schemaVersion       = 1
+		name                = "example eth request event spec"
+		observationSource   = """
+		"""
+
+		[[requesterLimits]]
+		maxRequestsPerHour = 10
+		`
+
+		_, err := ValidatedDirectRequestSpec(toml)
+		require.EqualError(t, err, "requesterLimits: address is required")
+	})
+}
@@ -580,6 +580,7 @@ func (s *service) ApproveSpec(ctx context.Context, id int64, force bool) error {
 	if err != nil {
 		return errors.Wrap(err, "could not generate job from spec")
 	}
+	j.TOML = spec.Definition
 
 	var address ethkey.EIP55Address
 	switch j.Type {
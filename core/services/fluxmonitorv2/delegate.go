@@ -1,6 +1,9 @@
 package fluxmonitorv2
 
 import (
+	"context"
+	"sync"
+
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
 
@@ -21,8 +24,15 @@ type Delegate struct {
 	pipelineRunner pipeline.Runner
 	chainSet       evm.ChainSet
 	lggr           logger.Logger
+
+	monitorsMu sync.RWMutex
+	monitors   map[int32]*FluxMonitor
 }
 
+// ErrJobNotRunning is returned for flux monitor jobs whose services are not
+// running, e.g. because they are paused.
+var ErrJobNotRunning = errors.New("flux monitor job is not running")
+
 var _ job.Delegate = (*Delegate)(nil)
 
 // NewDelegate constructs a new delegate
@@ -36,13 +46,14 @@ func NewDelegate(
 	lggr logger.Logger,
 ) *Delegate {
 	return &Delegate{
-		db,
-		ethKeyStore,
-		jobORM,
-		pipelineORM,
-		pipelineRunner,
-		chainSet,
-		lggr.Named("FluxMonitor"),
+		db:             db,
+		ethKeyStore:    ethKeyStore,
+		jobORM:         jobORM,
+		pipelineORM:    pipelineORM,
+		pipelineRunner: pipelineRunner,
+		chainSet:       chainSet,
+		lggr:           lggr.Named("FluxMonitor"),
+		monitors:       make(map[int32]*FluxMonitor),
 	}
 }
 
@@ -51,8 +62,8 @@ func (d *Delegate) JobType() job.Type {
 	return job.FluxMonitor
 }
 
-func (Delegate) AfterJobCreated(spec job.Job)  {}
-func (Delegate) BeforeJobDeleted(spec job.Job) {}
+func (d *Delegate) AfterJobCreated(spec job.Job)  {}
+func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
 
 // ServicesForSpec returns the flux monitor service for the job spec
 func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.ServiceCtx, err error) {
@@ -86,5 +97,57 @@ func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.ServiceCtx, err e
 		return nil, err
 	}
 
-	return []job.ServiceCtx{fm}, nil
+	return []job.ServiceCtx{fm, &monitorRegistration{d, jb.ID, fm}}, nil
+}
+
+// RequestPoll asks the running flux monitor of a job to poll and submit an
+// answer, see FluxMonitor.RequestPoll.
+func (d *Delegate) RequestPoll(ctx context.Context, jobID int32) error {
+	fm, err := d.monitor(jobID)
+	if err != nil {
+		return err
+	}
+	return fm.RequestPoll(ctx)
+}
+
+// PreviewDeviation previews the deviation of the running flux monitor of a
+// job, see FluxMonitor.PreviewDeviation.
+func (d *Delegate) PreviewDeviation(ctx context.Context, jobID int32) (DeviationPreview, error) {
+	fm, err := d.monitor(jobID)
+	if err != nil {
+		return DeviationPreview{}, err
+	}
+	return fm.PreviewDeviation(ctx)
+}
+
+func (d *Delegate) monitor(jobID int32) (*FluxMonitor, error) {
+	d.monitorsMu.RLock()
+	defer d.monitorsMu.RUnlock()
+	fm, exists := d.monitors[jobID]
+	if !exists {
+		return nil, ErrJobNotRunning
+	}
+	return fm, nil
+}
+
+// monitorRegistration makes a started FluxMonitor available to the Delegate
+// until it is closed. It is started after and closed before the FluxMonitor.
+type monitorRegistration struct {
+	d     *Delegate
+	jobID int32
+	fm    *FluxMonitor
+}
+
+func (r *monitorRegistration) Start(context.Context) error {
+	r.d.monitorsMu.Lock()
+	defer r.d.monitorsMu.Unlock()
+	r.d.monitors[r.jobID] = r.fm
+	return nil
+}
+
+func (r *monitorRegistration) Close() error {
+	r.d.monitorsMu.Lock()
+	defer r.d.monitorsMu.Unlock()
+	delete(r.d.monitors, r.jobID)
+	return nil
 }
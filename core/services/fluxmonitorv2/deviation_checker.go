@@ -77,3 +77,15 @@ func (c *DeviationChecker) OutsideDeviation(curAnswer, nextAnswer decimal.Decima
 	c.lggr.Infow("Relative and absolute deviation thresholds both met", loggerFields...)
 	return true
 }
+
+// Deviation returns the absolute deviation |next-cur| and the deviation
+// relative to curAnswer as a percentage. The relative deviation is nil if
+// curAnswer is zero, as it is not defined then.
+func Deviation(curAnswer, nextAnswer decimal.Decimal) (abs decimal.Decimal, rel *decimal.Decimal) {
+	abs = curAnswer.Sub(nextAnswer).Abs()
+	if curAnswer.IsZero() {
+		return abs, nil
+	}
+	percentage := abs.Div(curAnswer.Abs()).Mul(decimal.NewFromInt(100))
+	return abs, &percentage
+}
@@ -81,3 +81,38 @@ func TestDeviationChecker_OutsideDeviation(t *testing.T) {
 		t.Run(tc.name+" max absolute threshold", func(t *testing.T) { c(test3) })
 	}
 }
+
+func TestDeviation(t *testing.T) {
+	t.Parallel()
+
+	f, i := decimal.NewFromFloat, decimal.NewFromInt
+	testCases := []struct {
+		name     string
+		cur      decimal.Decimal
+		next     decimal.Decimal
+		abs      decimal.Decimal
+		rel      decimal.Decimal
+		relIsNil bool
+	}{
+		{"increase", i(100), i(103), i(3), i(3), false},
+		{"decrease", i(200), i(150), i(50), i(25), false},
+		{"unchanged", i(100), i(100), i(0), i(0), false},
+		{"crosses 0", f(-0.1), f(0.1), f(0.2), i(200), false},
+		{"0 current answer", i(0), i(100), i(100), decimal.Decimal{}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			abs, rel := fluxmonitorv2.Deviation(tc.cur, tc.next)
+			assert.True(t, tc.abs.Equal(abs), "expected absolute deviation %s, got %s", tc.abs, abs)
+			if tc.relIsNil {
+				assert.Nil(t, rel)
+				return
+			}
+			if assert.NotNil(t, rel) {
+				assert.True(t, tc.rel.Equal(*rel), "expected relative deviation %s, got %s", tc.rel, rel)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package fluxmonitorv2
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// DeviationPreview shows how the FluxMonitor judges the answer its pipeline
+// currently returns, against the answers on chain and the job's thresholds.
+type DeviationPreview struct {
+	JobID int32
+	// RoundID is the round the node would submit to, and EligibleToSubmit
+	// whether the aggregator accepts a submission from it.
+	RoundID          uint32
+	EligibleToSubmit bool
+	Hibernating      bool
+
+	// PipelineAnswer is the answer of a new pipeline run. It is nil, and
+	// PipelineError is set, if the run failed.
+	PipelineAnswer *decimal.Decimal
+	PipelineError  string
+	// LatestAnswer is the answer of the aggregator's latest round.
+	LatestAnswer *big.Int
+	// LatestSubmission is the node's latest submission, which the deviation
+	// is computed against.
+	LatestSubmission *big.Int
+
+	Thresholds DeviationThresholds
+	// AbsoluteDeviation and RelativeDeviation, a percentage, are nil if the
+	// pipeline run failed. RelativeDeviation is also nil if the latest
+	// submission is zero.
+	AbsoluteDeviation *decimal.Decimal
+	RelativeDeviation *decimal.Decimal
+	// OutsideDeviation is true if a poll would submit the pipeline answer. The
+	// first round is always submitted.
+	OutsideDeviation bool
+	// ValidSubmission is false if the pipeline answer is outside the
+	// aggregator's min and max submission values.
+	ValidSubmission bool
+}
+
+// PreviewDeviation runs the pipeline and computes the deviation of its answer
+// the way a poll does, without submitting the answer or saving the run.
+func (fm *FluxMonitor) PreviewDeviation(ctx context.Context) (preview DeviationPreview, err error) {
+	preview = DeviationPreview{
+		JobID:       fm.spec.JobID,
+		Hibernating: fm.pollManager.isHibernating.Load(),
+		Thresholds:  fm.deviationChecker.Thresholds,
+	}
+
+	roundState, err := fm.roundState(0)
+	if err != nil {
+		return preview, errors.Wrap(err, "unable to determine eligibility to submit from FluxAggregator contract")
+	}
+	preview.RoundID = roundState.RoundId
+	preview.EligibleToSubmit = roundState.EligibleToSubmit
+	preview.LatestSubmission = roundState.LatestSubmission
+
+	var metaDataForBridge map[string]interface{}
+	lrd, err := fm.fluxAggregator.LatestRoundData(nil)
+	if err != nil {
+		fm.logger.Warnw("Couldn't read latest round data for request meta", "err", err)
+	} else {
+		preview.LatestAnswer = lrd.Answer
+		metaDataForBridge, err = bridges.MarshalBridgeMetaData(lrd.Answer, lrd.UpdatedAt)
+		if err != nil {
+			fm.logger.Warnw("Error marshalling roundState for request meta", "err", err)
+		}
+	}
+
+	_, results, err := fm.runner.ExecuteRun(ctx, fm.spec, fm.runVars(metaDataForBridge), fm.logger)
+	if err != nil {
+		return preview, errors.Wrap(err, "failed to execute run")
+	}
+	result, err := results.FinalResult(fm.logger).SingularResult()
+	if err == nil {
+		err = result.Error
+	}
+	var answer decimal.Decimal
+	if err == nil {
+		answer, err = utils.ToDecimal(result.Value)
+	}
+	if err != nil {
+		preview.PipelineError = err.Error()
+		return preview, nil
+	}
+	preview.PipelineAnswer = &answer
+	preview.ValidSubmission = fm.submissionChecker.IsValid(answer)
+
+	latestSubmission := decimal.Zero
+	if roundState.LatestSubmission != nil {
+		latestSubmission = decimal.NewFromBigInt(roundState.LatestSubmission, 0)
+	}
+	abs, rel := Deviation(latestSubmission, answer)
+	preview.AbsoluteDeviation, preview.RelativeDeviation = &abs, rel
+	preview.OutsideDeviation = roundState.RoundId <= 1 || fm.deviationChecker.OutsideDeviation(latestSubmission, answer)
+	return preview, nil
+}
@@ -48,6 +48,8 @@ const (
 	PollRequestTypeRetry
 	PollRequestTypeAwaken
 	PollRequestTypeDrumbeat
+	// PollRequestTypeManual is requested by an operator, see RequestPoll
+	PollRequestTypeManual
 )
 
 // DefaultHibernationPollPeriod defines the hibernation polling period
@@ -440,6 +442,11 @@ func (fm *FluxMonitor) consume() {
 			switch request.Type {
 			case PollRequestTypeUnknown:
 				break
+			case PollRequestTypeManual:
+				// A manual poll forces a round, like the idle timer does
+				recovery.WrapRecover(fm.logger, func() {
+					fm.pollIfEligible(PollRequestTypeManual, NewZeroDeviationChecker(fm.logger), nil)
+				})
 			default:
 				recovery.WrapRecover(fm.logger, func() {
 					fm.pollIfEligible(request.Type, fm.deviationChecker, nil)
@@ -454,6 +461,21 @@ func formatTime(at time.Time) string {
 	return fmt.Sprintf("%v (%v ago)", at.UTC().Format(time.RFC3339), ago)
 }
 
+// RequestPoll asks the FluxMonitor to poll and submit an answer to the
+// reportable round, regardless of deviation and even while hibernating. The
+// poll is still skipped if the node is not eligible to submit or would not be
+// paid. It returns once the FluxMonitor has picked up the request.
+func (fm *FluxMonitor) RequestPoll(ctx context.Context) error {
+	select {
+	case fm.pollManager.chPoll <- PollRequest{PollRequestTypeManual, time.Now()}:
+		return nil
+	case <-fm.chStop:
+		return errors.New("flux monitor is stopped")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // SetOracleAddress sets the oracle address which matches the node's keys.
 // If none match, it uses the first available key
 func (fm *FluxMonitor) SetOracleAddress() error {
@@ -817,7 +839,7 @@ func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker
 		}
 	}()
 
-	if pollReq != PollRequestTypeHibernation && fm.pollManager.isHibernating.Load() {
+	if pollReq != PollRequestTypeHibernation && pollReq != PollRequestTypeManual && fm.pollManager.isHibernating.Load() {
 		l.Warnw("Skipping poll because a ticker fired while hibernating")
 		return
 	}
@@ -925,19 +947,7 @@ func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker
 	// Call the v2 pipeline to execute a new pipeline run
 	// Note: we expect the FM pipeline to scale the fetched answer by the same
 	// amount as "decimals" in the FM contract.
-
-	vars := pipeline.NewVarsFrom(map[string]interface{}{
-		"jobSpec": map[string]interface{}{
-			"databaseID":    fm.jobSpec.ID,
-			"externalJobID": fm.jobSpec.ExternalJobID,
-			"name":          fm.jobSpec.Name.ValueOrZero(),
-		},
-		"jobRun": map[string]interface{}{
-			"meta": metaDataForBridge,
-		},
-	})
-
-	run, results, err := fm.runner.ExecuteRun(context.Background(), fm.spec, vars, fm.logger)
+	run, results, err := fm.runner.ExecuteRun(context.Background(), fm.spec, fm.runVars(metaDataForBridge), fm.logger)
 	if err != nil {
 		l.Errorw("can't fetch answer", "err", err)
 		fm.jobORM.TryRecordError(fm.spec.JobID, "Error polling")
@@ -1007,6 +1017,20 @@ func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker
 	promfm.SetUint32(promfm.ReportedRound.WithLabelValues(jobID), roundState.RoundId)
 }
 
+// runVars returns the vars of a pipeline run which fetches an answer.
+func (fm *FluxMonitor) runVars(metaDataForBridge map[string]interface{}) pipeline.Vars {
+	return pipeline.NewVarsFrom(map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID":    fm.jobSpec.ID,
+			"externalJobID": fm.jobSpec.ExternalJobID,
+			"name":          fm.jobSpec.Name.ValueOrZero(),
+		},
+		"jobRun": map[string]interface{}{
+			"meta": metaDataForBridge,
+		},
+	})
+}
+
 // If the answer is outside the allowable range, log an error and don't submit.
 // to avoid an onchain reversion.
 func (fm *FluxMonitor) isValidSubmission(l logger.Logger, answer decimal.Decimal, started time.Time) bool {
@@ -1931,3 +1931,161 @@ func TestFluxMonitor_DrumbeatTicker(t *testing.T) {
 	cltest.EventuallyExpectationsMet(t, tm.pipelineORM, waitTime, interval)
 	cltest.EventuallyExpectationsMet(t, tm.contractSubmitter, waitTime, interval)
 }
+
+func TestFluxMonitor_RequestPoll(t *testing.T) {
+	t.Parallel()
+
+	db, nodeAddr := setupStoreWithKey(t)
+	oracles := []common.Address{nodeAddr, testutils.NewAddress()}
+
+	fm, tm := setup(t, db, disablePollTicker(true), disableIdleTimer(true))
+
+	tm.keyStore.On("EnabledKeysForChain", testutils.FixtureChainID).Return([]ethkey.KeyV2{{Address: nodeAddr}}, nil)
+
+	const fetchedAnswer = 100
+	answerBigInt := big.NewInt(fetchedAnswer)
+
+	tm.fluxAggregator.On("Address").Return(common.Address{})
+	tm.fluxAggregator.On("GetOracles", nilOpts).Return(oracles, nil)
+	tm.logBroadcaster.On("Register", mock.Anything, mock.Anything).Return(func() {})
+	tm.logBroadcaster.On("IsConnected").Return(true).Maybe()
+
+	tm.fluxAggregator.On("LatestRoundData", nilOpts).Return(freshContractRoundDataResponse()).Once()
+
+	// The latest submission equals the fetched answer, so only a manual poll
+	// submits it
+	const roundID uint32 = 3
+	roundState := flux_aggregator_wrapper.OracleRoundState{
+		RoundId:          roundID,
+		EligibleToSubmit: true,
+		LatestSubmission: answerBigInt,
+		AvailableFunds:   big.NewInt(1).Mul(big.NewInt(10000), config.DefaultMinimumContractPayment.ToInt()),
+		PaymentAmount:    config.DefaultMinimumContractPayment.ToInt(),
+		StartedAt:        now(),
+	}
+	tm.fluxAggregator.On("OracleRoundState", nilOpts, nodeAddr, uint32(0)).Return(roundState, nil).Once()
+	tm.fluxAggregator.On("OracleRoundState", nilOpts, nodeAddr, roundID).Return(roundState, nil).Once()
+	tm.orm.On("FindOrCreateFluxMonitorRoundStats", contractAddress, roundID, mock.Anything).
+		Return(fluxmonitorv2.FluxMonitorRoundStatsV2{Aggregator: contractAddress, RoundID: roundID}, nil).
+		Once()
+	tm.fluxAggregator.On("LatestRoundData", nilOpts).
+		Return(flux_aggregator_wrapper.LatestRoundData{Answer: answerBigInt, UpdatedAt: big.NewInt(100)}, nil).
+		Once()
+	tm.pipelineRunner.On("ExecuteRun", mock.Anything, pipelineSpec, mock.Anything, mock.Anything).
+		Return(pipeline.Run{}, pipeline.TaskRunResults{
+			{
+				Result: pipeline.Result{Value: decimal.NewFromInt(fetchedAnswer)},
+				Task:   &pipeline.HTTPTask{},
+			},
+		}, nil).
+		Once()
+	tm.pipelineRunner.On("InsertFinishedRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).
+		Run(func(args mock.Arguments) {
+			args.Get(0).(*pipeline.Run).ID = 1
+		}).
+		Once()
+	tm.contractSubmitter.On("Submit", big.NewInt(int64(roundID)), answerBigInt, mock.Anything).Return(nil).Once()
+	tm.orm.On("UpdateFluxMonitorRoundStats", contractAddress, roundID, int64(1), mock.Anything, mock.Anything).Return(nil).Once()
+
+	require.NoError(t, fm.Start(testutils.Context(t)))
+	require.NoError(t, fm.RequestPoll(testutils.Context(t)))
+
+	waitTime := 5 * time.Second
+	interval := 50 * time.Millisecond
+	cltest.EventuallyExpectationsMet(t, tm.fluxAggregator, waitTime, interval)
+	cltest.EventuallyExpectationsMet(t, tm.orm, waitTime, interval)
+	cltest.EventuallyExpectationsMet(t, tm.contractSubmitter, waitTime, interval)
+
+	require.NoError(t, fm.Close())
+	require.Error(t, fm.RequestPoll(testutils.Context(t)))
+}
+
+func TestFluxMonitor_PreviewDeviation(t *testing.T) {
+	t.Parallel()
+
+	db, _ := setupStoreWithKey(t)
+
+	tests := []struct {
+		name             string
+		roundID          uint32
+		latestSubmission int64
+		answer           int64
+		absDeviation     int64
+		relDeviation     int64
+		outsideDeviation bool
+	}{
+		{"inside deviation", 3, 100, 100, 0, 0, false},
+		{"outside deviation", 3, 100, 110, 10, 10, true},
+		{"first round", 1, 100, 100, 0, 0, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fm, tm := setup(t, db)
+
+			tm.fluxAggregator.On("OracleRoundState", nilOpts, common.Address{}, uint32(0)).
+				Return(flux_aggregator_wrapper.OracleRoundState{
+					RoundId:          tc.roundID,
+					EligibleToSubmit: true,
+					LatestSubmission: big.NewInt(tc.latestSubmission),
+				}, nil)
+			tm.fluxAggregator.On("LatestRoundData", nilOpts).
+				Return(flux_aggregator_wrapper.LatestRoundData{
+					Answer:    big.NewInt(tc.latestSubmission),
+					UpdatedAt: big.NewInt(100),
+				}, nil)
+			tm.pipelineRunner.On("ExecuteRun", mock.Anything, pipelineSpec, mock.Anything, mock.Anything).
+				Return(pipeline.Run{}, pipeline.TaskRunResults{
+					{
+						Result: pipeline.Result{Value: decimal.NewFromInt(tc.answer)},
+						Task:   &pipeline.HTTPTask{},
+					},
+				}, nil)
+
+			preview, err := fm.PreviewDeviation(testutils.Context(t))
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.roundID, preview.RoundID)
+			assert.True(t, preview.EligibleToSubmit)
+			assert.Equal(t, big.NewInt(tc.latestSubmission), preview.LatestAnswer)
+			assert.Equal(t, big.NewInt(tc.latestSubmission), preview.LatestSubmission)
+			require.NotNil(t, preview.PipelineAnswer)
+			assert.True(t, decimal.NewFromInt(tc.answer).Equal(*preview.PipelineAnswer))
+			assert.Empty(t, preview.PipelineError)
+			require.NotNil(t, preview.AbsoluteDeviation)
+			assert.True(t, decimal.NewFromInt(tc.absDeviation).Equal(*preview.AbsoluteDeviation))
+			require.NotNil(t, preview.RelativeDeviation)
+			assert.True(t, decimal.NewFromInt(tc.relDeviation).Equal(*preview.RelativeDeviation))
+			assert.Equal(t, threshold, preview.Thresholds.Rel)
+			assert.Equal(t, absoluteThreshold, preview.Thresholds.Abs)
+			assert.Equal(t, tc.outsideDeviation, preview.OutsideDeviation)
+			assert.True(t, preview.ValidSubmission)
+		})
+	}
+
+	t.Run("pipeline error", func(t *testing.T) {
+		fm, tm := setup(t, db)
+
+		tm.fluxAggregator.On("OracleRoundState", nilOpts, common.Address{}, uint32(0)).
+			Return(flux_aggregator_wrapper.OracleRoundState{RoundId: 3, LatestSubmission: big.NewInt(100)}, nil)
+		tm.fluxAggregator.On("LatestRoundData", nilOpts).Return(freshContractRoundDataResponse())
+		tm.pipelineRunner.On("ExecuteRun", mock.Anything, pipelineSpec, mock.Anything, mock.Anything).
+			Return(pipeline.Run{}, pipeline.TaskRunResults{
+				{
+					Result: pipeline.Result{Error: errors.New("price source unavailable")},
+					Task:   &pipeline.HTTPTask{},
+				},
+			}, nil)
+
+		preview, err := fm.PreviewDeviation(testutils.Context(t))
+		require.NoError(t, err)
+
+		assert.Nil(t, preview.PipelineAnswer)
+		assert.Contains(t, preview.PipelineError, "price source unavailable")
+		assert.Nil(t, preview.LatestAnswer)
+		assert.Nil(t, preview.AbsoluteDeviation)
+		assert.False(t, preview.OutsideDeviation)
+	})
+}
@@ -0,0 +1,51 @@
+package job
+
+import (
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// BundleVersion is the version of the bundle format. Bundles of other
+// versions are rejected on import.
+const BundleVersion = 1
+
+// Bundle holds job specs together with the bridges and external initiators
+// they depend on, so that the jobs can be moved from one node to another.
+type Bundle struct {
+	Version int         `json:"version"`
+	Jobs    []BundleJob `json:"jobs"`
+	// Bridges are exported without their credentials, which have to be set
+	// again on the importing node.
+	Bridges []bridges.BridgeTypeRequest `json:"bridges"`
+	// ExternalInitiators are only referenced, as their credentials are issued
+	// by the node. They must exist on the importing node.
+	ExternalInitiators []BundleExternalInitiator `json:"externalInitiators"`
+}
+
+// BundleJob is a job in a bundle.
+type BundleJob struct {
+	Name          string    `json:"name"`
+	ExternalJobID uuid.UUID `json:"externalJobID"`
+	Type          Type      `json:"type"`
+	TOML          string    `json:"toml"`
+}
+
+// BundleExternalInitiator references an external initiator used by a job in a
+// bundle.
+type BundleExternalInitiator struct {
+	Name string         `json:"name"`
+	URL  *models.WebURL `json:"url"`
+}
+
+// BundleConflictPolicy decides what happens when a job or bridge of a bundle
+// already exists on the node.
+type BundleConflictPolicy string
+
+const (
+	// BundleConflictFail rejects the whole bundle.
+	BundleConflictFail BundleConflictPolicy = "fail"
+	// BundleConflictSkip keeps the existing job or bridge and imports the rest.
+	BundleConflictSkip BundleConflictPolicy = "skip"
+)
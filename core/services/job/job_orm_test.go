@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -513,6 +514,102 @@ func TestORM_CreateJob_OCR_DuplicatedContractAddress(t *testing.T) {
 	})
 }
 
+func TestORM_UpdateJob(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := cltest.NewKeyStore(t, db, config)
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	orm := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	jb, err := directrequest.ValidatedDirectRequestSpec(testspecs.DirectRequestSpec)
+	require.NoError(t, err)
+	jb.TOML = testspecs.DirectRequestSpec
+	require.NoError(t, orm.CreateJob(&jb))
+	run := mustInsertPipelineRun(t, pipelineORM, jb)
+
+	updatedTOML := strings.Replace(testspecs.DirectRequestSpec, "times=100", "times=1000", 1)
+	updated, err := directrequest.ValidatedDirectRequestSpec(updatedTOML)
+	require.NoError(t, err)
+	updated.TOML = updatedTOML
+	require.NoError(t, orm.UpdateJob(jb.ID, &updated))
+
+	assert.Equal(t, jb.ID, updated.ID)
+	assert.Equal(t, jb.DirectRequestSpecID, updated.DirectRequestSpecID)
+	assert.NotEqual(t, jb.PipelineSpecID, updated.PipelineSpecID)
+	cltest.AssertCount(t, db, "jobs", 1)
+	cltest.AssertCount(t, db, "direct_request_specs", 1)
+
+	versions, err := orm.FindSpecVersions(jb.ID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, int32(2), versions[0].Version)
+	assert.Equal(t, updated.PipelineSpecID, versions[0].PipelineSpecID)
+	assert.Equal(t, updatedTOML, versions[0].TOML.String)
+	assert.Equal(t, int32(1), versions[1].Version)
+	assert.Equal(t, jb.PipelineSpecID, versions[1].PipelineSpecID)
+
+	version, err := orm.FindSpecVersion(jb.ID, 1)
+	require.NoError(t, err)
+	assert.Equal(t, testspecs.DirectRequestSpec, version.TOML.String)
+	_, err = orm.FindSpecVersion(jb.ID, 3)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	t.Run("keeps the runs of earlier versions", func(t *testing.T) {
+		runs, count, err := orm.PipelineRuns(&jb.ID, 0, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+		assert.Equal(t, run.ID, runs[0].ID)
+
+		runCount, err := orm.CountPipelineRunsByJobID(jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), runCount)
+
+		found, err := pipelineORM.FindRun(run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, jb.ID, found.PipelineSpec.JobID)
+	})
+
+	t.Run("finds the job by the pipeline specs of all versions", func(t *testing.T) {
+		jbs, err := orm.FindJobsByPipelineSpecIDs([]int32{jb.PipelineSpecID, updated.PipelineSpecID})
+		require.NoError(t, err)
+		require.Len(t, jbs, 2)
+		for _, found := range jbs {
+			assert.Equal(t, jb.ID, found.ID)
+			require.NotNil(t, found.PipelineSpec)
+			assert.Equal(t, found.PipelineSpecID, found.PipelineSpec.ID)
+		}
+		assert.ElementsMatch(t, []int32{jb.PipelineSpecID, updated.PipelineSpecID}, []int32{jbs[0].PipelineSpecID, jbs[1].PipelineSpecID})
+	})
+
+	t.Run("can not change the job type", func(t *testing.T) {
+		other, err := webhook.ValidatedWebhookSpec(testspecs.GenerateWebhookSpec(testspecs.WebhookSpecParams{}).Toml(), nil)
+		require.NoError(t, err)
+		err = orm.UpdateJob(jb.ID, &other)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "job type can not be changed")
+	})
+
+	t.Run("can not change the external job ID", func(t *testing.T) {
+		other, err := directrequest.ValidatedDirectRequestSpec(testspecs.DirectRequestSpec)
+		require.NoError(t, err)
+		other.ExternalJobID = uuid.NewV4()
+		err = orm.UpdateJob(jb.ID, &other)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "externalJobID can not be changed")
+	})
+
+	t.Run("deletes all versions with the job", func(t *testing.T) {
+		require.NoError(t, orm.DeleteJob(jb.ID))
+		cltest.AssertCount(t, db, "job_spec_versions", 0)
+		cltest.AssertCount(t, db, "pipeline_specs", 0)
+		cltest.AssertCount(t, db, "pipeline_runs", 0)
+	})
+}
+
 func Test_FindJobs(t *testing.T) {
 	t.Parallel()
 
@@ -433,6 +433,50 @@ func (_m *ORM) FindSpecErrorsByJobIDs(ids []int32, qopts ...pg.QOpt) ([]job.Spec
 	return r0, r1
 }
 
+// FindSpecVersion provides a mock function with given fields: jobID, version
+func (_m *ORM) FindSpecVersion(jobID int32, version int32) (job.SpecVersion, error) {
+	ret := _m.Called(jobID, version)
+
+	var r0 job.SpecVersion
+	if rf, ok := ret.Get(0).(func(int32, int32) job.SpecVersion); ok {
+		r0 = rf(jobID, version)
+	} else {
+		r0 = ret.Get(0).(job.SpecVersion)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, int32) error); ok {
+		r1 = rf(jobID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindSpecVersions provides a mock function with given fields: jobID
+func (_m *ORM) FindSpecVersions(jobID int32) ([]job.SpecVersion, error) {
+	ret := _m.Called(jobID)
+
+	var r0 []job.SpecVersion
+	if rf, ok := ret.Get(0).(func(int32) []job.SpecVersion); ok {
+		r0 = rf(jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.SpecVersion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32) error); ok {
+		r1 = rf(jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InsertJob provides a mock function with given fields: _a0, qopts
 func (_m *ORM) InsertJob(_a0 *job.Job, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -475,6 +519,27 @@ func (_m *ORM) InsertWebhookSpec(webhookSpec *job.WebhookSpec, qopts ...pg.QOpt)
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: id, qopts
+func (_m *ORM) PauseJob(id int32, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) error); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PipelineRuns provides a mock function with given fields: jobID, offset, size
 func (_m *ORM) PipelineRuns(jobID *int32, offset int, size int) ([]pipeline.Run, int, error) {
 	ret := _m.Called(jobID, offset, size)
@@ -526,6 +591,27 @@ func (_m *ORM) RecordError(jobID int32, description string, qopts ...pg.QOpt) er
 	return r0
 }
 
+// ResumeJob provides a mock function with given fields: id, qopts
+func (_m *ORM) ResumeJob(id int32, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) error); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // TryRecordError provides a mock function with given fields: jobID, description, qopts
 func (_m *ORM) TryRecordError(jobID int32, description string, qopts ...pg.QOpt) {
 	_va := make([]interface{}, len(qopts))
@@ -538,6 +624,27 @@ func (_m *ORM) TryRecordError(jobID int32, description string, qopts ...pg.QOpt)
 	_m.Called(_ca...)
 }
 
+// UpdateJob provides a mock function with given fields: id, jb, qopts
+func (_m *ORM) UpdateJob(id int32, jb *job.Job, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id, jb)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, *job.Job, ...pg.QOpt) error); ok {
+		r0 = rf(id, jb, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewORM interface {
 	mock.TestingT
 	Cleanup(func())
@@ -67,6 +67,27 @@ func (_m *Spawner) CreateJob(jb *job.Job, qopts ...pg.QOpt) error {
 	return r0
 }
 
+// CreateJobs provides a mock function with given fields: jbs, createDependencies, qopts
+func (_m *Spawner) CreateJobs(jbs []*job.Job, createDependencies func(pg.Queryer) error, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jbs, createDependencies)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*job.Job, func(pg.Queryer) error, ...pg.QOpt) error); ok {
+		r0 = rf(jbs, createDependencies, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteJob provides a mock function with given fields: jobID, qopts
 func (_m *Spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -102,6 +123,27 @@ func (_m *Spawner) Healthy() error {
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: jobID, qopts
+func (_m *Spawner) PauseJob(jobID int32, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) error); ok {
+		r0 = rf(jobID, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Ready provides a mock function with given fields:
 func (_m *Spawner) Ready() error {
 	ret := _m.Called()
@@ -116,6 +158,27 @@ func (_m *Spawner) Ready() error {
 	return r0
 }
 
+// ResumeJob provides a mock function with given fields: jobID, qopts
+func (_m *Spawner) ResumeJob(jobID int32, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...pg.QOpt) error); ok {
+		r0 = rf(jobID, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Spawner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -144,6 +207,27 @@ func (_m *Spawner) StartService(ctx context.Context, spec job.Job) error {
 	return r0
 }
 
+// UpdateJob provides a mock function with given fields: jobID, jb, qopts
+func (_m *Spawner) UpdateJob(jobID int32, jb *job.Job, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID, jb)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, *job.Job, ...pg.QOpt) error); ok {
+		r0 = rf(jobID, jb, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewSpawner interface {
 	mock.TestingT
 	Cleanup(func())
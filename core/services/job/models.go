@@ -132,6 +132,12 @@ type Job struct {
 	MaxTaskDuration      models.Interval
 	Pipeline             pipeline.Pipeline `toml:"observationSource"`
 	CreatedAt            time.Time
+	// PausedAt is set while the job is paused. The services of a paused job
+	// are not running.
+	PausedAt null.Time `toml:"-"`
+	// TOML is the spec the job was parsed from, if known. It is saved with
+	// the job's version when the job is created or updated.
+	TOML string `toml:"-" db:"-"`
 }
 
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
@@ -166,6 +172,19 @@ func (j *Job) SetID(value string) error {
 	return nil
 }
 
+// SpecVersion is a version of a job's spec. Versions are numbered from 1 per
+// external job ID, and the latest version is the job's current spec.
+type SpecVersion struct {
+	ID             int64
+	JobID          int32
+	ExternalJobID  uuid.UUID
+	Version        int32
+	PipelineSpecID int32
+	// TOML is null for jobs which were created before versions were recorded.
+	TOML      null.String
+	CreatedAt time.Time
+}
+
 type SpecError struct {
 	ID          int64
 	JobID       int32
@@ -357,11 +376,53 @@ type DirectRequestSpec struct {
 	MinIncomingConfirmationsEnv bool                     `toml:"minIncomingConfirmationsEnv"`
 	Requesters                  models.AddressCollection `toml:"requesters"`
 	MinContractPayment          *assets.Link             `toml:"minContractPaymentLinkJuels"`
+	RequesterLimits             RequesterLimits          `toml:"requesterLimits"`
 	EVMChainID                  *utils.Big               `toml:"evmChainID"`
 	CreatedAt                   time.Time                `toml:"-"`
 	UpdatedAt                   time.Time                `toml:"-"`
 }
 
+// RequesterLimit allows the requests of a requester, with their own minimum
+// payment and rate limit.
+type RequesterLimit struct {
+	Address ethkey.EIP55Address `toml:"address" json:"address"`
+	// MinContractPayment overrides the minimum payment of the spec, if set.
+	MinContractPayment *assets.Link `toml:"minContractPaymentLinkJuels" json:"minContractPaymentLinkJuels"`
+	// MaxRequestsPerHour limits the requests which are run in any hour. Zero
+	// means unlimited.
+	MaxRequestsPerHour uint32 `toml:"maxRequestsPerHour" json:"maxRequestsPerHour"`
+}
+
+// RequesterLimits is a collection of RequesterLimit, stored as JSON.
+type RequesterLimits []RequesterLimit
+
+// Find returns the limit of a requester, if there is one.
+func (r RequesterLimits) Find(requester common.Address) (RequesterLimit, bool) {
+	for _, l := range r {
+		if l.Address.Address() == requester {
+			return l, true
+		}
+	}
+	return RequesterLimit{}, false
+}
+
+// Value returns this instance serialized for database storage.
+func (r RequesterLimits) Value() (driver.Value, error) {
+	if r == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(r)
+}
+
+// Scan reads the database value and returns an instance.
+func (r *RequesterLimits) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, r)
+}
+
 type CronSpec struct {
 	ID           int32     `toml:"-"`
 	CronSchedule string    `toml:"schedule"`
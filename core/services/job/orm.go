@@ -51,7 +51,12 @@ type ORM interface {
 	FindJobByExternalJobID(uuid uuid.UUID, qopts ...pg.QOpt) (Job, error)
 	FindJobIDByAddress(address ethkey.EIP55Address, qopts ...pg.QOpt) (int32, error)
 	FindJobIDsWithBridge(name string) ([]int32, error)
+	UpdateJob(id int32, jb *Job, qopts ...pg.QOpt) error
 	DeleteJob(id int32, qopts ...pg.QOpt) error
+	PauseJob(id int32, qopts ...pg.QOpt) error
+	ResumeJob(id int32, qopts ...pg.QOpt) error
+	FindSpecVersions(jobID int32) ([]SpecVersion, error)
+	FindSpecVersion(jobID int32, version int32) (SpecVersion, error)
 	RecordError(jobID int32, description string, qopts ...pg.QOpt) error
 	// TryRecordError is a helper which calls RecordError and logs the returned error if present.
 	TryRecordError(jobID int32, description string, qopts ...pg.QOpt)
@@ -104,16 +109,17 @@ func (o *orm) Close() error {
 	return nil
 }
 
-func (o *orm) assertBridgesExist(p pipeline.Pipeline) error {
+// BridgeNames returns the unique names of the bridges called by the tasks of
+// p, in the order they appear.
+func BridgeNames(p pipeline.Pipeline) ([]bridges.BridgeName, error) {
 	var bridgeNames = make(map[bridges.BridgeName]struct{})
 	var uniqueBridges []bridges.BridgeName
 	for _, task := range p.Tasks {
 		if task.Type() == pipeline.TaskTypeBridge {
-			// Bridge must exist
 			name := task.(*pipeline.BridgeTask).Name
 			bridge, err := bridges.ParseBridgeName(name)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			if _, have := bridgeNames[bridge]; have {
 				continue
@@ -122,8 +128,17 @@ func (o *orm) assertBridgesExist(p pipeline.Pipeline) error {
 			uniqueBridges = append(uniqueBridges, bridge)
 		}
 	}
+	return uniqueBridges, nil
+}
+
+func (o *orm) assertBridgesExist(p pipeline.Pipeline, qopts ...pg.QOpt) error {
+	// Bridges must exist
+	uniqueBridges, err := BridgeNames(p)
+	if err != nil {
+		return err
+	}
 	if len(uniqueBridges) != 0 {
-		_, err := o.bridgeORM.FindBridges(uniqueBridges)
+		_, err := o.bridgeORM.FindBridges(uniqueBridges, qopts...)
 		if err != nil {
 			return err
 		}
@@ -137,7 +152,11 @@ func (o *orm) assertBridgesExist(p pipeline.Pipeline) error {
 func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
 	p := jb.Pipeline
-	if err := o.assertBridgesExist(p); err != nil {
+	if err := o.assertBridgesExist(p, qopts...); err != nil {
+		return err
+	}
+
+	if err := o.assertSpecDependenciesExist(jb, qopts...); err != nil {
 		return err
 	}
 
@@ -151,8 +170,8 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 		switch jb.Type {
 		case DirectRequest:
 			var specID int32
-			sql := `INSERT INTO direct_request_specs (contract_address, min_incoming_confirmations, requesters, min_contract_payment, evm_chain_id, created_at, updated_at)
-			VALUES (:contract_address, :min_incoming_confirmations, :requesters, :min_contract_payment, :evm_chain_id, now(), now())
+			sql := `INSERT INTO direct_request_specs (contract_address, min_incoming_confirmations, requesters, min_contract_payment, requester_limits, evm_chain_id, created_at, updated_at)
+			VALUES (:contract_address, :min_incoming_confirmations, :requesters, :min_contract_payment, :requester_limits, :evm_chain_id, now(), now())
 			RETURNING id;`
 			if err := pg.PrepareQueryRowx(tx, sql, &specID, jb.DirectRequestSpec); err != nil {
 				return errors.Wrap(err, "failed to create DirectRequestSpec")
@@ -171,19 +190,6 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			jb.FluxMonitorSpecID = &specID
 		case OffchainReporting:
 			var specID int32
-			if jb.OCROracleSpec.EncryptedOCRKeyBundleID != nil {
-				_, err := o.keyStore.OCR().Get(jb.OCROracleSpec.EncryptedOCRKeyBundleID.String())
-				if err != nil {
-					return errors.Wrapf(ErrNoSuchKeyBundle, "%v", jb.OCROracleSpec.EncryptedOCRKeyBundleID)
-				}
-			}
-			if jb.OCROracleSpec.TransmitterAddress != nil {
-				_, err := o.keyStore.Eth().Get(jb.OCROracleSpec.TransmitterAddress.Hex())
-				if err != nil {
-					return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", jb.OCROracleSpec.TransmitterAddress)
-				}
-			}
-
 			existingSpec := new(OCROracleSpec)
 			err := tx.Get(existingSpec, `SELECT * FROM ocr_oracle_specs WHERE contract_address = $1 and (evm_chain_id = $2 or evm_chain_id IS NULL) LIMIT 1;`,
 				jb.OCROracleSpec.ContractAddress, jb.OCROracleSpec.EVMChainID,
@@ -221,48 +227,6 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 			jb.OCROracleSpecID = &specID
 		case OffchainReporting2:
 			var specID int32
-			if jb.OCR2OracleSpec.OCRKeyBundleID.Valid {
-				_, err := o.keyStore.OCR2().Get(jb.OCR2OracleSpec.OCRKeyBundleID.String)
-				if err != nil {
-					return errors.Wrapf(ErrNoSuchKeyBundle, "%v", jb.OCR2OracleSpec.OCRKeyBundleID)
-				}
-			}
-			if jb.OCR2OracleSpec.TransmitterID.Valid {
-				switch jb.OCR2OracleSpec.Relay {
-				case relay.EVM:
-					_, err := o.keyStore.Eth().Get(jb.OCR2OracleSpec.TransmitterID.String)
-					if err != nil {
-						return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", jb.OCR2OracleSpec.TransmitterID)
-					}
-				case relay.Solana:
-					_, err := o.keyStore.Solana().Get(jb.OCR2OracleSpec.TransmitterID.String)
-					if err != nil {
-						return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", jb.OCR2OracleSpec.TransmitterID)
-					}
-				case relay.Terra:
-					_, err := o.keyStore.Terra().Get(jb.OCR2OracleSpec.TransmitterID.String)
-					if err != nil {
-						return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", jb.OCR2OracleSpec.TransmitterID)
-					}
-				}
-			}
-			switch jb.OCR2OracleSpec.PluginType {
-			case Median:
-				var cfg medianconfig.PluginConfig
-				err := json.Unmarshal(jb.OCR2OracleSpec.PluginConfig.Bytes(), &cfg)
-				if err != nil {
-					return errors.Wrap(err, "failed to parse plugin config")
-				}
-				feePipeline, err := pipeline.Parse(cfg.JuelsPerFeeCoinPipeline)
-				if err != nil {
-					return err
-				}
-				if err2 := o.assertBridgesExist(*feePipeline); err2 != nil {
-					return err2
-				}
-			case DKG, OCR2VRF:
-			}
-
 			sql := `INSERT INTO ocr2_oracle_specs (contract_id, relay, relay_config, plugin_type, plugin_config, p2pv2_bootstrappers, ocr_key_bundle_id, transmitter_id,
 					blockchain_timeout, contract_config_tracker_poll_interval, contract_config_confirmations,
 					created_at, updated_at)
@@ -378,7 +342,10 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 		jb.PipelineSpecID = pipelineSpecID
 		err = o.InsertJob(jb, pg.WithQueryer(tx))
 		jobID = jb.ID
-		return errors.Wrap(err, "failed to insert job")
+		if err != nil {
+			return errors.Wrap(err, "failed to insert job")
+		}
+		return o.insertSpecVersion(tx, jb)
 	})
 	if err != nil {
 		return errors.Wrap(err, "CreateJobFailed")
@@ -387,6 +354,69 @@ func (o *orm) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 	return o.findJob(jb, "id", jobID, qopts...)
 }
 
+// assertSpecDependenciesExist checks that the keys and bridges referenced by
+// the type specific spec of jb exist.
+func (o *orm) assertSpecDependenciesExist(jb *Job, qopts ...pg.QOpt) error {
+	switch jb.Type {
+	case OffchainReporting:
+		if jb.OCROracleSpec.EncryptedOCRKeyBundleID != nil {
+			_, err := o.keyStore.OCR().Get(jb.OCROracleSpec.EncryptedOCRKeyBundleID.String())
+			if err != nil {
+				return errors.Wrapf(ErrNoSuchKeyBundle, "%v", jb.OCROracleSpec.EncryptedOCRKeyBundleID)
+			}
+		}
+		if jb.OCROracleSpec.TransmitterAddress != nil {
+			_, err := o.keyStore.Eth().Get(jb.OCROracleSpec.TransmitterAddress.Hex())
+			if err != nil {
+				return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", jb.OCROracleSpec.TransmitterAddress)
+			}
+		}
+	case OffchainReporting2:
+		if jb.OCR2OracleSpec.OCRKeyBundleID.Valid {
+			_, err := o.keyStore.OCR2().Get(jb.OCR2OracleSpec.OCRKeyBundleID.String)
+			if err != nil {
+				return errors.Wrapf(ErrNoSuchKeyBundle, "%v", jb.OCR2OracleSpec.OCRKeyBundleID)
+			}
+		}
+		if jb.OCR2OracleSpec.TransmitterID.Valid {
+			switch jb.OCR2OracleSpec.Relay {
+			case relay.EVM:
+				_, err := o.keyStore.Eth().Get(jb.OCR2OracleSpec.TransmitterID.String)
+				if err != nil {
+					return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", jb.OCR2OracleSpec.TransmitterID)
+				}
+			case relay.Solana:
+				_, err := o.keyStore.Solana().Get(jb.OCR2OracleSpec.TransmitterID.String)
+				if err != nil {
+					return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", jb.OCR2OracleSpec.TransmitterID)
+				}
+			case relay.Terra:
+				_, err := o.keyStore.Terra().Get(jb.OCR2OracleSpec.TransmitterID.String)
+				if err != nil {
+					return errors.Wrapf(ErrNoSuchTransmitterKey, "%v", jb.OCR2OracleSpec.TransmitterID)
+				}
+			}
+		}
+		switch jb.OCR2OracleSpec.PluginType {
+		case Median:
+			var cfg medianconfig.PluginConfig
+			err := json.Unmarshal(jb.OCR2OracleSpec.PluginConfig.Bytes(), &cfg)
+			if err != nil {
+				return errors.Wrap(err, "failed to parse plugin config")
+			}
+			feePipeline, err := pipeline.Parse(cfg.JuelsPerFeeCoinPipeline)
+			if err != nil {
+				return err
+			}
+			if err2 := o.assertBridgesExist(*feePipeline, qopts...); err2 != nil {
+				return err2
+			}
+		case DKG, OCR2VRF:
+		}
+	}
+	return nil
+}
+
 func (o *orm) InsertWebhookSpec(webhookSpec *WebhookSpec, qopts ...pg.QOpt) error {
 	q := o.q.WithOpts(qopts...)
 	query := `INSERT INTO webhook_specs (created_at, updated_at)
@@ -405,6 +435,214 @@ func (o *orm) InsertJob(job *Job, qopts ...pg.QOpt) error {
 	return q.GetNamed(query, job, job)
 }
 
+// UpdateJob replaces the spec of the job with jb. The job keeps its ID,
+// external job ID and everything which references it, such as its runs and
+// consumed logs. The type specific spec is updated in place, while a new
+// pipeline spec is created so that earlier runs keep the pipeline they ran.
+// The job type can not be changed.
+func (o *orm) UpdateJob(id int32, jb *Job, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	if err := o.assertBridgesExist(jb.Pipeline, qopts...); err != nil {
+		return err
+	}
+	if err := o.assertSpecDependenciesExist(jb, qopts...); err != nil {
+		return err
+	}
+
+	err := q.Transaction(func(tx pg.Queryer) error {
+		var existing Job
+		// NO KEY UPDATE still lets the job's new services, which the spawner starts
+		// within this transaction, insert rows referencing the job.
+		if err := tx.Get(&existing, `SELECT * FROM jobs WHERE id = $1 FOR NO KEY UPDATE`, id); err != nil {
+			return errors.Wrap(err, "failed to load job")
+		}
+		if jb.Type != existing.Type {
+			return errors.Errorf("job type can not be changed from %s to %s", existing.Type, jb.Type)
+		}
+		if jb.ExternalJobID == (uuid.UUID{}) {
+			jb.ExternalJobID = existing.ExternalJobID
+		} else if jb.ExternalJobID != existing.ExternalJobID {
+			return errors.Errorf("externalJobID can not be changed from %s to %s", existing.ExternalJobID, jb.ExternalJobID)
+		}
+		jb.ID = id
+
+		if err := o.updateTypeSpec(tx, existing, jb); err != nil {
+			return err
+		}
+
+		pipelineSpecID, err := o.pipelineORM.CreateSpec(jb.Pipeline, jb.MaxTaskDuration, pg.WithQueryer(tx))
+		if err != nil {
+			return errors.Wrap(err, "failed to create pipeline spec")
+		}
+		jb.PipelineSpecID = pipelineSpecID
+
+		sql := `UPDATE jobs SET pipeline_spec_id = :pipeline_spec_id, name = :name, schema_version = :schema_version,
+			max_task_duration = :max_task_duration, gas_limit = :gas_limit, forwarding_allowed = :forwarding_allowed
+			WHERE id = :id;`
+		if _, err = tx.NamedExec(sql, jb); err != nil {
+			return errors.Wrap(err, "failed to update job")
+		}
+		return o.insertSpecVersion(tx, jb)
+	})
+	if err != nil {
+		return errors.Wrap(err, "UpdateJob failed")
+	}
+
+	return o.findJob(jb, "id", id, qopts...)
+}
+
+// updateTypeSpec overwrites the type specific spec of existing with the one
+// of jb.
+func (o *orm) updateTypeSpec(tx pg.Queryer, existing Job, jb *Job) error {
+	var sql string
+	var arg interface{}
+	switch jb.Type {
+	case DirectRequest:
+		jb.DirectRequestSpecID = existing.DirectRequestSpecID
+		jb.DirectRequestSpec.ID = *existing.DirectRequestSpecID
+		sql = `UPDATE direct_request_specs SET contract_address = :contract_address, min_incoming_confirmations = :min_incoming_confirmations,
+			requesters = :requesters, min_contract_payment = :min_contract_payment, requester_limits = :requester_limits, evm_chain_id = :evm_chain_id, updated_at = NOW()
+			WHERE id = :id;`
+		arg = jb.DirectRequestSpec
+	case FluxMonitor:
+		jb.FluxMonitorSpecID = existing.FluxMonitorSpecID
+		jb.FluxMonitorSpec.ID = *existing.FluxMonitorSpecID
+		sql = `UPDATE flux_monitor_specs SET contract_address = :contract_address, threshold = :threshold, absolute_threshold = :absolute_threshold,
+			poll_timer_period = :poll_timer_period, poll_timer_disabled = :poll_timer_disabled, idle_timer_period = :idle_timer_period,
+			idle_timer_disabled = :idle_timer_disabled, drumbeat_schedule = :drumbeat_schedule, drumbeat_random_delay = :drumbeat_random_delay,
+			drumbeat_enabled = :drumbeat_enabled, min_payment = :min_payment, evm_chain_id = :evm_chain_id, updated_at = NOW()
+			WHERE id = :id;`
+		arg = jb.FluxMonitorSpec
+	case OffchainReporting:
+		jb.OCROracleSpecID = existing.OCROracleSpecID
+		jb.OCROracleSpec.ID = *existing.OCROracleSpecID
+		sql = `UPDATE ocr_oracle_specs SET contract_address = :contract_address, p2p_bootstrap_peers = :p2p_bootstrap_peers,
+			p2pv2_bootstrappers = :p2pv2_bootstrappers, is_bootstrap_peer = :is_bootstrap_peer, encrypted_ocr_key_bundle_id = :encrypted_ocr_key_bundle_id,
+			transmitter_address = :transmitter_address, observation_timeout = :observation_timeout, blockchain_timeout = :blockchain_timeout,
+			contract_config_tracker_subscribe_interval = :contract_config_tracker_subscribe_interval,
+			contract_config_tracker_poll_interval = :contract_config_tracker_poll_interval, contract_config_confirmations = :contract_config_confirmations,
+			evm_chain_id = :evm_chain_id, database_timeout = :database_timeout, observation_grace_period = :observation_grace_period,
+			contract_transmitter_transmit_timeout = :contract_transmitter_transmit_timeout, updated_at = NOW()
+			WHERE id = :id;`
+		arg = jb.OCROracleSpec
+	case OffchainReporting2:
+		jb.OCR2OracleSpecID = existing.OCR2OracleSpecID
+		jb.OCR2OracleSpec.ID = *existing.OCR2OracleSpecID
+		sql = `UPDATE ocr2_oracle_specs SET contract_id = :contract_id, relay = :relay, relay_config = :relay_config, plugin_type = :plugin_type,
+			plugin_config = :plugin_config, p2pv2_bootstrappers = :p2pv2_bootstrappers, ocr_key_bundle_id = :ocr_key_bundle_id,
+			transmitter_id = :transmitter_id, blockchain_timeout = :blockchain_timeout,
+			contract_config_tracker_poll_interval = :contract_config_tracker_poll_interval, contract_config_confirmations = :contract_config_confirmations,
+			updated_at = NOW()
+			WHERE id = :id;`
+		arg = jb.OCR2OracleSpec
+	case Keeper:
+		jb.KeeperSpecID = existing.KeeperSpecID
+		jb.KeeperSpec.ID = *existing.KeeperSpecID
+		sql = `UPDATE keeper_specs SET contract_address = :contract_address, from_address = :from_address, evm_chain_id = :evm_chain_id, updated_at = NOW()
+			WHERE id = :id;`
+		arg = jb.KeeperSpec
+	case Cron:
+		jb.CronSpecID = existing.CronSpecID
+		jb.CronSpec.ID = *existing.CronSpecID
+		sql = `UPDATE cron_specs SET cron_schedule = :cron_schedule, updated_at = NOW() WHERE id = :id;`
+		arg = jb.CronSpec
+	case VRF:
+		jb.VRFSpecID = existing.VRFSpecID
+		jb.VRFSpec.ID = *existing.VRFSpecID
+		sql = `UPDATE vrf_specs SET coordinator_address = :coordinator_address, public_key = :public_key,
+			min_incoming_confirmations = :min_incoming_confirmations, evm_chain_id = :evm_chain_id, from_addresses = :from_addresses,
+			poll_period = :poll_period, requested_confs_delay = :requested_confs_delay, request_timeout = :request_timeout,
+			chunk_size = :chunk_size, batch_coordinator_address = :batch_coordinator_address, batch_fulfillment_enabled = :batch_fulfillment_enabled,
+			batch_fulfillment_gas_multiplier = :batch_fulfillment_gas_multiplier, backoff_initial_delay = :backoff_initial_delay,
+			backoff_max_delay = :backoff_max_delay, max_gas_price_gwei = :max_gas_price_gwei, updated_at = NOW()
+			WHERE id = :id;`
+		arg = toVRFSpecRow(jb.VRFSpec)
+	case Webhook:
+		jb.WebhookSpecID = existing.WebhookSpecID
+		jb.WebhookSpec.ID = *existing.WebhookSpecID
+		if _, err := tx.Exec(`DELETE FROM external_initiator_webhook_specs WHERE webhook_spec_id = $1`, jb.WebhookSpec.ID); err != nil {
+			return errors.Wrap(err, "failed to delete ExternalInitiatorWebhookSpecs")
+		}
+		if len(jb.WebhookSpec.ExternalInitiatorWebhookSpecs) > 0 {
+			for i := range jb.WebhookSpec.ExternalInitiatorWebhookSpecs {
+				jb.WebhookSpec.ExternalInitiatorWebhookSpecs[i].WebhookSpecID = jb.WebhookSpec.ID
+			}
+			sql := `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec)
+			VALUES (:external_initiator_id, :webhook_spec_id, :spec);`
+			query, args, err := tx.BindNamed(sql, jb.WebhookSpec.ExternalInitiatorWebhookSpecs)
+			if err != nil {
+				return errors.Wrap(err, "failed to bindquery for ExternalInitiatorWebhookSpecs")
+			}
+			if _, err = tx.Exec(query, args...); err != nil {
+				return errors.Wrap(err, "failed to create ExternalInitiatorWebhookSpecs")
+			}
+		}
+		sql = `UPDATE webhook_specs SET updated_at = NOW() WHERE id = :id;`
+		arg = jb.WebhookSpec
+	case BlockhashStore:
+		jb.BlockhashStoreSpecID = existing.BlockhashStoreSpecID
+		jb.BlockhashStoreSpec.ID = *existing.BlockhashStoreSpecID
+		sql = `UPDATE blockhash_store_specs SET coordinator_v1_address = :coordinator_v1_address, coordinator_v2_address = :coordinator_v2_address,
+			wait_blocks = :wait_blocks, lookback_blocks = :lookback_blocks, blockhash_store_address = :blockhash_store_address,
+			poll_period = :poll_period, run_timeout = :run_timeout, evm_chain_id = :evm_chain_id, from_address = :from_address, updated_at = NOW()
+			WHERE id = :id;`
+		arg = jb.BlockhashStoreSpec
+	case Bootstrap:
+		jb.BootstrapSpecID = existing.BootstrapSpecID
+		jb.BootstrapSpec.ID = *existing.BootstrapSpecID
+		sql = `UPDATE bootstrap_specs SET contract_id = :contract_id, relay = :relay, relay_config = :relay_config,
+			monitoring_endpoint = :monitoring_endpoint, blockchain_timeout = :blockchain_timeout,
+			contract_config_tracker_poll_interval = :contract_config_tracker_poll_interval,
+			contract_config_confirmations = :contract_config_confirmations, updated_at = NOW()
+			WHERE id = :id;`
+		arg = jb.BootstrapSpec
+	default:
+		return errors.Errorf("unsupported job type: %v", jb.Type)
+	}
+
+	_, err := tx.NamedExec(sql, arg)
+	var pqErr *pgconn.PgError
+	if err != nil && errors.As(err, &pqErr) && pqErr.ConstraintName == "vrf_specs_public_key_fkey" {
+		return errors.Wrapf(ErrNoSuchPublicKey, "%s", jb.VRFSpec.PublicKey.String())
+	}
+	return errors.Wrapf(err, "failed to update %s spec", jb.Type)
+}
+
+// insertSpecVersion records the current spec of jb as its next version.
+func (o *orm) insertSpecVersion(tx pg.Queryer, jb *Job) error {
+	stmt := `INSERT INTO job_spec_versions (job_id, external_job_id, version, pipeline_spec_id, toml, created_at)
+	SELECT $1, $2, COALESCE(MAX(version), 0) + 1, $3, $4, NOW() FROM job_spec_versions WHERE job_id = $1;`
+	_, err := tx.Exec(stmt, jb.ID, jb.ExternalJobID, jb.PipelineSpecID, sql.NullString{String: jb.TOML, Valid: jb.TOML != ""})
+	return errors.Wrap(err, "failed to insert job spec version")
+}
+
+// FindSpecVersions returns all versions of a job's spec, latest first.
+func (o *orm) FindSpecVersions(jobID int32) (versions []SpecVersion, err error) {
+	err = o.q.Select(&versions, `SELECT * FROM job_spec_versions WHERE job_id = $1 ORDER BY version DESC`, jobID)
+	return versions, errors.Wrap(err, "FindSpecVersions failed")
+}
+
+// FindSpecVersion returns a version of a job's spec.
+func (o *orm) FindSpecVersion(jobID int32, version int32) (specVersion SpecVersion, err error) {
+	err = o.q.Get(&specVersion, `SELECT * FROM job_spec_versions WHERE job_id = $1 AND version = $2`, jobID, version)
+	return specVersion, errors.Wrap(err, "FindSpecVersion failed")
+}
+
+// PauseJob marks a job as paused. Pausing a paused job keeps the time it was
+// first paused at.
+func (o *orm) PauseJob(id int32, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	err := q.Get(&id, `UPDATE jobs SET paused_at = COALESCE(paused_at, $2) WHERE id = $1 RETURNING id`, id, time.Now())
+	return errors.Wrap(err, "PauseJob failed")
+}
+
+// ResumeJob clears the paused state of a job.
+func (o *orm) ResumeJob(id int32, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	err := q.Get(&id, `UPDATE jobs SET paused_at = NULL WHERE id = $1 RETURNING id`, id)
+	return errors.Wrap(err, "ResumeJob failed")
+}
+
 // DeleteJob removes a job
 func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 	o.lggr.Debugw("Deleting job", "jobID", id)
@@ -458,7 +696,11 @@ func (o *orm) DeleteJob(id int32, qopts ...pg.QOpt) error {
 		deleted_bootstrap_specs AS (
 			DELETE FROM bootstrap_specs WHERE id IN (SELECT bootstrap_spec_id FROM deleted_jobs)
 		)
-		DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM deleted_jobs)`
+		DELETE FROM pipeline_specs WHERE id IN (
+			SELECT pipeline_spec_id FROM deleted_jobs
+			UNION
+			SELECT pipeline_spec_id FROM job_spec_versions WHERE job_id = $1
+		)`
 	res, cancel, err := q.ExecQIter(query, id)
 	defer cancel()
 	if err != nil {
@@ -823,7 +1065,7 @@ func (o *orm) FindJobIDsWithBridge(name string) (jids []int32, err error) {
 // PipelineRunsByJobsIDs returns pipeline runs for multiple jobs, not preloading data
 func (o *orm) PipelineRunsByJobsIDs(ids []int32) (runs []pipeline.Run, err error) {
 	err = o.q.Transaction(func(tx pg.Queryer) error {
-		stmt := `SELECT pipeline_runs.* FROM pipeline_runs INNER JOIN jobs ON pipeline_runs.pipeline_spec_id = jobs.pipeline_spec_id WHERE jobs.id = ANY($1)
+		stmt := `SELECT pipeline_runs.* FROM pipeline_runs INNER JOIN job_pipeline_specs ON pipeline_runs.pipeline_spec_id = job_pipeline_specs.pipeline_spec_id WHERE job_pipeline_specs.job_id = ANY($1)
 		ORDER BY pipeline_runs.created_at DESC, pipeline_runs.id DESC;`
 		if err = tx.Select(&runs, stmt, ids); err != nil {
 			return errors.Wrap(err, "error loading runs")
@@ -852,7 +1094,7 @@ func (o *orm) loadPipelineRunIDs(jobID *int32, offset, limit int, tx pg.Queryer)
 
 	var filter string
 	if jobID != nil {
-		filter = fmt.Sprintf("JOIN jobs USING(pipeline_spec_id) WHERE jobs.id = %d AND ", *jobID)
+		filter = fmt.Sprintf("JOIN job_pipeline_specs USING(pipeline_spec_id) WHERE job_pipeline_specs.job_id = %d AND ", *jobID)
 	} else {
 		filter = "WHERE "
 	}
@@ -965,7 +1207,7 @@ WHERE id = $1
 // CountPipelineRunsByJobID returns the total number of pipeline runs for a job.
 func (o *orm) CountPipelineRunsByJobID(jobID int32) (count int32, err error) {
 	err = o.q.Transaction(func(tx pg.Queryer) error {
-		stmt := "SELECT COUNT(*) FROM pipeline_runs JOIN jobs USING (pipeline_spec_id) WHERE jobs.id = $1"
+		stmt := "SELECT COUNT(*) FROM pipeline_runs JOIN job_pipeline_specs USING (pipeline_spec_id) WHERE job_pipeline_specs.job_id = $1"
 		if err = tx.Get(&count, stmt, jobID); err != nil {
 			return errors.Wrap(err, "error counting runs")
 		}
@@ -976,15 +1218,44 @@ func (o *orm) CountPipelineRunsByJobID(jobID int32) (count int32, err error) {
 	return count, errors.Wrap(err, "PipelineRunsByJobsIDs failed")
 }
 
+// FindJobsByPipelineSpecIDs returns the jobs which the pipeline specs belong
+// to, in any of their versions. A job is returned once for each of its
+// pipeline specs found, with PipelineSpecID and PipelineSpec set to that one.
 func (o *orm) FindJobsByPipelineSpecIDs(ids []int32) ([]Job, error) {
 	var jbs []Job
 
 	err := o.q.Transaction(func(tx pg.Queryer) error {
-		stmt := `SELECT * FROM jobs WHERE jobs.pipeline_spec_id = ANY($1) ORDER BY id ASC
-`
-		if err := tx.Select(&jbs, stmt, ids); err != nil {
+		var versions []struct {
+			JobID          int32
+			PipelineSpecID int32
+		}
+		stmt := `SELECT job_id, pipeline_spec_id FROM job_pipeline_specs WHERE pipeline_spec_id = ANY($1) ORDER BY job_id ASC, pipeline_spec_id ASC`
+		if err := tx.Select(&versions, stmt, ids); err != nil {
+			return errors.Wrap(err, "error fetching job pipeline specs")
+		}
+		if len(versions) == 0 {
+			return nil
+		}
+		jobIDs := make([]int32, len(versions))
+		for i, v := range versions {
+			jobIDs[i] = v.JobID
+		}
+		var found []Job
+		if err := tx.Select(&found, `SELECT * FROM jobs WHERE id = ANY($1)`, jobIDs); err != nil {
 			return errors.Wrap(err, "error fetching jobs by pipeline spec IDs")
 		}
+		jobsByID := make(map[int32]Job, len(found))
+		for _, jb := range found {
+			jobsByID[jb.ID] = jb
+		}
+		for _, v := range versions {
+			jb, ok := jobsByID[v.JobID]
+			if !ok {
+				continue
+			}
+			jb.PipelineSpecID = v.PipelineSpecID
+			jbs = append(jbs, jb)
+		}
 
 		err := LoadAllJobsTypes(tx, jbs)
 		if err != nil {
@@ -1008,7 +1279,7 @@ func (o *orm) FindJobsByPipelineSpecIDs(ids []int32) ([]Job, error) {
 func (o *orm) PipelineRuns(jobID *int32, offset, size int) (runs []pipeline.Run, count int, err error) {
 	var filter string
 	if jobID != nil {
-		filter = fmt.Sprintf("JOIN jobs USING(pipeline_spec_id) WHERE jobs.id = %d", *jobID)
+		filter = fmt.Sprintf("JOIN job_pipeline_specs USING(pipeline_spec_id) WHERE job_pipeline_specs.job_id = %d", *jobID)
 	}
 	err = o.q.Transaction(func(tx pg.Queryer) error {
 		sql := fmt.Sprintf(`SELECT count(*) FROM pipeline_runs %s`, filter)
@@ -1039,7 +1310,7 @@ func (o *orm) loadPipelineRunsRelations(runs []pipeline.Run, tx pg.Queryer) ([]p
 	for specID := range specM {
 		specIDs = append(specIDs, specID)
 	}
-	stmt := `SELECT pipeline_specs.*, jobs.id AS job_id FROM pipeline_specs JOIN jobs ON pipeline_specs.id = jobs.pipeline_spec_id WHERE pipeline_specs.id = ANY($1);`
+	stmt := `SELECT pipeline_specs.*, job_pipeline_specs.job_id FROM pipeline_specs JOIN job_pipeline_specs ON pipeline_specs.id = job_pipeline_specs.pipeline_spec_id WHERE pipeline_specs.id = ANY($1);`
 	var specs []pipeline.Spec
 	if err := o.q.Select(&specs, stmt, specIDs); err != nil {
 		return nil, errors.Wrap(err, "error loading specs")
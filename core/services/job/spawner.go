@@ -2,6 +2,7 @@ package job
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"math"
 	"reflect"
@@ -26,7 +27,11 @@ type (
 	Spawner interface {
 		services.ServiceCtx
 		CreateJob(jb *Job, qopts ...pg.QOpt) error
+		CreateJobs(jbs []*Job, createDependencies func(tx pg.Queryer) error, qopts ...pg.QOpt) error
+		UpdateJob(jobID int32, jb *Job, qopts ...pg.QOpt) error
 		DeleteJob(jobID int32, qopts ...pg.QOpt) error
+		PauseJob(jobID int32, qopts ...pg.QOpt) error
+		ResumeJob(jobID int32, qopts ...pg.QOpt) error
 		ActiveJobs() map[int32]Job
 
 		// NOTE: Prefer to use CreateJob, this is only publicly exposed for use in tests
@@ -111,6 +116,10 @@ func (js *spawner) startAllServices(ctx context.Context) {
 	}
 
 	for _, spec := range specs {
+		if spec.PausedAt.Valid {
+			js.lggr.Infow("Not starting paused job", "jobID", spec.ID, "pausedAt", spec.PausedAt.Time)
+			continue
+		}
 		if err = js.StartService(ctx, spec); err != nil {
 			js.lggr.Errorf("Couldn't start service %v: %v", spec.Name, err)
 		}
@@ -132,10 +141,14 @@ func (js *spawner) stopAllServices() {
 // stopService removes the job from memory and stop the services.
 // It will always delete the job from memory even if closing the services fail.
 func (js *spawner) stopService(jobID int32) {
-	js.lggr.Debugw("Stopping services for job", "jobID", jobID)
 	js.activeJobsMu.Lock()
 	defer js.activeJobsMu.Unlock()
 
+	js.stopServiceLocked(jobID)
+}
+
+func (js *spawner) stopServiceLocked(jobID int32) {
+	js.lggr.Debugw("Stopping services for job", "jobID", jobID)
 	aj := js.activeJobs[jobID]
 
 	for i := len(aj.services) - 1; i >= 0; i-- {
@@ -157,6 +170,10 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 	js.activeJobsMu.Lock()
 	defer js.activeJobsMu.Unlock()
 
+	return js.startServiceLocked(ctx, jb)
+}
+
+func (js *spawner) startServiceLocked(ctx context.Context, jb Job) error {
 	delegate, exists := js.jobTypeDelegates[jb.Type]
 	if !exists {
 		js.lggr.Errorw("Job type has not been registered with job.Spawner", "type", jb.Type, "jobID", jb.ID)
@@ -168,15 +185,7 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 	// that it was able to start without an error.
 	aj := activeJob{delegate: delegate, spec: jb}
 
-	jb.PipelineSpec.JobName = jb.Name.ValueOrZero()
-	jb.PipelineSpec.JobID = jb.ID
-	jb.PipelineSpec.JobType = string(jb.Type)
-	jb.PipelineSpec.ForwardingAllowed = jb.ForwardingAllowed
-	if jb.GasLimit.Valid {
-		jb.PipelineSpec.GasLimit = &jb.GasLimit.Uint32
-	}
-
-	services, err := delegate.ServicesForSpec(jb)
+	services, err := servicesForSpec(delegate, jb)
 	if err != nil {
 		js.lggr.Errorw("Error creating services for job", "jobID", jb.ID, "error", err)
 		cctx, cancel := utils.ContextFromChan(js.chStop)
@@ -186,10 +195,31 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 		return nil
 	}
 
+	js.startServicesLocked(ctx, aj, services)
+	return nil
+}
+
+// servicesForSpec returns the services of jb, once the job's fields its
+// pipeline spec needs are set.
+func servicesForSpec(delegate Delegate, jb Job) ([]ServiceCtx, error) {
+	jb.PipelineSpec.JobName = jb.Name.ValueOrZero()
+	jb.PipelineSpec.JobID = jb.ID
+	jb.PipelineSpec.JobType = string(jb.Type)
+	jb.PipelineSpec.ForwardingAllowed = jb.ForwardingAllowed
+	if jb.GasLimit.Valid {
+		jb.PipelineSpec.GasLimit = &jb.GasLimit.Uint32
+	}
+	return delegate.ServicesForSpec(jb)
+}
+
+// startServicesLocked starts the services of aj, and adds it to the active
+// jobs. Services which fail to start are logged and left out.
+func (js *spawner) startServicesLocked(ctx context.Context, aj activeJob, services []ServiceCtx) {
+	jb := aj.spec
 	js.lggr.Debugw("JobSpawner: Starting services for job", "jobID", jb.ID, "count", len(services))
 
 	for _, service := range services {
-		err = service.Start(ctx)
+		err := service.Start(ctx)
 		if err != nil {
 			js.lggr.Criticalw("Error starting service for job", "jobID", jb.ID, "error", err)
 			continue
@@ -198,7 +228,6 @@ func (js *spawner) StartService(ctx context.Context, jb Job) error {
 	}
 	js.lggr.Debugw("JobSpawner: Finished starting services for job", "jobID", jb.ID, "count", len(services))
 	js.activeJobs[jb.ID] = aj
-	return nil
 }
 
 // Should not get called before Start()
@@ -238,6 +267,144 @@ func (js *spawner) CreateJob(jb *Job, qopts ...pg.QOpt) error {
 	return err
 }
 
+// CreateJobs creates several jobs in a single transaction, so that either all
+// or none of them are created. createDependencies is called within the
+// transaction before the jobs are saved, e.g. to create the bridges they
+// call. The jobs are only started once the transaction is committed.
+// Should not get called before Start()
+func (js *spawner) CreateJobs(jbs []*Job, createDependencies func(tx pg.Queryer) error, qopts ...pg.QOpt) error {
+	delegates := make([]Delegate, len(jbs))
+	for i, jb := range jbs {
+		delegate, exists := js.jobTypeDelegates[jb.Type]
+		if !exists {
+			return errors.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
+		}
+		delegates[i] = delegate
+	}
+
+	q := js.q.WithOpts(qopts...)
+	if q.ParentCtx != nil {
+		ctx, cancel := utils.WithCloseChan(q.ParentCtx, js.chStop)
+		defer cancel()
+		q.ParentCtx = ctx
+	} else {
+		ctx, cancel := utils.ContextFromChan(js.chStop)
+		defer cancel()
+		q.ParentCtx = ctx
+	}
+	ctx, cancel := q.Context()
+	defer cancel()
+
+	err := q.Transaction(func(tx pg.Queryer) error {
+		if createDependencies != nil {
+			if err := createDependencies(tx); err != nil {
+				return err
+			}
+		}
+		for _, jb := range jbs {
+			if err := js.orm.CreateJob(jb, pg.WithQueryer(tx), pg.WithParentCtx(ctx)); err != nil {
+				return errors.Wrapf(err, "failed to create job %q", jb.Name.ValueOrZero())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		js.lggr.Errorw("Error creating jobs", "error", err)
+		return err
+	}
+
+	for i, jb := range jbs {
+		if err = js.StartService(q.ParentCtx, *jb); err != nil {
+			return err
+		}
+		delegates[i].AfterJobCreated(*jb)
+		js.lggr.Infow("Created job", "type", jb.Type, "jobID", jb.ID)
+	}
+	return nil
+}
+
+// UpdateJob replaces the spec of a job. The services of a running job are
+// stopped and the new ones started while holding the lock on the active jobs,
+// so that the job is never missing from ActiveJobs. Paused jobs stay paused.
+// The services are swapped within the transaction which stores the new spec,
+// so that the update is rolled back if the new services can not be created,
+// and the old services are restored if the transaction fails to commit.
+// Should not get called before Start()
+func (js *spawner) UpdateJob(jobID int32, jb *Job, qopts ...pg.QOpt) error {
+	delegate, exists := js.jobTypeDelegates[jb.Type]
+	if !exists {
+		return errors.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
+	}
+
+	q := js.q.WithOpts(qopts...)
+	if q.ParentCtx != nil {
+		ctx, cancel := utils.WithCloseChan(q.ParentCtx, js.chStop)
+		defer cancel()
+		q.ParentCtx = ctx
+	} else {
+		ctx, cancel := utils.ContextFromChan(js.chStop)
+		defer cancel()
+		q.ParentCtx = ctx
+	}
+	ctx, cancel := q.Context()
+	defer cancel()
+
+	aj, err := js.findJob(ctx, jobID)
+	if err != nil {
+		return err
+	}
+
+	var swapped bool
+	err = q.Transaction(func(tx pg.Queryer) error {
+		if err = js.orm.UpdateJob(jobID, jb, pg.WithQueryer(tx), pg.WithParentCtx(ctx)); err != nil {
+			return err
+		}
+		var services []ServiceCtx
+		if !jb.PausedAt.Valid {
+			if services, err = servicesForSpec(delegate, *jb); err != nil {
+				return errors.Wrap(err, "failed to create services for job")
+			}
+		}
+
+		aj.delegate.BeforeJobDeleted(aj.spec)
+		js.activeJobsMu.Lock()
+		defer js.activeJobsMu.Unlock()
+		js.stopServiceLocked(jobID)
+		if !jb.PausedAt.Valid {
+			js.startServicesLocked(q.ParentCtx, activeJob{delegate: delegate, spec: *jb}, services)
+		}
+		swapped = true
+		return nil
+	})
+	if err != nil {
+		js.lggr.Errorw("Error updating job", "jobID", jobID, "error", err)
+		if swapped {
+			js.restoreServices(q.ParentCtx, aj)
+		}
+		return err
+	}
+	delegate.AfterJobCreated(*jb)
+
+	js.lggr.Infow("Updated job", "type", jb.Type, "jobID", jb.ID)
+	return nil
+}
+
+// restoreServices replaces the services of the job with the ones of aj, after
+// its update failed to commit.
+func (js *spawner) restoreServices(ctx context.Context, aj activeJob) {
+	js.activeJobsMu.Lock()
+	defer js.activeJobsMu.Unlock()
+	js.stopServiceLocked(aj.spec.ID)
+	if aj.spec.PausedAt.Valid {
+		return
+	}
+	if err := js.startServiceLocked(ctx, aj.spec); err != nil {
+		js.lggr.Criticalw("Error restoring services for job", "jobID", aj.spec.ID, "error", err)
+		return
+	}
+	aj.delegate.AfterJobCreated(aj.spec)
+}
+
 // Should not get called before Start()
 func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 	if jobID == 0 {
@@ -247,15 +414,13 @@ func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 	lggr := js.lggr.With("jobID", jobID)
 	lggr.Debugw("Deleting job")
 
-	var aj activeJob
-	var exists bool
-	func() {
-		js.activeJobsMu.RLock()
-		defer js.activeJobsMu.RUnlock()
-		aj, exists = js.activeJobs[jobID]
+	aj, err := func() (activeJob, error) {
+		ctx, cancel := utils.ContextFromChan(js.chStop)
+		defer cancel()
+		return js.findJob(ctx, jobID)
 	}()
-	if !exists {
-		return errors.Errorf("job not found (id: %v)", jobID)
+	if err != nil {
+		return err
 	}
 
 	lggr.Debugw("Callback: BeforeJobDeleted")
@@ -276,7 +441,7 @@ func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 		}
 		return ctx
 	}
-	err := js.orm.DeleteJob(jobID, append(qopts, pg.MergeCtx(setCtx))...)
+	err = js.orm.DeleteJob(jobID, append(qopts, pg.MergeCtx(setCtx))...)
 	if err != nil {
 		js.lggr.Errorw("Error deleting job", "jobID", jobID, "error", err)
 		return err
@@ -291,6 +456,83 @@ func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 	return nil
 }
 
+// PauseJob stops the services of a job and marks it as paused, so that they
+// are not started again until the job is resumed. The job's spec, keys and
+// runs are kept.
+// Should not get called before Start()
+func (js *spawner) PauseJob(jobID int32, qopts ...pg.QOpt) error {
+	if err := js.orm.PauseJob(jobID, qopts...); err != nil {
+		js.lggr.Errorw("Error pausing job", "jobID", jobID, "error", err)
+		return err
+	}
+
+	js.stopService(jobID)
+
+	js.lggr.Infow("Paused job", "jobID", jobID)
+	return nil
+}
+
+// ResumeJob clears the paused state of a job and starts its services again.
+// Should not get called before Start()
+func (js *spawner) ResumeJob(jobID int32, qopts ...pg.QOpt) error {
+	q := js.q.WithOpts(qopts...)
+	if q.ParentCtx != nil {
+		ctx, cancel := utils.WithCloseChan(q.ParentCtx, js.chStop)
+		defer cancel()
+		q.ParentCtx = ctx
+	} else {
+		ctx, cancel := utils.ContextFromChan(js.chStop)
+		defer cancel()
+		q.ParentCtx = ctx
+	}
+	ctx, cancel := q.Context()
+	defer cancel()
+
+	if err := js.orm.ResumeJob(jobID, pg.WithQueryer(q.Queryer), pg.WithParentCtx(ctx)); err != nil {
+		js.lggr.Errorw("Error resuming job", "jobID", jobID, "error", err)
+		return err
+	}
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if err != nil {
+		return err
+	}
+
+	js.activeJobsMu.Lock()
+	defer js.activeJobsMu.Unlock()
+	if _, exists := js.activeJobs[jobID]; exists {
+		return nil
+	}
+	if err = js.startServiceLocked(q.ParentCtx, jb); err != nil {
+		return err
+	}
+
+	js.lggr.Infow("Resumed job", "type", jb.Type, "jobID", jobID)
+	return nil
+}
+
+// findJob returns a job and its delegate. Paused jobs are not active, so they
+// are loaded from the database.
+func (js *spawner) findJob(ctx context.Context, jobID int32) (activeJob, error) {
+	js.activeJobsMu.RLock()
+	aj, exists := js.activeJobs[jobID]
+	js.activeJobsMu.RUnlock()
+	if exists {
+		return aj, nil
+	}
+
+	jb, err := js.orm.FindJob(ctx, jobID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return aj, errors.Errorf("job not found (id: %v)", jobID)
+	} else if err != nil {
+		return aj, err
+	}
+	delegate, exists := js.jobTypeDelegates[jb.Type]
+	if !exists {
+		return aj, errors.Errorf("job type '%s' has not been registered with the job.Spawner", jb.Type)
+	}
+	return activeJob{delegate: delegate, spec: jb}, nil
+}
+
 func (js *spawner) ActiveJobs() map[int32]Job {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
@@ -1,26 +1,32 @@
 package job_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/sqlx"
 
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/job/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/ocr"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -232,4 +238,166 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 			return exists
 		}, testutils.WaitTimeout(t), cltest.DBPollingInterval).Should(gomega.Equal(false))
 	})
+	clearDB(t, db)
+
+	t.Run("stops and restarts job services on 'PauseJob()'/'ResumeJob()'", func(t *testing.T) {
+		jobA := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		serviceA1 := mocks.NewServiceCtx(t)
+		serviceA2 := mocks.NewServiceCtx(t)
+		serviceA1.On("Start", mock.Anything).Return(nil).Once()
+		serviceA2.On("Start", mock.Anything).Return(nil).Once()
+
+		lggr := logger.TestLogger(t)
+		orm := job.NewTestORM(t, db, cc, pipeline.NewORM(db, lggr, config), keyStore, config)
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, cc, logger.TestLogger(t), config)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA1, serviceA2}, 0, nil, d}
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, db, lggr, nil)
+
+		require.NoError(t, orm.CreateJob(jobA))
+		delegateA.jobID = jobA.ID
+
+		// Paused jobs are not started with the spawner
+		require.NoError(t, orm.PauseJob(jobA.ID))
+		require.NoError(t, spawner.Start(testutils.Context(t)))
+		defer spawner.Close()
+		assert.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+
+		require.NoError(t, spawner.ResumeJob(jobA.ID))
+		require.Contains(t, spawner.ActiveJobs(), jobA.ID)
+		assert.False(t, spawner.ActiveJobs()[jobA.ID].PausedAt.Valid)
+		// Resuming a running job is a noop
+		require.NoError(t, spawner.ResumeJob(jobA.ID))
+
+		serviceA1.On("Close").Return(nil).Once()
+		serviceA2.On("Close").Return(nil).Once()
+		require.NoError(t, spawner.PauseJob(jobA.ID))
+		assert.NotContains(t, spawner.ActiveJobs(), jobA.ID)
+		mock.AssertExpectationsForObjects(t, serviceA1, serviceA2)
+
+		jb, err := orm.FindJob(testutils.Context(t), jobA.ID)
+		require.NoError(t, err)
+		require.True(t, jb.PausedAt.Valid)
+
+		// Pausing again keeps the time the job was first paused at
+		require.NoError(t, spawner.PauseJob(jobA.ID))
+		again, err := orm.FindJob(testutils.Context(t), jobA.ID)
+		require.NoError(t, err)
+		assert.Equal(t, jb.PausedAt.Time.Unix(), again.PausedAt.Time.Unix())
+
+		// Paused jobs can be deleted
+		require.NoError(t, spawner.DeleteJob(jobA.ID))
+		cltest.AssertCount(t, db, "jobs", 0)
+
+		require.Error(t, spawner.PauseJob(jobA.ID))
+		require.Error(t, spawner.ResumeJob(jobA.ID))
+	})
+
+	clearDB(t, db)
+
+	t.Run("creates jobs and their dependencies in a single transaction on 'CreateJobs()'", func(t *testing.T) {
+		_, newBridge := cltest.NewBridgeType(t, cltest.BridgeOpts{})
+		jobA := makeOCRJobSpec(t, address, newBridge.Name.String(), bridge2.Name.String())
+		jobB := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+
+		serviceA := mocks.NewServiceCtx(t)
+		serviceB := mocks.NewServiceCtx(t)
+		serviceA.On("Start", mock.Anything).Return(nil).Twice()
+		serviceB.On("Start", mock.Anything).Return(nil).Twice()
+
+		lggr := logger.TestLogger(t)
+		orm := job.NewTestORM(t, db, cc, pipeline.NewORM(db, lggr, config), keyStore, config)
+		bridgeORM := bridges.NewORM(db, lggr, config)
+		d := ocr.NewDelegate(nil, orm, nil, nil, nil, monitoringEndpoint, cc, logger.TestLogger(t), config)
+		delegateA := &delegate{jobA.Type, []job.ServiceCtx{serviceA, serviceB}, 0, nil, d}
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
+			jobA.Type: delegateA,
+		}, db, lggr, nil)
+		require.NoError(t, spawner.Start(testutils.Context(t)))
+		defer spawner.Close()
+
+		// Nothing is created if a dependency fails
+		err := spawner.CreateJobs([]*job.Job{jobA, jobB}, func(tx pg.Queryer) error {
+			require.NoError(t, bridgeORM.CreateBridgeType(newBridge, pg.WithQueryer(tx)))
+			return errors.New("failed")
+		})
+		require.Error(t, err)
+		cltest.AssertCount(t, db, "jobs", 0)
+		_, err = bridgeORM.FindBridge(newBridge.Name)
+		require.Error(t, err)
+
+		// Jobs see the bridges created within the transaction
+		require.NoError(t, spawner.CreateJobs([]*job.Job{jobA, jobB}, func(tx pg.Queryer) error {
+			return bridgeORM.CreateBridgeType(newBridge, pg.WithQueryer(tx))
+		}))
+		cltest.AssertCount(t, db, "jobs", 2)
+		_, err = bridgeORM.FindBridge(newBridge.Name)
+		require.NoError(t, err)
+		assert.Contains(t, spawner.ActiveJobs(), jobA.ID)
+		assert.Contains(t, spawner.ActiveJobs(), jobB.ID)
+
+		serviceA.On("Close").Return(nil).Twice()
+		serviceB.On("Close").Return(nil).Twice()
+	})
+}
+
+func TestSpawner_UpdateJob(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	lggr := logger.TestLogger(t)
+	orm := job.NewTestORM(t, db, cc, pipeline.NewORM(db, lggr, config), keyStore, config)
+
+	jb, err := directrequest.ValidatedDirectRequestSpec(testspecs.DirectRequestSpec)
+	require.NoError(t, err)
+	updatedTOML := strings.Replace(testspecs.DirectRequestSpec, "times=100", "times=1000", 1)
+
+	d := mocks.NewDelegate(t)
+	d.On("AfterJobCreated", mock.Anything).Return().Maybe()
+	d.On("BeforeJobDeleted", mock.Anything).Return().Maybe()
+	spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{job.DirectRequest: d}, db, lggr, nil)
+	require.NoError(t, spawner.Start(testutils.Context(t)))
+	t.Cleanup(func() { require.NoError(t, spawner.Close()) })
+
+	service := mocks.NewServiceCtx(t)
+	service.On("Start", mock.Anything).Return(nil).Once()
+	d.On("ServicesForSpec", mock.Anything).Return([]job.ServiceCtx{service}, nil).Once()
+	require.NoError(t, spawner.CreateJob(&jb))
+
+	t.Run("rolls back if the new services can not be created", func(t *testing.T) {
+		updated, err := directrequest.ValidatedDirectRequestSpec(updatedTOML)
+		require.NoError(t, err)
+		d.On("ServicesForSpec", mock.Anything).Return(nil, errors.New("no services")).Once()
+
+		err = spawner.UpdateJob(jb.ID, &updated)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no services")
+
+		versions, err := orm.FindSpecVersions(jb.ID)
+		require.NoError(t, err)
+		assert.Len(t, versions, 1)
+		assert.Equal(t, jb.PipelineSpecID, spawner.ActiveJobs()[jb.ID].PipelineSpecID)
+	})
+
+	t.Run("swaps the services", func(t *testing.T) {
+		updated, err := directrequest.ValidatedDirectRequestSpec(updatedTOML)
+		require.NoError(t, err)
+		service.On("Close").Return(nil).Once()
+		newService := mocks.NewServiceCtx(t)
+		newService.On("Start", mock.Anything).Return(nil).Once()
+		newService.On("Close").Return(nil).Once()
+		d.On("ServicesForSpec", mock.Anything).Return([]job.ServiceCtx{newService}, nil).Once()
+
+		require.NoError(t, spawner.UpdateJob(jb.ID, &updated))
+
+		versions, err := orm.FindSpecVersions(jb.ID)
+		require.NoError(t, err)
+		assert.Len(t, versions, 2)
+		assert.Equal(t, updated.PipelineSpecID, spawner.ActiveJobs()[jb.ID].PipelineSpecID)
+	})
 }
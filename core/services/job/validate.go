@@ -5,6 +5,8 @@ import (
 
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 )
 
 var (
@@ -66,3 +68,34 @@ func ValidateSpec(ts string) (Type, error) {
 
 	return jb.Type, nil
 }
+
+// ValidatedPipelineSpec runs the common spec validation and returns an unsaved pipeline spec
+// for the job's observationSource, e.g. to simulate the pipeline before creating the job.
+func ValidatedPipelineSpec(ts string) (pipeline.Spec, error) {
+	jobType, err := ValidateSpec(ts)
+	if err != nil {
+		return pipeline.Spec{}, err
+	}
+	var jb Job
+	tree, err := toml.Load(ts)
+	if err != nil {
+		return pipeline.Spec{}, err
+	}
+	if err = tree.Unmarshal(&jb); err != nil {
+		return pipeline.Spec{}, err
+	}
+	if jb.Pipeline.Source == "" {
+		return pipeline.Spec{}, ErrNoPipelineSpec
+	}
+	spec := pipeline.Spec{
+		DotDagSource:      jb.Pipeline.Source,
+		MaxTaskDuration:   jb.MaxTaskDuration,
+		ForwardingAllowed: jb.ForwardingAllowed,
+		JobName:           jb.Name.ValueOrZero(),
+		JobType:           string(jobType),
+	}
+	if jb.GasLimit.Valid {
+		spec.GasLimit = &jb.GasLimit.Uint32
+	}
+	return spec, nil
+}
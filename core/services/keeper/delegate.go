@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/sqlx"
 
@@ -8,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 )
 
@@ -59,8 +64,7 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.ServiceCtx, err
 	}
 	registryAddress := spec.KeeperSpec.ContractAddress
 
-	strategy := txmgr.NewQueueingTxStrategy(spec.ExternalJobID, chain.Config().KeeperDefaultTransactionQueueDepth())
-	orm := NewORM(d.db, d.logger, chain.Config(), strategy)
+	orm := d.newORM(spec, chain)
 	svcLogger := d.logger.With(
 		"jobID", spec.ID,
 		"registryAddress", registryAddress.Hex(),
@@ -77,17 +81,7 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.ServiceCtx, err
 		minIncomingConfirmations = *spec.KeeperSpec.MinIncomingConfirmations
 	}
 
-	// effectiveKeeperAddress is the keeper address registered on the registry. This is by default the EOA account on the node.
-	// In the case of forwarding, the keeper address is the forwarder contract deployed onchain between EOA and Registry.
-	effectiveKeeperAddress := spec.KeeperSpec.FromAddress.Address()
-	if spec.ForwardingAllowed {
-		fwdrAddress, fwderr := chain.TxManager().GetForwarderForEOA(spec.KeeperSpec.FromAddress.Address())
-		if fwderr == nil {
-			effectiveKeeperAddress = fwdrAddress
-		} else {
-			svcLogger.Warnw("Skipping forwarding for job, will fallback to default behavior", "job", spec.Name, "err", fwderr)
-		}
-	}
+	effectiveKeeperAddress := effectiveKeeperAddressFor(spec, chain, svcLogger)
 
 	registrySynchronizer := NewRegistrySynchronizer(RegistrySynchronizerOptions{
 		Job:                      spec,
@@ -102,20 +96,81 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.ServiceCtx, err
 		EffectiveKeeperAddress:   effectiveKeeperAddress,
 		newTurnEnabled:           chain.Config().KeeperTurnFlagEnabled(),
 	})
-	upkeepExecuter := NewUpkeepExecuter(
+	upkeepExecuter := d.newUpkeepExecuter(spec, chain, orm, svcLogger, effectiveKeeperAddress)
+
+	return []job.ServiceCtx{
+		registrySynchronizer,
+		upkeepExecuter,
+	}, nil
+}
+
+// ReportUpkeep checks an upkeep of the registry at the latest head, the same
+// way the UpkeepExecuter of the registry's job does, without performing it.
+func (d *Delegate) ReportUpkeep(ctx context.Context, registryAddress ethkey.EIP55Address, upkeepID *big.Int) (report UpkeepReport, err error) {
+	defaultChain, err := d.chainSet.Default()
+	if err != nil {
+		return report, err
+	}
+	registry, err := NewORM(d.db, d.logger, defaultChain.Config(), txmgr.SendEveryStrategy{}).RegistryByContractAddress(registryAddress)
+	if err != nil {
+		return report, errors.Wrap(err, "unable to load registry")
+	}
+	spec, err := d.jrm.FindJob(ctx, registry.JobID)
+	if err != nil {
+		return report, errors.Wrap(err, "unable to load registry job")
+	}
+	if spec.KeeperSpec == nil {
+		return report, errors.Errorf("job %d is not a keeper job", spec.ID)
+	}
+	chain, err := d.chainSet.Get(spec.KeeperSpec.EVMChainID.ToInt())
+	if err != nil {
+		return report, err
+	}
+
+	svcLogger := d.logger.With(
+		"jobID", spec.ID,
+		"registryAddress", registryAddress.Hex(),
+	)
+	executer := d.newUpkeepExecuter(spec, chain, d.newORM(spec, chain), svcLogger, effectiveKeeperAddressFor(spec, chain, svcLogger))
+
+	head, err := chain.Client().HeadByNumber(ctx, nil)
+	if err != nil {
+		return report, errors.Wrap(err, "unable to get latest head")
+	}
+	if head == nil {
+		return report, errors.New("no latest head")
+	}
+	return executer.Report(ctx, upkeepID, head)
+}
+
+func (d *Delegate) newORM(spec job.Job, chain evm.Chain) ORM {
+	strategy := txmgr.NewPriorityStrategy(txmgr.NewQueueingTxStrategy(spec.ExternalJobID, chain.Config().KeeperDefaultTransactionQueueDepth()), txmgr.TxPriorityLow)
+	return NewORM(d.db, d.logger, chain.Config(), strategy)
+}
+
+func (d *Delegate) newUpkeepExecuter(spec job.Job, chain evm.Chain, orm ORM, lggr logger.Logger, effectiveKeeperAddress common.Address) *UpkeepExecuter {
+	return NewUpkeepExecuter(
 		spec,
 		orm,
 		d.pr,
 		chain.Client(),
 		chain.HeadBroadcaster(),
 		chain.TxManager().GetGasEstimator(),
-		svcLogger,
+		lggr,
 		chain.Config(),
 		effectiveKeeperAddress,
 	)
+}
 
-	return []job.ServiceCtx{
-		registrySynchronizer,
-		upkeepExecuter,
-	}, nil
+// effectiveKeeperAddressFor returns the keeper address registered on the registry. This is by default the EOA account on the node.
+// In the case of forwarding, the keeper address is the forwarder contract deployed onchain between EOA and Registry.
+func effectiveKeeperAddressFor(spec job.Job, chain evm.Chain, lggr logger.Logger) common.Address {
+	if spec.ForwardingAllowed {
+		fwdrAddress, fwderr := chain.TxManager().GetForwarderForEOA(spec.KeeperSpec.FromAddress.Address())
+		if fwderr == nil {
+			return fwdrAddress
+		}
+		lggr.Warnw("Skipping forwarding for job, will fallback to default behavior", "job", spec.Name, "err", fwderr)
+	}
+	return spec.KeeperSpec.FromAddress.Address()
 }
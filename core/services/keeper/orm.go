@@ -245,6 +245,12 @@ func loadUpkeepsRegistry(q pg.Queryer, upkeeps []UpkeepRegistration) error {
 	return nil
 }
 
+// UpkeepByID returns the upkeep with the given ID of a registry
+func (korm ORM) UpkeepByID(registryID int64, upkeepID *utils.Big) (upkeep UpkeepRegistration, err error) {
+	err = korm.q.Get(&upkeep, `SELECT * FROM upkeep_registrations WHERE registry_id = $1 AND upkeep_id = $2`, registryID, upkeepID)
+	return upkeep, errors.Wrap(err, "failed to get upkeep")
+}
+
 func (korm ORM) AllUpkeepIDsForRegistry(regID int64) (upkeeps []utils.Big, err error) {
 	err = korm.q.Select(&upkeeps, `
 SELECT upkeep_id
@@ -95,6 +95,24 @@ func TestKeeperDB_RegistryByContractAddress(t *testing.T) {
 	require.Equal(t, registry, registryByContractAddress)
 }
 
+func TestKeeperDB_UpkeepByID(t *testing.T) {
+	t.Parallel()
+	db, config, orm := setupKeeperDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, db, config).Eth()
+
+	registry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore, 0, 1, 20)
+	otherRegistry, _ := cltest.MustInsertKeeperRegistry(t, db, orm, ethKeyStore, 0, 1, 20)
+	upkeep := cltest.MustInsertUpkeepForRegistry(t, db, config, registry)
+
+	upkeepByID, err := orm.UpkeepByID(registry.ID, upkeep.UpkeepID)
+	require.NoError(t, err)
+	require.Equal(t, upkeep.ID, upkeepByID.ID)
+	require.Equal(t, upkeep.UpkeepID.String(), upkeepByID.UpkeepID.String())
+
+	_, err = orm.UpkeepByID(otherRegistry.ID, upkeep.UpkeepID)
+	require.Error(t, err)
+}
+
 func TestKeeperDB_UpsertUpkeep(t *testing.T) {
 	t.Parallel()
 	db, config, orm := setupKeeperDB(t)
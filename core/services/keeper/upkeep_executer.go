@@ -150,32 +150,10 @@ func (ex *UpkeepExecuter) processActiveUpkeeps() {
 		return
 	}
 
-	var activeUpkeeps []UpkeepRegistration
-	if ex.config.KeeperTurnFlagEnabled() {
-		turnBinary, err2 := ex.turnBlockHashBinary(registry, head, ex.config.KeeperTurnLookBack())
-		if err2 != nil {
-			ex.logger.Error(errors.Wrap(err2, "unable to get turn block number hash"))
-			return
-		}
-		activeUpkeeps, err2 = ex.orm.NewEligibleUpkeepsForRegistry(
-			ex.job.KeeperSpec.ContractAddress,
-			head.Number,
-			ex.config.KeeperMaximumGracePeriod(),
-			turnBinary)
-		if err2 != nil {
-			ex.logger.Error(errors.Wrap(err2, "unable to load active registrations"))
-			return
-		}
-	} else {
-		activeUpkeeps, err = ex.orm.EligibleUpkeepsForRegistry(
-			ex.job.KeeperSpec.ContractAddress,
-			head.Number,
-			ex.config.KeeperMaximumGracePeriod(),
-		)
-		if err != nil {
-			ex.logger.Error(errors.Wrap(err, "unable to load active registrations"))
-			return
-		}
+	activeUpkeeps, err := ex.eligibleUpkeeps(registry, head)
+	if err != nil {
+		ex.logger.Error(err)
+		return
 	}
 
 	if head.Number%10 == 0 {
@@ -202,6 +180,29 @@ func (ex *UpkeepExecuter) processActiveUpkeeps() {
 	ex.logger.Debugw("Finished checking upkeeps", "blockNum", head.Number)
 }
 
+// eligibleUpkeeps returns the upkeeps of registry which this node checks at
+// head, following the turn taking algorithm selected by KeeperTurnFlagEnabled.
+func (ex *UpkeepExecuter) eligibleUpkeeps(registry Registry, head *evmtypes.Head) ([]UpkeepRegistration, error) {
+	if ex.config.KeeperTurnFlagEnabled() {
+		turnBinary, err := ex.turnBlockHashBinary(registry, head, ex.config.KeeperTurnLookBack())
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get turn block number hash")
+		}
+		activeUpkeeps, err := ex.orm.NewEligibleUpkeepsForRegistry(
+			ex.job.KeeperSpec.ContractAddress,
+			head.Number,
+			ex.config.KeeperMaximumGracePeriod(),
+			turnBinary)
+		return activeUpkeeps, errors.Wrap(err, "unable to load active registrations")
+	}
+	activeUpkeeps, err := ex.orm.EligibleUpkeepsForRegistry(
+		ex.job.KeeperSpec.ContractAddress,
+		head.Number,
+		ex.config.KeeperMaximumGracePeriod(),
+	)
+	return activeUpkeeps, errors.Wrap(err, "unable to load active registrations")
+}
+
 // execute triggers the pipeline run
 func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head, done func()) {
 	defer done()
@@ -213,35 +214,12 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 	ctxService, cancel := utils.ContextFromChanWithDeadline(ex.chStop, time.Minute)
 	defer cancel()
 
-	evmChainID := ""
-	if ex.job.KeeperSpec.EVMChainID != nil {
-		evmChainID = ex.job.KeeperSpec.EVMChainID.String()
-	}
-
-	var gasPrice, gasTipCap, gasFeeCap *big.Int
-	if ex.config.KeeperCheckUpkeepGasPriceFeatureEnabled() {
-		price, fee, err := ex.estimateGasPrice(upkeep)
-		if err != nil {
-			svcLogger.Error(errors.Wrap(err, "estimating gas price"))
-			return
-		}
-		gasPrice, gasTipCap, gasFeeCap = price, fee.TipCap, fee.FeeCap
-
-		// Make sure the gas price is at least as large as the basefee to avoid ErrFeeCapTooLow error from geth during eth call.
-		// If head.BaseFeePerGas, we assume it is a EIP-1559 chain.
-		// Note: gasPrice will be nil if EvmEIP1559DynamicFees is enabled.
-		if head.BaseFeePerGas != nil && head.BaseFeePerGas.ToInt().BitLen() > 0 {
-			baseFee := addBuffer(head.BaseFeePerGas.ToInt(), ex.config.KeeperBaseFeeBufferPercent())
-			if gasPrice == nil || gasPrice.Cmp(baseFee) < 0 {
-				gasPrice = baseFee
-			}
-		}
+	vars, err := ex.runVars(upkeep, head)
+	if err != nil {
+		svcLogger.Error(errors.Wrap(err, "estimating gas price"))
+		return
 	}
 
-	// effectiveKeeperAddress is always fromAddress when forwarding is not enabled.
-	// when forwarding is enabled, effectiveKeeperAddress is on-chain forwarder.
-	vars := pipeline.NewVarsFrom(buildJobSpec(ex.job, ex.effectiveKeeperAddress, upkeep, ex.orm.config, gasPrice, gasTipCap, gasFeeCap, evmChainID))
-
 	// DotDagSource in database is empty because all the Keeper pipeline runs make use of the same observation source
 	ex.job.PipelineSpec.DotDagSource = pipeline.KeepersObservationSource
 	run := pipeline.NewRun(*ex.job.PipelineSpec, vars)
@@ -266,6 +244,48 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head
 	}
 }
 
+// runVars returns the vars of the pipeline run which checks and performs
+// upkeep at head.
+func (ex *UpkeepExecuter) runVars(upkeep UpkeepRegistration, head *evmtypes.Head) (pipeline.Vars, error) {
+	evmChainID := ""
+	if ex.job.KeeperSpec.EVMChainID != nil {
+		evmChainID = ex.job.KeeperSpec.EVMChainID.String()
+	}
+
+	gasPrice, gasTipCap, gasFeeCap, err := ex.checkUpkeepGasPrice(upkeep, head)
+	if err != nil {
+		return pipeline.Vars{}, err
+	}
+
+	// effectiveKeeperAddress is always fromAddress when forwarding is not enabled.
+	// when forwarding is enabled, effectiveKeeperAddress is on-chain forwarder.
+	return pipeline.NewVarsFrom(buildJobSpec(ex.job, ex.effectiveKeeperAddress, upkeep, ex.orm.config, gasPrice, gasTipCap, gasFeeCap, evmChainID)), nil
+}
+
+// checkUpkeepGasPrice returns the gas price of the checkUpkeep call, which is
+// only set if KeeperCheckUpkeepGasPriceFeatureEnabled.
+func (ex *UpkeepExecuter) checkUpkeepGasPrice(upkeep UpkeepRegistration, head *evmtypes.Head) (gasPrice, gasTipCap, gasFeeCap *big.Int, err error) {
+	if !ex.config.KeeperCheckUpkeepGasPriceFeatureEnabled() {
+		return nil, nil, nil, nil
+	}
+	price, fee, err := ex.estimateGasPrice(upkeep)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	gasPrice, gasTipCap, gasFeeCap = price, fee.TipCap, fee.FeeCap
+
+	// Make sure the gas price is at least as large as the basefee to avoid ErrFeeCapTooLow error from geth during eth call.
+	// If head.BaseFeePerGas, we assume it is a EIP-1559 chain.
+	// Note: gasPrice will be nil if EvmEIP1559DynamicFees is enabled.
+	if head.BaseFeePerGas != nil && head.BaseFeePerGas.ToInt().BitLen() > 0 {
+		baseFee := addBuffer(head.BaseFeePerGas.ToInt(), ex.config.KeeperBaseFeeBufferPercent())
+		if gasPrice == nil || gasPrice.Cmp(baseFee) < 0 {
+			gasPrice = baseFee
+		}
+	}
+	return gasPrice, gasTipCap, gasFeeCap, nil
+}
+
 func (ex *UpkeepExecuter) estimateGasPrice(upkeep UpkeepRegistration) (gasPrice *big.Int, fee gas.DynamicFee, err error) {
 	var performTxData []byte
 	performTxData, err = Registry1_1ABI.Pack(
@@ -300,8 +320,7 @@ func addBuffer(val *big.Int, prct uint32) *big.Int {
 }
 
 func (ex *UpkeepExecuter) turnBlockHashBinary(registry Registry, head *evmtypes.Head, lookback int64) (string, error) {
-	turnBlock := head.Number - (head.Number % int64(registry.BlockCountPerTurn)) - lookback
-	block, err := ex.ethClient.HeaderByNumber(context.Background(), big.NewInt(turnBlock))
+	block, err := ex.ethClient.HeaderByNumber(context.Background(), big.NewInt(turnBlockNumber(registry, head, lookback)))
 	if err != nil {
 		return "", err
 	}
@@ -310,6 +329,12 @@ func (ex *UpkeepExecuter) turnBlockHashBinary(registry Registry, head *evmtypes.
 	return binaryString, nil
 }
 
+// turnBlockNumber returns the block whose hash decides whose turn it is at
+// head.
+func turnBlockNumber(registry Registry, head *evmtypes.Head, lookback int64) int64 {
+	return head.Number - (head.Number % int64(registry.BlockCountPerTurn)) - lookback
+}
+
 func buildJobSpec(
 	jb job.Job,
 	effectiveKeeperAddress common.Address,
@@ -402,3 +402,57 @@ func Test_UpkeepExecuter_PerformsUpkeep_Error(t *testing.T) {
 	g.Eventually(wasCalled.Load).Should(gomega.Equal(true))
 	cltest.AssertCountStays(t, db, "eth_txes", 0)
 }
+
+func Test_UpkeepExecuter_Report(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reports performable upkeep without performing it", func(t *testing.T) {
+		db, _, ethMock, executer, registry, upkeep, _, _, _, _, _, _ := setup(t, mockEstimator(t))
+
+		registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.Registry1_1ABI, registry.ContractAddress.Address())
+		registryMock.MockResponse("checkUpkeep", checkUpkeepResponse)
+		registryMock.MockResponse("performUpkeep", checkPerformResponse)
+
+		head := newHead()
+		report, err := executer.Report(testutils.Context(t), upkeep.UpkeepID.ToInt(), &head)
+		require.NoError(t, err)
+
+		assert.Equal(t, registry.ContractAddress, report.RegistryAddress)
+		assert.Equal(t, upkeep.PrettyID(), report.UpkeepID)
+		assert.Equal(t, int64(20), report.HeadNumber)
+		assert.Equal(t, int32(1), report.NumKeepers)
+		assert.True(t, report.TurnFlagEnabled)
+		assert.Equal(t, int32(0), report.TurnKeeperIndex)
+		assert.True(t, report.Eligible)
+		assert.NotNil(t, report.GasPrice)
+		assert.True(t, report.Performable)
+		assert.Empty(t, report.Error)
+		assert.Equal(t, checkUpkeepResponse.PerformData, report.PerformData)
+
+		cltest.AssertCount(t, db, "pipeline_runs", 0)
+		assertLastRunHeight(t, db, upkeep, 0, 0)
+	})
+
+	t.Run("reports reason if checkUpkeep reverts", func(t *testing.T) {
+		_, _, ethMock, executer, registry, upkeep, _, _, _, _, _, _ := setup(t, mockEstimator(t))
+
+		registryMock := cltest.NewContractMockReceiver(t, ethMock, keeper.Registry1_1ABI, registry.ContractAddress.Address())
+		registryMock.MockRevertResponse("checkUpkeep")
+
+		head := newHead()
+		report, err := executer.Report(testutils.Context(t), upkeep.UpkeepID.ToInt(), &head)
+		require.NoError(t, err)
+
+		assert.True(t, report.Eligible)
+		assert.False(t, report.Performable)
+		assert.Contains(t, report.Error, "check_upkeep_tx")
+	})
+
+	t.Run("errors on unknown upkeep", func(t *testing.T) {
+		_, _, _, executer, _, _, _, _, _, _, _, _ := setup(t, mockEstimator(t))
+
+		head := newHead()
+		_, err := executer.Report(testutils.Context(t), big.NewInt(1000), &head)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// UpkeepReport explains whether this node would perform an upkeep at a head.
+// It is produced by following the same steps as the UpkeepExecuter, without
+// submitting anything.
+type UpkeepReport struct {
+	JobID              int32
+	RegistryAddress    ethkey.EIP55Address
+	UpkeepID           string
+	HeadNumber         int64
+	KeeperIndex        int32
+	NumKeepers         int32
+	BlockCountPerTurn  int32
+	LastRunBlockHeight int64
+	LastKeeperIndex    null.Int64
+
+	// TurnFlagEnabled selects the turn taking algorithm, see
+	// KeeperTurnFlagEnabled. TurnBlockNumber and TurnBinary, the binary hash
+	// of that block, are only set if it is enabled.
+	TurnFlagEnabled bool
+	TurnBlockNumber int64
+	TurnBinary      string
+	// TurnKeeperIndex is the index of the keeper whose turn it is.
+	TurnKeeperIndex int32
+	// Eligible is true if this node checks the upkeep at the head.
+	Eligible bool
+
+	// The gas price of the checkUpkeep call is only set if
+	// KeeperCheckUpkeepGasPriceFeatureEnabled.
+	GasPrice  *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+
+	// Performable is true if checkUpkeep succeeded and performUpkeep would be
+	// sent. Otherwise, Error holds the reason.
+	Performable bool
+	PerformData []byte
+	Error       string
+}
+
+// Report checks an upkeep at head like execute does, but never sends the
+// performUpkeep transaction or records the run. The upkeep is checked even if
+// it is not this node's turn.
+func (ex *UpkeepExecuter) Report(ctx context.Context, upkeepID *big.Int, head *evmtypes.Head) (report UpkeepReport, err error) {
+	registry, err := ex.orm.RegistryByContractAddress(ex.job.KeeperSpec.ContractAddress)
+	if err != nil {
+		return report, errors.Wrap(err, "unable to load registry")
+	}
+	upkeep, err := ex.orm.UpkeepByID(registry.ID, utils.NewBig(upkeepID))
+	if err != nil {
+		return report, err
+	}
+	upkeep.Registry = registry
+
+	report = UpkeepReport{
+		JobID:              ex.job.ID,
+		RegistryAddress:    registry.ContractAddress,
+		UpkeepID:           upkeep.PrettyID(),
+		HeadNumber:         head.Number,
+		KeeperIndex:        registry.KeeperIndex,
+		NumKeepers:         registry.NumKeepers,
+		BlockCountPerTurn:  registry.BlockCountPerTurn,
+		LastRunBlockHeight: upkeep.LastRunBlockHeight,
+		LastKeeperIndex:    upkeep.LastKeeperIndex,
+		TurnFlagEnabled:    ex.config.KeeperTurnFlagEnabled(),
+	}
+
+	if registry.NumKeepers > 0 && registry.BlockCountPerTurn > 0 {
+		if report.TurnFlagEnabled {
+			report.TurnBlockNumber = turnBlockNumber(registry, head, ex.config.KeeperTurnLookBack())
+			report.TurnBinary, err = ex.turnBlockHashBinary(registry, head, ex.config.KeeperTurnLookBack())
+			if err != nil {
+				return report, errors.Wrap(err, "unable to get turn block number hash")
+			}
+			turn, err2 := upkeepTurn(upkeepID, report.TurnBinary)
+			if err2 != nil {
+				return report, err2
+			}
+			report.TurnKeeperIndex = int32(turn % uint64(registry.NumKeepers))
+		} else {
+			turnStart := head.Number - (head.Number % int64(registry.BlockCountPerTurn))
+			report.TurnKeeperIndex = int32((int64(upkeep.PositioningConstant) + turnStart/int64(registry.BlockCountPerTurn)) % int64(registry.NumKeepers))
+		}
+	}
+
+	eligible, err := ex.eligibleUpkeeps(registry, head)
+	if err != nil {
+		return report, err
+	}
+	for _, u := range eligible {
+		if u.UpkeepID.Cmp(upkeep.UpkeepID) == 0 {
+			report.Eligible = true
+			break
+		}
+	}
+
+	report.GasPrice, report.GasTipCap, report.GasFeeCap, err = ex.checkUpkeepGasPrice(upkeep, head)
+	if err != nil {
+		return report, errors.Wrap(err, "estimating gas price")
+	}
+	vars, err := ex.runVars(upkeep, head)
+	if err != nil {
+		return report, errors.Wrap(err, "estimating gas price")
+	}
+
+	spec := *ex.job.PipelineSpec
+	spec.DotDagSource = pipeline.KeepersObservationSource
+	run, trrs, err := ex.pr.SimulateRun(ctx, spec, vars, map[string]interface{}{}, ex.logger)
+	if err != nil {
+		return report, errors.Wrap(err, "failed checking upkeep")
+	}
+
+	// Report the first task which failed, in the order they run
+	sort.Slice(trrs, func(i, j int) bool { return trrs[i].Task.ID() < trrs[j].Task.ID() })
+	for _, trr := range trrs {
+		if trr.Result.Error != nil {
+			report.Error = fmt.Sprintf("%s: %v", trr.Task.DotID(), trr.Result.Error)
+			break
+		}
+	}
+	for _, trr := range trrs {
+		if trr.Task.DotID() != "decode_check_upkeep_tx" {
+			continue
+		}
+		if decoded, ok := trr.Result.Value.(map[string]interface{}); ok {
+			report.PerformData, _ = decoded["performData"].([]byte)
+		}
+	}
+	report.Performable = report.Error == "" && !run.HasErrors()
+	return report, nil
+}
+
+// upkeepTurn returns the turn of an upkeep, which decides the keeper that
+// checks it, as computed by ORM.NewEligibleUpkeepsForRegistry.
+func upkeepTurn(upkeepID *big.Int, turnBinary string) (uint64, error) {
+	hash, ok := new(big.Int).SetString(turnBinary, 2)
+	if !ok {
+		return 0, errors.Errorf("invalid turn binary %s", turnBinary)
+	}
+	return LeastSignificant32(upkeepID) ^ LeastSignificant32(hash), nil
+}
@@ -1,6 +1,7 @@
 package keystore
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"reflect"
@@ -13,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/solkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/terrakey"
 
+	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/sqlx"
@@ -50,6 +52,11 @@ type Master interface {
 	Unlock(password string) error
 	Migrate(vrfPassword string, f DefaultEVMChainIDFunc) error
 	IsEmpty() (bool, error)
+	// Encrypt encrypts data with the keystore password, for secrets which
+	// are stored outside of the key ring.
+	Encrypt(data []byte) ([]byte, error)
+	// Decrypt decrypts data returned by Encrypt.
+	Decrypt(data []byte) ([]byte, error)
 }
 
 type master struct {
@@ -276,6 +283,34 @@ func (km *keyManager) Unlock(password string) error {
 	return nil
 }
 
+func (km *keyManager) Encrypt(data []byte) ([]byte, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	if km.isLocked() {
+		return nil, ErrLocked
+	}
+	cryptoJSON, err := gethkeystore.EncryptDataV3(data, []byte(adulteratedPassword(km.password)), km.scryptParams.N, km.scryptParams.P)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encrypt data")
+	}
+	encrypted, err := json.Marshal(&cryptoJSON)
+	return encrypted, errors.Wrap(err, "could not encode cryptoJSON")
+}
+
+func (km *keyManager) Decrypt(data []byte) ([]byte, error) {
+	km.lock.RLock()
+	defer km.lock.RUnlock()
+	if km.isLocked() {
+		return nil, ErrLocked
+	}
+	var cryptoJSON gethkeystore.CryptoJSON
+	if err := json.Unmarshal(data, &cryptoJSON); err != nil {
+		return nil, errors.Wrap(err, "could not decode cryptoJSON")
+	}
+	decrypted, err := gethkeystore.DecryptDataV3(cryptoJSON, adulteratedPassword(km.password))
+	return decrypted, errors.Wrap(err, "could not decrypt data")
+}
+
 // caller must hold lock!
 func (km *keyManager) save(callbacks ...func(pg.Queryer) error) error {
 	ekb, err := km.keyRing.Encrypt(km.password, km.scryptParams)
@@ -60,3 +60,30 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 		require.NoError(t, keyStore.Unlock(cltest.Password))
 	})
 }
+
+func TestMasterKeystore_Encrypt_Decrypt(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db, cfg)
+
+	_, err := keyStore.Encrypt([]byte("secret"))
+	require.ErrorIs(t, err, keystore.ErrLocked)
+	_, err = keyStore.Decrypt([]byte("{}"))
+	require.ErrorIs(t, err, keystore.ErrLocked)
+
+	require.NoError(t, keyStore.Unlock(cltest.Password))
+
+	encrypted, err := keyStore.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+	require.NotContains(t, string(encrypted), "secret")
+
+	decrypted, err := keyStore.Decrypt(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, "secret", string(decrypted))
+
+	_, err = keyStore.Decrypt([]byte("not encrypted"))
+	require.Error(t, err)
+}
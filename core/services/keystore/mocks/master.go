@@ -60,6 +60,52 @@ func (_m *Master) DKGSign() keystore.DKGSign {
 	return r0
 }
 
+// Decrypt provides a mock function with given fields: data
+func (_m *Master) Decrypt(data []byte) ([]byte, error) {
+	ret := _m.Called(data)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func([]byte) []byte); ok {
+		r0 = rf(data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Encrypt provides a mock function with given fields: data
+func (_m *Master) Encrypt(data []byte) ([]byte, error) {
+	ret := _m.Called(data)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func([]byte) []byte); ok {
+		r0 = rf(data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Eth provides a mock function with given fields:
 func (_m *Master) Eth() keystore.Eth {
 	ret := _m.Called()
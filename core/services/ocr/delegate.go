@@ -205,7 +205,7 @@ func (d Delegate) ServicesForSpec(jb job.Job) (services []job.ServiceCtx, err er
 			return nil, errors.Wrap(err, "could not get contract ABI JSON")
 		}
 
-		strategy := txmgr.NewQueueingTxStrategy(jb.ExternalJobID, chain.Config().OCRDefaultTransactionQueueDepth())
+		strategy := txmgr.NewPriorityStrategy(txmgr.NewQueueingTxStrategy(jb.ExternalJobID, chain.Config().OCRDefaultTransactionQueueDepth()), txmgr.TxPriorityHigh)
 
 		var checker txmgr.TransmitCheckerSpec
 		if chain.Config().OCRSimulateTransactions() {
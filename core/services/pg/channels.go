@@ -4,4 +4,7 @@ package pg
 const (
 	ChannelInsertOnEthTx    = "insert_on_eth_txes"
 	ChannelInsertOnTerraMsg = "insert_on_terra_msg"
+	// ChannelEthTxStateChanged is notified with the id of eth_txes which were
+	// inserted or changed state.
+	ChannelEthTxStateChanged = "eth_tx_state_changed"
 )
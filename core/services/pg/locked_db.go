@@ -14,6 +14,9 @@ import (
 // LockedDB bounds DB connection and DB locks.
 type LockedDB interface {
 	Open(ctx context.Context) error
+	// AwaitLease blocks until the lease is taken, or ctx is cancelled. It only
+	// blocks for warm standby nodes, as Open takes the lease otherwise.
+	AwaitLease(ctx context.Context) error
 	Close() error
 	DB() *sqlx.DB
 }
@@ -24,6 +27,8 @@ type lockedDb struct {
 	db           *sqlx.DB
 	leaseLock    LeaseLock
 	advisoryLock AdvisoryLock
+	// awaitingLease is set when Open left the lease to be taken by AwaitLease
+	awaitingLease bool
 }
 
 // NewLockedDB creates a new instance of LockedDB.
@@ -72,6 +77,11 @@ func (l *lockedDb) Open(ctx context.Context) (err error) {
 	switch lockingMode {
 	case "lease", "dual":
 		l.leaseLock = NewLeaseLock(l.db, l.cfg.AppID(), l.lggr, l.cfg.LeaseLockRefreshInterval(), l.cfg.LeaseLockDuration())
+		if lockingMode == "lease" && l.cfg.LeaseLockWarmStandby() {
+			l.lggr.Info("Running as a warm standby, the lease will be taken once it is released by the active node")
+			l.awaitingLease = true
+			break
+		}
 		if err = l.leaseLock.TakeAndHold(ctx); err != nil {
 			defer revert()
 			return errors.Wrap(err, "failed to take initial lease on database")
@@ -91,6 +101,21 @@ func (l *lockedDb) Open(ctx context.Context) (err error) {
 	return
 }
 
+// AwaitLease takes the lease which Open left to be taken for warm standby
+// nodes. It blocks until the lease is taken or ctx is cancelled, and returns
+// immediately if Open already took the locks.
+// NOT THREAD SAFE
+func (l *lockedDb) AwaitLease(ctx context.Context) error {
+	if !l.awaitingLease {
+		return nil
+	}
+	if err := l.leaseLock.TakeAndHold(ctx); err != nil {
+		return errors.Wrap(err, "failed to take lease on database")
+	}
+	l.awaitingLease = false
+	return nil
+}
+
 // Close function releases DB locks (if acquired by Open) and closes DB connection.
 // Closing of a closed LockedDB instance has no effect.
 // NOT THREAD SAFE
@@ -99,6 +124,7 @@ func (l *lockedDb) Close() error {
 		l.db = nil
 		l.advisoryLock = nil
 		l.leaseLock = nil
+		l.awaitingLease = false
 	}()
 
 	// Step 1: release DB locks
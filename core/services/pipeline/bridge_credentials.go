@@ -0,0 +1,179 @@
+package pipeline
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+)
+
+const (
+	// BridgeTimestampHeader holds the unix time at which a signed bridge
+	// request was made.
+	BridgeTimestampHeader = "X-Chainlink-Timestamp"
+	// BridgeSignatureHeader holds the hex encoded HMAC-SHA256 of
+	// "<timestamp>.<body>", keyed with the bridge's signing secret.
+	BridgeSignatureHeader = "X-Chainlink-Signature"
+)
+
+// BridgeKeyStore decrypts bridge credentials.
+type BridgeKeyStore interface {
+	Decrypt(data []byte) ([]byte, error)
+}
+
+// bridgeCredentials holds the decrypted credentials of all bridges.
+// Decrypting is slow, so they are kept in memory and shared by all runs.
+type bridgeCredentials struct {
+	keyStore   BridgeKeyStore
+	httpClient *http.Client
+
+	mu    sync.Mutex
+	creds map[bridges.BridgeName]*bridgeCredential
+}
+
+func newBridgeCredentials(keyStore BridgeKeyStore, httpClient *http.Client) *bridgeCredentials {
+	return &bridgeCredentials{
+		keyStore:   keyStore,
+		httpClient: httpClient,
+		creds:      make(map[bridges.BridgeName]*bridgeCredential),
+	}
+}
+
+// bridgeCredential holds the decrypted credentials of a single bridge. A nil
+// *bridgeCredential neither signs requests nor presents a certificate.
+type bridgeCredential struct {
+	fingerprint   [sha256.Size]byte
+	signingSecret []byte
+	client        *http.Client
+}
+
+// get returns the credentials for bt, or nil if bt has none. The credentials
+// are decrypted again when bt's credentials change.
+func (c *bridgeCredentials) get(bt bridges.BridgeType) (*bridgeCredential, error) {
+	if !bt.SignsRequests() && !bt.ClientCertificate.Valid {
+		if c != nil {
+			c.mu.Lock()
+			delete(c.creds, bt.Name)
+			c.mu.Unlock()
+		}
+		return nil, nil
+	}
+	if c == nil || c.keyStore == nil {
+		return nil, errors.Errorf("bridge %s has credentials, but no keystore is available to decrypt them", bt.Name)
+	}
+
+	fingerprint := credentialFingerprint(bt)
+	c.mu.Lock()
+	cred, ok := c.creds[bt.Name]
+	c.mu.Unlock()
+	if ok && cred.fingerprint == fingerprint {
+		return cred, nil
+	}
+
+	cred, err := c.decrypt(bt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load credentials for bridge %s", bt.Name)
+	}
+	cred.fingerprint = fingerprint
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.creds[bt.Name] = cred
+	return cred, nil
+}
+
+func (c *bridgeCredentials) decrypt(bt bridges.BridgeType) (*bridgeCredential, error) {
+	cred := &bridgeCredential{}
+	if bt.SignsRequests() {
+		secret, err := c.keyStore.Decrypt(bt.EncryptedSigningSecret)
+		if err != nil {
+			return nil, errors.Wrap(err, "signing secret")
+		}
+		cred.signingSecret = secret
+	}
+	if bt.ClientCertificate.Valid {
+		key, err := c.keyStore.Decrypt(bt.EncryptedClientKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "client key")
+		}
+		cert, err := tls.X509KeyPair([]byte(bt.ClientCertificate.String), key)
+		if err != nil {
+			return nil, errors.Wrap(err, "client certificate")
+		}
+		cred.client = clientWithCertificate(c.httpClient, cert)
+	}
+	return cred, nil
+}
+
+func credentialFingerprint(bt bridges.BridgeType) [sha256.Size]byte {
+	h := sha256.New()
+	for _, b := range [][]byte{bt.EncryptedSigningSecret, []byte(bt.ClientCertificate.String), bt.EncryptedClientKey} {
+		h.Write([]byte(strconv.Itoa(len(b))))
+		h.Write([]byte{':'})
+		h.Write(b)
+	}
+	var fingerprint [sha256.Size]byte
+	copy(fingerprint[:], h.Sum(nil))
+	return fingerprint
+}
+
+// clientWithCertificate returns a copy of base which presents cert to
+// servers that request a client certificate.
+func clientWithCertificate(base *http.Client, cert tls.Certificate) *http.Client {
+	client := &http.Client{}
+	if base != nil {
+		*client = *base
+	}
+	var tr *http.Transport
+	if baseTr, ok := client.Transport.(*http.Transport); ok {
+		tr = baseTr.Clone()
+	} else {
+		tr = http.DefaultTransport.(*http.Transport).Clone()
+	}
+	if tr.TLSClientConfig == nil {
+		tr.TLSClientConfig = &tls.Config{} //nolint:gosec
+	}
+	tr.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	client.Transport = tr
+	return client
+}
+
+// httpClient returns the client to use for requests to the bridge.
+func (c *bridgeCredential) httpClient(defaultClient *http.Client) *http.Client {
+	if c == nil || c.client == nil {
+		return defaultClient
+	}
+	return c.client
+}
+
+// sign returns the headers that authenticate body, if the bridge signs its
+// requests.
+func (c *bridgeCredential) sign(body []byte, now time.Time) []string {
+	if c == nil || len(c.signingSecret) == 0 {
+		return []string{}
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	return []string{
+		BridgeTimestampHeader, timestamp,
+		BridgeSignatureHeader, SignBridgeRequest(c.signingSecret, timestamp, body),
+	}
+}
+
+// SignBridgeRequest returns the signature of a bridge request, as sent in
+// the X-Chainlink-Signature header. External adapters can use it to verify
+// requests.
+func SignBridgeRequest(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,152 @@
+package pipeline
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+)
+
+// fakeBridgeKeyStore "encrypts" by prefixing the data.
+type fakeBridgeKeyStore struct {
+	decrypts atomic.Int32
+}
+
+func (ks *fakeBridgeKeyStore) Decrypt(data []byte) ([]byte, error) {
+	ks.decrypts.Inc()
+	if !strings.HasPrefix(string(data), "enc:") {
+		return nil, errors.New("not encrypted")
+	}
+	return data[len("enc:"):], nil
+}
+
+func TestBridgeCredentials_Get(t *testing.T) {
+	t.Parallel()
+
+	ks := &fakeBridgeKeyStore{}
+	creds := newBridgeCredentials(ks, http.DefaultClient)
+	bt := bridges.BridgeType{Name: "foo"}
+
+	cred, err := creds.get(bt)
+	require.NoError(t, err)
+	assert.Nil(t, cred)
+	assert.Empty(t, cred.sign([]byte("body"), time.Now()))
+	assert.Same(t, http.DefaultClient, cred.httpClient(http.DefaultClient))
+
+	bt.EncryptedSigningSecret = []byte("enc:secret")
+	cred, err = creds.get(bt)
+	require.NoError(t, err)
+	require.NotNil(t, cred)
+	assert.Equal(t, "secret", string(cred.signingSecret))
+
+	// Credentials are only decrypted once.
+	again, err := creds.get(bt)
+	require.NoError(t, err)
+	assert.Same(t, cred, again)
+	assert.Equal(t, int32(1), ks.decrypts.Load())
+
+	bt.EncryptedSigningSecret = []byte("enc:other")
+	cred, err = creds.get(bt)
+	require.NoError(t, err)
+	assert.Equal(t, "other", string(cred.signingSecret))
+	assert.Equal(t, int32(2), ks.decrypts.Load())
+
+	bt.EncryptedSigningSecret = []byte("garbage")
+	_, err = creds.get(bt)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "signing secret")
+
+	certPEM, keyPEM := testutils.MustNewCertificate(t)
+	bt.EncryptedSigningSecret = nil
+	bt.ClientCertificate = null.StringFrom(certPEM)
+	bt.EncryptedClientKey = []byte("enc:" + keyPEM)
+	cred, err = creds.get(bt)
+	require.NoError(t, err)
+	assert.Empty(t, cred.sign([]byte("body"), time.Now()))
+	client := cred.httpClient(http.DefaultClient)
+	assert.NotSame(t, http.DefaultClient, client)
+	require.Len(t, client.Transport.(*http.Transport).TLSClientConfig.Certificates, 1)
+
+	bt.ClientCertificate = null.StringFrom("invalid")
+	_, err = creds.get(bt)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "client certificate")
+
+	bt.ClientCertificate = null.String{}
+	bt.EncryptedClientKey = nil
+	cred, err = creds.get(bt)
+	require.NoError(t, err)
+	assert.Nil(t, cred)
+	assert.Empty(t, creds.creds)
+
+	t.Run("without a keystore", func(t *testing.T) {
+		bt := bridges.BridgeType{Name: "foo", EncryptedSigningSecret: []byte("enc:secret")}
+		_, err := newBridgeCredentials(nil, nil).get(bt)
+		require.Error(t, err)
+
+		var nilCreds *bridgeCredentials
+		_, err = nilCreds.get(bt)
+		require.Error(t, err)
+	})
+}
+
+func TestBridgeCredential_Sign(t *testing.T) {
+	t.Parallel()
+
+	cred := &bridgeCredential{signingSecret: []byte("secret")}
+	headers := cred.sign([]byte(`{"data":{}}`), time.Unix(1600000000, 0))
+	require.Equal(t, []string{
+		BridgeTimestampHeader, "1600000000",
+		BridgeSignatureHeader, SignBridgeRequest([]byte("secret"), "1600000000", []byte(`{"data":{}}`)),
+	}, headers)
+
+	// The signature covers the timestamp, the body and the secret.
+	sig := SignBridgeRequest([]byte("secret"), "1600000000", []byte(`{"data":{}}`))
+	assert.Len(t, sig, 64)
+	assert.NotEqual(t, sig, SignBridgeRequest([]byte("secret"), "1600000001", []byte(`{"data":{}}`)))
+	assert.NotEqual(t, sig, SignBridgeRequest([]byte("secret"), "1600000000", []byte(`{"data":{"a":1}}`)))
+	assert.NotEqual(t, sig, SignBridgeRequest([]byte("other"), "1600000000", []byte(`{"data":{}}`)))
+}
+
+func TestBridgeCredential_ClientCertificate(t *testing.T) {
+	t.Parallel()
+
+	certPEM, keyPEM := testutils.MustNewCertificate(t)
+	cert, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+	require.NoError(t, err)
+	clientCA := x509.NewCertPool()
+	require.True(t, clientCA.AppendCertsFromPEM([]byte(certPEM)))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCA} //nolint:gosec
+	server.StartTLS()
+	defer server.Close()
+
+	base := server.Client()
+
+	// Without a certificate, the handshake fails.
+	_, err = base.Get(server.URL) //nolint:noctx
+	require.Error(t, err)
+
+	resp, err := clientWithCertificate(base, cert).Get(server.URL) //nolint:noctx
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// The base client is left untouched.
+	assert.Empty(t, base.Transport.(*http.Transport).TLSClientConfig.Certificates)
+}
@@ -0,0 +1,208 @@
+package pipeline
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+)
+
+// maxBridgeCacheEntries limits the number of responses cached per bridge.
+const maxBridgeCacheEntries = 1000
+
+var ErrBridgeCircuitOpen = errors.New("bridge circuit breaker is open")
+
+var (
+	promBridgeCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_bridge_cache_hits",
+		Help: "The number of bridge requests served from the response cache",
+	}, []string{"bridge"})
+	promBridgeCircuitOpen = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_bridge_circuit_open",
+		Help: "The number of bridge requests rejected because the bridge's circuit breaker was open",
+	}, []string{"bridge"})
+)
+
+// bridgeGuards holds the rate limiters, circuit breakers and response caches
+// of all bridges. They are kept in memory and shared by all runs.
+type bridgeGuards struct {
+	mu     sync.Mutex
+	guards map[bridges.BridgeName]*bridgeGuard
+}
+
+func newBridgeGuards() *bridgeGuards {
+	return &bridgeGuards{guards: make(map[bridges.BridgeName]*bridgeGuard)}
+}
+
+// get returns the guard for bt, or nil if bt has no limits. The guard is
+// reset when bt's limits change.
+func (g *bridgeGuards) get(bt bridges.BridgeType) *bridgeGuard {
+	if g == nil {
+		return nil
+	}
+	cfg := bridgeGuardConfig{
+		rateLimit: bt.RateLimit,
+		burst:     bt.RateLimitBurst,
+		threshold: bt.CircuitBreakerThreshold,
+		cooldown:  bt.CircuitBreakerCooldown.Duration(),
+		ttl:       bt.CacheTTL.Duration(),
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if cfg == (bridgeGuardConfig{}) {
+		delete(g.guards, bt.Name)
+		return nil
+	}
+	guard, ok := g.guards[bt.Name]
+	if !ok || guard.cfg != cfg {
+		guard = newBridgeGuard(bt.Name, cfg)
+		g.guards[bt.Name] = guard
+	}
+	return guard
+}
+
+// evict drops the guard of the named bridge, along with its cached responses.
+func (g *bridgeGuards) evict(name bridges.BridgeName) {
+	if g == nil {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.guards, name)
+}
+
+type bridgeGuardConfig struct {
+	rateLimit float64
+	burst     uint32
+	threshold uint32
+	cooldown  time.Duration
+	ttl       time.Duration
+}
+
+type bridgeCacheEntry struct {
+	value   string
+	expires time.Time
+}
+
+// bridgeGuard limits and caches the requests to a single bridge. A nil
+// *bridgeGuard allows all requests and caches nothing.
+type bridgeGuard struct {
+	name    bridges.BridgeName
+	cfg     bridgeGuardConfig
+	limiter *rate.Limiter
+
+	mu        sync.Mutex
+	failures  uint32
+	openUntil time.Time
+	probing   bool
+	cache     map[string]bridgeCacheEntry
+}
+
+func newBridgeGuard(name bridges.BridgeName, cfg bridgeGuardConfig) *bridgeGuard {
+	g := &bridgeGuard{name: name, cfg: cfg, cache: make(map[string]bridgeCacheEntry)}
+	if cfg.rateLimit > 0 {
+		burst := int(cfg.burst)
+		if burst == 0 {
+			burst = int(math.Max(1, math.Ceil(cfg.rateLimit)))
+		}
+		g.limiter = rate.NewLimiter(rate.Limit(cfg.rateLimit), burst)
+	}
+	return g
+}
+
+// caching returns true if responses should be cached.
+func (g *bridgeGuard) caching() bool {
+	return g != nil && g.cfg.ttl > 0
+}
+
+// cached returns the cached response for key, if it has not expired yet.
+func (g *bridgeGuard) cached(key string) (string, bool) {
+	if !g.caching() {
+		return "", false
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	entry, ok := g.cache[key]
+	if !ok || time.Now().After(entry.expires) {
+		return "", false
+	}
+	promBridgeCacheHits.WithLabelValues(g.name.String()).Inc()
+	return entry.value, true
+}
+
+// store caches value for key.
+func (g *bridgeGuard) store(key string, value string) {
+	if !g.caching() {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	now := time.Now()
+	if len(g.cache) >= maxBridgeCacheEntries {
+		for k, entry := range g.cache {
+			if now.After(entry.expires) {
+				delete(g.cache, k)
+			}
+		}
+		if len(g.cache) >= maxBridgeCacheEntries {
+			return
+		}
+	}
+	g.cache[key] = bridgeCacheEntry{value: value, expires: now.Add(g.cfg.ttl)}
+}
+
+// wait blocks until the rate limit allows another request, or returns an
+// error if ctx would expire first.
+func (g *bridgeGuard) wait(ctx context.Context) error {
+	if g == nil || g.limiter == nil {
+		return nil
+	}
+	return errors.Wrap(g.limiter.Wait(ctx), "bridge rate limit")
+}
+
+// allow returns ErrBridgeCircuitOpen if the circuit breaker is open. Once the
+// cooldown has passed, a single request is let through to probe the bridge.
+// Every allowed request must be followed by a call to record.
+func (g *bridgeGuard) allow() error {
+	if g == nil || g.cfg.threshold == 0 {
+		return nil
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.failures < g.cfg.threshold {
+		return nil
+	}
+	if g.probing || time.Now().Before(g.openUntil) {
+		promBridgeCircuitOpen.WithLabelValues(g.name.String()).Inc()
+		return errors.Wrapf(ErrBridgeCircuitOpen, "%d consecutive failures", g.failures)
+	}
+	g.probing = true
+	return nil
+}
+
+// record records the outcome of a request. The circuit breaker opens after
+// threshold consecutive failures.
+func (g *bridgeGuard) record(failed bool) {
+	if g == nil || g.cfg.threshold == 0 {
+		return
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.probing = false
+	if !failed {
+		g.failures = 0
+		return
+	}
+	g.failures++
+	if g.failures >= g.cfg.threshold {
+		g.openUntil = time.Now().Add(g.cfg.cooldown)
+	}
+}
@@ -0,0 +1,154 @@
+package pipeline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func TestBridgeGuards_Get(t *testing.T) {
+	t.Parallel()
+
+	guards := newBridgeGuards()
+	bt := bridges.BridgeType{Name: "foo"}
+
+	assert.Nil(t, guards.get(bt))
+
+	bt.RateLimit = 1
+	guard := guards.get(bt)
+	require.NotNil(t, guard)
+	assert.Same(t, guard, guards.get(bt))
+
+	bt.CacheTTL = models.Interval(time.Second)
+	updated := guards.get(bt)
+	require.NotNil(t, updated)
+	assert.NotSame(t, guard, updated)
+
+	bt.RateLimit = 0
+	bt.CacheTTL = 0
+	assert.Nil(t, guards.get(bt))
+	assert.Empty(t, guards.guards)
+
+	var nilGuards *bridgeGuards
+	assert.Nil(t, nilGuards.get(bt))
+}
+
+func TestBridgeGuards_Evict(t *testing.T) {
+	t.Parallel()
+
+	guards := newBridgeGuards()
+	bt := bridges.BridgeType{Name: "foo", CacheTTL: models.Interval(time.Minute)}
+
+	guard := guards.get(bt)
+	guard.store("key", "value")
+	_, ok := guard.cached("key")
+	require.True(t, ok)
+
+	guards.evict(bt.Name)
+	assert.Empty(t, guards.guards)
+	_, ok = guards.get(bt).cached("key")
+	assert.False(t, ok)
+
+	var nilGuards *bridgeGuards
+	nilGuards.evict(bt.Name)
+}
+
+func TestBridgeGuard_Nil(t *testing.T) {
+	t.Parallel()
+
+	var guard *bridgeGuard
+	assert.False(t, guard.caching())
+	_, ok := guard.cached("foo")
+	assert.False(t, ok)
+	guard.store("foo", "bar")
+	assert.NoError(t, guard.wait(testutils.Context(t)))
+	assert.NoError(t, guard.allow())
+	guard.record(true)
+}
+
+func TestBridgeGuard_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	guard := newBridgeGuard("foo", bridgeGuardConfig{rateLimit: 1, burst: 2})
+
+	ctx, cancel := context.WithTimeout(testutils.Context(t), 100*time.Millisecond)
+	defer cancel()
+	require.NoError(t, guard.wait(ctx))
+	require.NoError(t, guard.wait(ctx))
+	// The next token is only available after a second.
+	require.Error(t, guard.wait(ctx))
+}
+
+func TestBridgeGuard_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	guard := newBridgeGuard("foo", bridgeGuardConfig{threshold: 2, cooldown: 100 * time.Millisecond})
+
+	require.NoError(t, guard.allow())
+	guard.record(true)
+	require.NoError(t, guard.allow())
+	guard.record(false)
+
+	// Only consecutive failures trip the breaker.
+	require.NoError(t, guard.allow())
+	guard.record(true)
+	require.NoError(t, guard.allow())
+	guard.record(true)
+
+	err := guard.allow()
+	require.Equal(t, ErrBridgeCircuitOpen, errors.Cause(err))
+
+	// After the cooldown, a single request probes the bridge.
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, guard.allow())
+	require.Equal(t, ErrBridgeCircuitOpen, errors.Cause(guard.allow()))
+
+	// A failed probe opens the breaker again.
+	guard.record(true)
+	require.Equal(t, ErrBridgeCircuitOpen, errors.Cause(guard.allow()))
+
+	// A successful probe closes it.
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, guard.allow())
+	guard.record(false)
+	require.NoError(t, guard.allow())
+	require.NoError(t, guard.allow())
+}
+
+func TestBridgeGuard_Cache(t *testing.T) {
+	t.Parallel()
+
+	guard := newBridgeGuard("foo", bridgeGuardConfig{ttl: 100 * time.Millisecond})
+	require.True(t, guard.caching())
+
+	_, ok := guard.cached("key")
+	assert.False(t, ok)
+
+	guard.store("key", "value")
+	value, ok := guard.cached("key")
+	assert.True(t, ok)
+	assert.Equal(t, "value", value)
+
+	_, ok = guard.cached("other")
+	assert.False(t, ok)
+
+	time.Sleep(100 * time.Millisecond)
+	_, ok = guard.cached("key")
+	assert.False(t, ok)
+
+	t.Run("is bounded", func(t *testing.T) {
+		guard := newBridgeGuard("foo", bridgeGuardConfig{ttl: time.Hour})
+		for i := 0; i < maxBridgeCacheEntries+10; i++ {
+			guard.store(string(rune(i)), "value")
+		}
+		assert.Len(t, guard.cache, maxBridgeCacheEntries)
+	})
+}
@@ -141,7 +141,9 @@ func TestUnmarshalTaskFromMap(t *testing.T) {
 		{pipeline.TaskTypeSum, &pipeline.SumTask{}},
 		{pipeline.TaskTypeMultiply, &pipeline.MultiplyTask{}},
 		{pipeline.TaskTypeDivide, &pipeline.DivideTask{}},
+		{pipeline.TaskTypeExpr, &pipeline.ExprTask{}},
 		{pipeline.TaskTypeJSONParse, &pipeline.JSONParseTask{}},
+		{pipeline.TaskTypeJSONQuery, &pipeline.JSONQueryTask{}},
 		{pipeline.TaskTypeCBORParse, &pipeline.CBORParseTask{}},
 		{pipeline.TaskTypeAny, &pipeline.AnyTask{}},
 		{pipeline.TaskTypeVRF, &pipeline.VRFTask{}},
@@ -35,6 +35,12 @@ func (t *BridgeTask) HelperSetDependencies(config Config, db *sqlx.DB, id uuid.U
 	t.queryer = db
 	t.uuid = id
 	t.httpClient = httpClient
+	t.guards = newBridgeGuards()
+	t.creds = newBridgeCredentials(nil, httpClient)
+}
+
+func (t *BridgeTask) HelperSetBridgeKeyStore(ks BridgeKeyStore) {
+	t.creds = newBridgeCredentials(ks, t.httpClient)
 }
 
 func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, unrestrictedHTTPClient *http.Client) {
@@ -56,3 +62,9 @@ func (t *ETHTxTask) HelperSetDependencies(cc evm.ChainSet, keyStore ETHKeyStore,
 	t.specGasLimit = specGasLimit
 	t.jobType = jobType
 }
+
+func (t *EstimateGasLimitTask) HelperSetDependencies(cc evm.ChainSet, specGasLimit *uint32, jobType string) {
+	t.chainSet = cc
+	t.specGasLimit = specGasLimit
+	t.jobType = jobType
+}
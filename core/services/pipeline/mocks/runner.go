@@ -13,6 +13,8 @@ import (
 	pipeline "github.com/smartcontractkit/chainlink/core/services/pipeline"
 
 	uuid "github.com/satori/go.uuid"
+
+	bridges "github.com/smartcontractkit/chainlink/core/bridges"
 )
 
 // Runner is an autogenerated mock type for the Runner type
@@ -34,6 +36,11 @@ func (_m *Runner) Close() error {
 	return r0
 }
 
+// EvictBridge provides a mock function with given fields: name
+func (_m *Runner) EvictBridge(name bridges.BridgeName) {
+	_m.Called(name)
+}
+
 // ExecuteAndInsertFinishedRun provides a mock function with given fields: ctx, spec, vars, l, saveSuccessfulTaskRuns
 func (_m *Runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, l logger.Logger, saveSuccessfulTaskRuns bool) (int64, pipeline.FinalResult, error) {
 	ret := _m.Called(ctx, spec, vars, l, saveSuccessfulTaskRuns)
@@ -148,9 +155,20 @@ func (_m *Runner) InsertFinishedRuns(runs []*pipeline.Run, saveSuccessfulTaskRun
 	return r0
 }
 
-// OnRunFinished provides a mock function with given fields: _a0
-func (_m *Runner) OnRunFinished(_a0 func(*pipeline.Run)) {
-	_m.Called(_a0)
+// OnRunFinished provides a mock function with given fields: fn
+func (_m *Runner) OnRunFinished(fn func(*pipeline.Run)) func() {
+	ret := _m.Called(fn)
+
+	var r0 func()
+	if rf, ok := ret.Get(0).(func(func(*pipeline.Run)) func()); ok {
+		r0 = rf(fn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+
+	return r0
 }
 
 // Ready provides a mock function with given fields:
@@ -202,6 +220,36 @@ func (_m *Runner) Run(ctx context.Context, run *pipeline.Run, l logger.Logger, s
 	return r0, r1
 }
 
+// SimulateRun provides a mock function with given fields: ctx, spec, vars, ethTxOutputs, l
+func (_m *Runner) SimulateRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, ethTxOutputs map[string]interface{}, l logger.Logger) (pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, spec, vars, ethTxOutputs, l)
+
+	var r0 pipeline.Run
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]interface{}, logger.Logger) pipeline.Run); ok {
+		r0 = rf(ctx, spec, vars, ethTxOutputs, l)
+	} else {
+		r0 = ret.Get(0).(pipeline.Run)
+	}
+
+	var r1 pipeline.TaskRunResults
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]interface{}, logger.Logger) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, spec, vars, ethTxOutputs, l)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Spec, pipeline.Vars, map[string]interface{}, logger.Logger) error); ok {
+		r2 = rf(ctx, spec, vars, ethTxOutputs, l)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *Runner) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -179,6 +179,9 @@ func TestInsertFinishedRuns(t *testing.T) {
 	err = orm.InsertFinishedRuns(runs, true)
 	require.NoError(t, err)
 
+	for _, run := range runs {
+		require.NotZero(t, run.ID)
+	}
 }
 
 // Tests that inserting run results, then later updating the run results via upsert will work correctly.
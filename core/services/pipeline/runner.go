@@ -14,6 +14,7 @@ import (
 	uuid "github.com/satori/go.uuid"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/recovery"
@@ -37,6 +38,11 @@ type Runner interface {
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run Run, trrs TaskRunResults, err error)
+	// SimulateRun executes a new run in-memory like ExecuteRun, but ethtx tasks do not send
+	// transactions. Instead they output the value found in ethTxOutputs under their dot ID (or nil).
+	// Nothing is persisted, no metrics are recorded, and the run is never suspended, even for async
+	// tasks. All other tasks are executed for real: bridge and http tasks call their endpoints.
+	SimulateRun(ctx context.Context, spec Spec, vars Vars, ethTxOutputs map[string]interface{}, l logger.Logger) (run Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
 	InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error
 	InsertFinishedRuns(runs []*Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error
@@ -46,7 +52,13 @@ type Runner interface {
 	// Note that the spec MUST have a DOT graph for this to work.
 	ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger, saveSuccessfulTaskRuns bool) (runID int64, finalResult FinalResult, err error)
 
-	OnRunFinished(func(*Run))
+	// OnRunFinished registers fn to be called after each run is finished and
+	// stored. It returns a func which unregisters fn again.
+	OnRunFinished(fn func(*Run)) (unregister func())
+
+	// EvictBridge drops the rate limiter, circuit breaker and cached responses
+	// of the named bridge. It must be called when a bridge is updated or deleted.
+	EvictBridge(name bridges.BridgeName)
 }
 
 type runner struct {
@@ -59,9 +71,12 @@ type runner struct {
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	bridgeGuards           *bridgeGuards
+	bridgeCredentials      *bridgeCredentials
 
-	// test helper
-	runFinished func(*Run)
+	runFinishedMu     sync.RWMutex
+	runFinishedNextID int
+	runFinished       map[int]func(*Run)
 
 	utils.StartStopOnce
 	chStop chan struct{}
@@ -98,7 +113,7 @@ var (
 	)
 )
 
-func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, lggr logger.Logger, httpClient, unrestrictedHTTPClient *http.Client) *runner {
+func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, bridgeks BridgeKeyStore, lggr logger.Logger, httpClient, unrestrictedHTTPClient *http.Client) *runner {
 	r := &runner{
 		orm:                    orm,
 		config:                 config,
@@ -107,10 +122,12 @@ func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore,
 		vrfKeyStore:            vrfks,
 		chStop:                 make(chan struct{}),
 		wgDone:                 sync.WaitGroup{},
-		runFinished:            func(*Run) {},
+		runFinished:            make(map[int]func(*Run)),
 		lggr:                   lggr.Named("PipelineRunner"),
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
+		bridgeGuards:           newBridgeGuards(),
+		bridgeCredentials:      newBridgeCredentials(bridgeks, unrestrictedHTTPClient),
 	}
 	r.runReaperWorker = utils.NewSleeperTask(
 		utils.SleeperFuncTask(r.runReaper, "PipelineRunnerReaper"),
@@ -193,8 +210,31 @@ func NewRun(spec Spec, vars Vars) Run {
 	}
 }
 
-func (r *runner) OnRunFinished(fn func(*Run)) {
-	r.runFinished = fn
+func (r *runner) OnRunFinished(fn func(*Run)) (unregister func()) {
+	r.runFinishedMu.Lock()
+	defer r.runFinishedMu.Unlock()
+	id := r.runFinishedNextID
+	r.runFinishedNextID++
+	r.runFinished[id] = fn
+	return func() {
+		r.runFinishedMu.Lock()
+		defer r.runFinishedMu.Unlock()
+		delete(r.runFinished, id)
+	}
+}
+
+func (r *runner) EvictBridge(name bridges.BridgeName) {
+	r.bridgeGuards.evict(name)
+}
+
+func (r *runner) notifyRunFinished(runs ...*Run) {
+	r.runFinishedMu.RLock()
+	defer r.runFinishedMu.RUnlock()
+	for _, fn := range r.runFinished {
+		for _, run := range runs {
+			fn(run)
+		}
+	}
 }
 
 // Be careful with the ctx passed in here: it applies to requests in individual
@@ -213,7 +253,7 @@ func (r *runner) ExecuteRun(
 		return run, nil, err
 	}
 
-	taskRunResults := r.run(ctx, pipeline, &run, vars, l)
+	taskRunResults := r.run(ctx, pipeline, &run, vars, l, false)
 
 	if run.Pending {
 		return run, nil, errors.Wrapf(err, "unexpected async run for spec ID %v, tried executing via ExecuteAndInsertFinishedRun", spec.ID)
@@ -222,6 +262,39 @@ func (r *runner) ExecuteRun(
 	return run, taskRunResults, nil
 }
 
+// SimulateRun executes the spec in-memory with stubbed ethtx task outputs. It is intended to
+// exercise a pipeline before creating a job, so the results are never saved to the database and
+// are not reported to prometheus. Bridge and http tasks are not stubbed: external adapters are
+// called, and may charge for the request.
+func (r *runner) SimulateRun(
+	ctx context.Context,
+	spec Spec,
+	vars Vars,
+	ethTxOutputs map[string]interface{},
+	l logger.Logger,
+) (Run, TaskRunResults, error) {
+	run := NewRun(spec, vars)
+
+	pipeline, err := r.initializePipeline(&run)
+	if err != nil {
+		return run, nil, err
+	}
+
+	for _, task := range pipeline.Tasks {
+		if task.Type() == TaskTypeETHTx {
+			task.(*ETHTxTask).simulatedOutput = &Result{Value: ethTxOutputs[task.DotID()]}
+		}
+	}
+
+	taskRunResults := r.run(ctx, pipeline, &run, vars, l.Named("Simulation"), true)
+
+	if run.Pending {
+		return run, nil, errors.Errorf("unexpected async run for spec ID %v, simulated runs cannot be suspended", spec.ID)
+	}
+
+	return run, taskRunResults, nil
+}
+
 func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 	pipeline, err := Parse(run.PipelineSpec.DotDagSource)
 	if err != nil {
@@ -244,6 +317,8 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).guards = r.bridgeGuards
+			task.(*BridgeTask).creds = r.bridgeCredentials
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).chainSet = r.chainSet
 			task.(*ETHCallTask).config = r.config
@@ -280,7 +355,9 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 	return pipeline, nil
 }
 
-func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, l logger.Logger) TaskRunResults {
+// run executes the pipeline. Simulated runs are not reported to prometheus, as they do not
+// belong to a job.
+func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Vars, l logger.Logger, simulated bool) TaskRunResults {
 	l = l.With("jobID", run.PipelineSpec.JobID, "jobName", run.PipelineSpec.JobName)
 	l.Debug("Initiating tasks for pipeline run of spec")
 
@@ -303,7 +380,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		go recovery.WrapRecoverHandle(l, func() {
 			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
 
-			logTaskRunToPrometheus(result, run.PipelineSpec)
+			if !simulated {
+				logTaskRunToPrometheus(result, run.PipelineSpec)
+			}
 
 			scheduler.report(reportCtx, result)
 		}, func(err interface{}) {
@@ -330,7 +409,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 		// NOTE: runTime can be very long now because it'll include suspend
 		runTime := run.FinishedAt.Time.Sub(run.CreatedAt)
 		l.Debugw("Finished all tasks for pipeline run", "specID", run.PipelineSpecID, "runTime", runTime)
-		PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		if !simulated {
+			PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		}
 	}
 
 	// Update run results
@@ -377,7 +458,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 
 		if run.HasFatalErrors() {
 			run.State = RunStatusErrored
-			PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			if !simulated {
+				PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			}
 		} else {
 			run.State = RunStatusCompleted
 		}
@@ -478,6 +561,7 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 	if err = r.orm.InsertFinishedRun(&run, saveSuccessfulTaskRuns); err != nil {
 		return 0, finalResult, errors.Wrapf(err, "error inserting finished results for spec ID %v", spec.ID)
 	}
+	r.notifyRunFinished(&run)
 	return run.ID, finalResult, nil
 
 }
@@ -525,7 +609,7 @@ func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccess
 	}
 
 	for {
-		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), l)
+		r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), l, false)
 
 		if preinsert {
 			// FailSilently = run failed and task was marked failEarly. skip StoreRun and instead delete all trace of it
@@ -561,7 +645,7 @@ func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccess
 			}
 		}
 
-		r.runFinished(run)
+		r.notifyRunFinished(run)
 
 		return run.Pending, err
 	}
@@ -591,11 +675,19 @@ func (r *runner) ResumeRun(taskID uuid.UUID, value interface{}, err error) error
 }
 
 func (r *runner) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error {
-	return r.orm.InsertFinishedRun(run, saveSuccessfulTaskRuns, qopts...)
+	if err := r.orm.InsertFinishedRun(run, saveSuccessfulTaskRuns, qopts...); err != nil {
+		return err
+	}
+	r.notifyRunFinished(run)
+	return nil
 }
 
 func (r *runner) InsertFinishedRuns(runs []*Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error {
-	return r.orm.InsertFinishedRuns(runs, saveSuccessfulTaskRuns, qopts...)
+	if err := r.orm.InsertFinishedRuns(runs, saveSuccessfulTaskRuns, qopts...); err != nil {
+		return err
+	}
+	r.notifyRunFinished(runs...)
+	return nil
 }
 
 func (r *runner) runReaper() {
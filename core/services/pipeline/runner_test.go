@@ -14,6 +14,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -43,7 +44,7 @@ func newRunner(t testing.TB, db *sqlx.DB, cfg *configtest.TestGeneralConfig) (pi
 	orm.On("GetQ").Return(q).Maybe()
 	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
 	c := clhttptest.NewTestLocalOnlyHTTPClient()
-	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, logger.TestLogger(t), c, c)
+	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, nil, logger.TestLogger(t), c, c)
 	return r, orm
 }
 
@@ -464,7 +465,7 @@ func Test_PipelineRunner_HandleFaultsPersistRun(t *testing.T) {
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg})
 	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, lggr, nil, nil)
+	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, nil, lggr, nil, nil)
 
 	spec := pipeline.Spec{DotDagSource: `
 fail_but_i_dont_care [type=fail]
@@ -536,6 +537,42 @@ a->b2;`,
 	assert.Equal(t, mustDecimal(t, "12").String(), result.Values[1].(decimal.Decimal).String())
 }
 
+func Test_PipelineRunner_SimulateRun(t *testing.T) {
+	cfg := cltest.NewTestGeneralConfig(t)
+	r, _ := newRunner(t, pgtest.NewSqlxDB(t), cfg)
+	input := map[string]interface{}{"val": 2}
+	lggr := logger.TestLogger(t)
+	run, trrs, err := r.SimulateRun(testutils.Context(t), pipeline.Spec{
+		JobName: "simulated",
+		DotDagSource: `
+a      [type=multiply input="$(val)" times=2]
+encode [type=ethabiencode abi="(uint256 answer)" data=<{"answer": $(a)}>]
+submit [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="$(encode)" minConfirmations=2]
+a->encode->submit;`,
+	}, pipeline.NewVarsFrom(input), map[string]interface{}{"submit": "stubbed receipt"}, lggr)
+	require.NoError(t, err)
+	require.Len(t, trrs, 3)
+	assert.Equal(t, int64(0), run.ID)
+	assert.Equal(t, pipeline.RunStatusCompleted, run.State)
+	assert.False(t, run.Pending)
+
+	result := trrs.FinalResult(lggr)
+	require.False(t, result.HasFatalErrors())
+	assert.Equal(t, "stubbed receipt", result.Values[0])
+
+	// Simulated runs are not reported to prometheus
+	assert.Zero(t, promtestutil.ToFloat64(pipeline.PromPipelineTasksTotalFinished.WithLabelValues("0", "simulated", "a", "multiply", "completed")))
+
+	// ethtx tasks without a stubbed output are simulated with a nil output
+	_, trrs, err = r.SimulateRun(testutils.Context(t), pipeline.Spec{
+		DotDagSource: `submit [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="0x01"]`,
+	}, pipeline.NewVarsFrom(nil), nil, lggr)
+	require.NoError(t, err)
+	require.Len(t, trrs, 1)
+	assert.NoError(t, trrs[0].Result.Error)
+	assert.Nil(t, trrs[0].Result.Value)
+}
+
 func Test_PipelineRunner_AsyncJob_Basic(t *testing.T) {
 	db := pgtest.NewSqlxDB(t)
 
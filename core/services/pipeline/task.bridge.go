@@ -2,10 +2,13 @@ package pipeline
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
 	"net/url"
 	"path"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -29,6 +32,8 @@ type BridgeTask struct {
 	queryer    pg.Queryer
 	config     Config
 	httpClient *http.Client
+	guards     *bridgeGuards
+	creds      *bridgeCredentials
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -59,7 +64,13 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		return Result{Error: err}, runInfo
 	}
 
-	url, err := t.getBridgeURLFromName(name)
+	bt, err := t.getBridgeFromName(name)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	url := URLParam(bt.URL)
+	guard := t.guards.get(bt)
+	cred, err := t.creds.get(bt)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
@@ -101,6 +112,23 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+
+	// Responses to async requests are never cached, since they contain a
+	// unique responseURL.
+	var cacheKey string
+	if t.Async != "true" && guard.caching() {
+		hash := sha256.Sum256(requestDataJSON)
+		cacheKey = hex.EncodeToString(hash[:])
+		if cached, ok := guard.cached(cacheKey); ok {
+			lggr.Debugw("Bridge task: using cached answer",
+				"answer", cached,
+				"url", url.String(),
+				"dotID", t.DotID(),
+			)
+			return Result{Value: cached}, runInfo
+		}
+	}
+
 	lggr.Debugw("Bridge task: sending request",
 		"requestData", string(requestDataJSON),
 		"url", url.String(),
@@ -109,7 +137,19 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
 	defer cancel()
 
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", URLParam(url), []string{}, requestData, t.httpClient, t.config.DefaultHTTPLimit())
+	if err = guard.wait(requestCtx); err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if err = guard.allow(); err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	// makeHTTPRequest marshals requestData to the same bytes as requestDataJSON,
+	// since map keys are sorted.
+	reqHeaders := cred.sign(requestDataJSON, time.Now())
+	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, cred.httpClient(t.httpClient), t.config.DefaultHTTPLimit())
+	// Client errors do not mean that the bridge is unhealthy.
+	guard.record(err != nil && isRetryableHTTPError(statusCode, err))
 	if err != nil {
 		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
 	}
@@ -133,6 +173,9 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	// flag such as  "BinaryMode: true" which passes through raw binary as the
 	// value instead.
 	result = Result{Value: string(responseBytes)}
+	if cacheKey != "" {
+		guard.store(cacheKey, string(responseBytes))
+	}
 
 	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
 	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
@@ -145,13 +188,13 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t BridgeTask) getBridgeURLFromName(name StringParam) (URLParam, error) {
+func (t BridgeTask) getBridgeFromName(name StringParam) (bridges.BridgeType, error) {
 	var bt bridges.BridgeType
 	err := t.queryer.Get(&bt, "SELECT * FROM bridge_types WHERE name = $1", string(name))
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bridges.BridgeType{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
 	}
-	return URLParam(bt.URL), nil
+	return bt, nil
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
@@ -26,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -597,6 +599,123 @@ func TestBridgeTask_ErrorIfBridgeMissing(t *testing.T) {
 	assert.Contains(t, result.Error.Error(), "could not find bridge with name 'foo'")
 }
 
+func TestBridgeTask_Limits(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := bridges.NewORM(db, logger.TestLogger(t), cfg)
+
+	var requests atomic.Int32
+	var fail atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Inc()
+		if fail.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(`{"data":{"result":"9700"}}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	run := func(t *testing.T, name bridges.BridgeName, requestData string) pipeline.Result {
+		task := pipeline.BridgeTask{
+			BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+			Name:        name.String(),
+			RequestData: requestData,
+		}
+		task.HelperSetDependencies(cfg, db, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	t.Run("caches responses", func(t *testing.T) {
+		requests.Store(0)
+		_, bt := cltest.NewBridgeType(t, cltest.BridgeOpts{URL: server.URL})
+		bt.CacheTTL = models.Interval(time.Hour)
+		require.NoError(t, orm.CreateBridgeType(bt))
+
+		for i := 0; i < 3; i++ {
+			result := run(t, bt.Name, btcUSDPairing)
+			require.NoError(t, result.Error)
+			require.Equal(t, `{"data":{"result":"9700"}}`, result.Value)
+		}
+		assert.Equal(t, int32(1), requests.Load())
+
+		// A different request body is not cached yet.
+		result := run(t, bt.Name, ethUSDPairing)
+		require.NoError(t, result.Error)
+		assert.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("circuit breaker", func(t *testing.T) {
+		requests.Store(0)
+		fail.Store(true)
+		defer fail.Store(false)
+		_, bt := cltest.NewBridgeType(t, cltest.BridgeOpts{URL: server.URL})
+		bt.CircuitBreakerThreshold = 2
+		bt.CircuitBreakerCooldown = models.Interval(time.Hour)
+		require.NoError(t, orm.CreateBridgeType(bt))
+
+		for i := 0; i < 2; i++ {
+			result := run(t, bt.Name, btcUSDPairing)
+			require.Error(t, result.Error)
+			require.NotEqual(t, pipeline.ErrBridgeCircuitOpen, errors.Cause(result.Error))
+		}
+		result := run(t, bt.Name, btcUSDPairing)
+		require.Equal(t, pipeline.ErrBridgeCircuitOpen, errors.Cause(result.Error))
+		assert.Equal(t, int32(2), requests.Load())
+	})
+}
+
+type plaintextBridgeKeyStore struct{}
+
+func (plaintextBridgeKeyStore) Decrypt(data []byte) ([]byte, error) { return data, nil }
+
+func TestBridgeTask_Signing(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := bridges.NewORM(db, logger.TestLogger(t), cfg)
+
+	const secret = "0123456789abcdef"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		timestamp := r.Header.Get(pipeline.BridgeTimestampHeader)
+		require.NotEmpty(t, timestamp)
+		if r.Header.Get(pipeline.BridgeSignatureHeader) != pipeline.SignBridgeRequest([]byte(secret), timestamp, body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, err = w.Write([]byte(`{"data":{"result":"9700"}}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	_, bt := cltest.NewBridgeType(t, cltest.BridgeOpts{URL: server.URL})
+	bt.EncryptedSigningSecret = []byte(secret)
+	require.NoError(t, orm.CreateBridgeType(bt))
+
+	task := pipeline.BridgeTask{
+		BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+		Name:        bt.Name.String(),
+		RequestData: btcUSDPairing,
+	}
+	task.HelperSetDependencies(cfg, db, uuid.UUID{}, clhttptest.NewTestLocalOnlyHTTPClient())
+
+	// Without a keystore, credentials cannot be decrypted.
+	result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.Error(t, result.Error)
+
+	task.HelperSetBridgeKeyStore(plaintextBridgeKeyStore{})
+	result, _ = task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.NoError(t, result.Error)
+	require.Equal(t, `{"data":{"result":"9700"}}`, result.Value)
+}
+
 // Sample input taken from
 // https://github.com/smartcontractkit/price-adapters#chainlink-price-request-adapters
 func TestAdapterResponse_UnmarshalJSON_Happy(t *testing.T) {
@@ -3,6 +3,7 @@ package pipeline
 import (
 	"context"
 	"math"
+	"math/big"
 	"strconv"
 
 	"github.com/ethereum/go-ethereum"
@@ -12,6 +13,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -19,6 +21,7 @@ import (
 //
 // Return types:
 //   uint64
+//   map[string]interface{} with potential keys {"gasLimit", "gasPrice", "l1Fee", "totalFee"} if includeFee is true
 //
 type EstimateGasLimitTask struct {
 	BaseTask   `mapstructure:",squash"`
@@ -28,6 +31,7 @@ type EstimateGasLimitTask struct {
 	Multiplier string `json:"multiplier"`
 	Data       string `json:"data"`
 	EVMChainID string `json:"evmChainID" mapstructure:"evmChainID"`
+	IncludeFee string `json:"includeFee"`
 
 	specGasLimit *uint32
 	chainSet     evm.ChainSet
@@ -53,6 +57,7 @@ func (t *EstimateGasLimitTask) Run(ctx context.Context, lggr logger.Logger, vars
 		toAddr     AddressParam
 		data       BytesParam
 		multiplier DecimalParam
+		includeFee BoolParam
 	)
 	err := multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddr, From(VarExpr(t.From, vars), utils.ZeroAddress)), "from"),
@@ -60,6 +65,7 @@ func (t *EstimateGasLimitTask) Run(ctx context.Context, lggr logger.Logger, vars
 		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data))), "data"),
 		// Default to 1, i.e. exactly what estimateGas suggests
 		errors.Wrap(ResolveParam(&multiplier, From(VarExpr(t.Multiplier, vars), NonemptyString(t.Multiplier), decimal.New(1, 0))), "multiplier"),
+		errors.Wrap(ResolveParam(&includeFee, From(VarExpr(t.IncludeFee, vars), NonemptyString(t.IncludeFee), false)), "includeFee"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -80,7 +86,7 @@ func (t *EstimateGasLimitTask) Run(ctx context.Context, lggr logger.Logger, vars
 		// Fallback to the maximum conceivable gas limit
 		// if we're unable to call estimate gas for whatever reason.
 		lggr.Warnw("EstimateGas: unable to estimate, fallback to configured limit", "err", err, "fallback", maximumGasLimit)
-		return Result{Value: maximumGasLimit}, runInfo
+		return t.result(ctx, chain, bool(includeFee), data, maximumGasLimit), runInfo
 	}
 	gasLimitDecimal, err := decimal.NewFromString(strconv.FormatUint(gasLimit, 10))
 	if err != nil {
@@ -102,5 +108,46 @@ func (t *EstimateGasLimitTask) Run(ctx context.Context, lggr logger.Logger, vars
 		)
 		gasLimitFinal = maximumGasLimit
 	}
-	return Result{Value: gasLimitFinal}, runInfo
+	return t.result(ctx, chain, bool(includeFee), data, gasLimitFinal), runInfo
+}
+
+// result returns gasLimit, or the gas limit with the expected fee of the
+// transaction if includeFee is true. The fee includes the L1 data fee on L2
+// chains which charge one.
+func (t *EstimateGasLimitTask) result(ctx context.Context, chain evm.Chain, includeFee bool, data []byte, gasLimit uint32) Result {
+	if !includeFee {
+		return Result{Value: gasLimit}
+	}
+	estimator := chain.TxManager().GetGasEstimator()
+	if estimator == nil {
+		return Result{Error: errors.New("gas estimator is not available")}
+	}
+	cfg := chain.Config()
+	var gasPrice *big.Int
+	if cfg.EvmEIP1559DynamicFees() {
+		fee, _, err := estimator.GetDynamicFee(gasLimit, cfg.EvmMaxGasPriceWei())
+		if err != nil {
+			return Result{Error: errors.Wrap(err, "failed to get dynamic gas fee")}
+		}
+		gasPrice = fee.FeeCap
+	} else {
+		price, _, err := estimator.GetLegacyGas(data, gasLimit, cfg.EvmMaxGasPriceWei())
+		if err != nil {
+			return Result{Error: errors.Wrap(err, "failed to estimate gas price")}
+		}
+		gasPrice = price
+	}
+	l1Fee := big.NewInt(0)
+	if l1FeeEstimator, ok := estimator.(gas.L1FeeEstimator); ok {
+		var err error
+		if l1Fee, err = l1FeeEstimator.GetL1Fee(ctx, data); err != nil {
+			return Result{Error: errors.Wrap(err, "failed to get L1 fee")}
+		}
+	}
+	return Result{Value: map[string]interface{}{
+		"gasLimit": gasLimit,
+		"gasPrice": gasPrice,
+		"l1Fee":    l1Fee,
+		"totalFee": gas.TotalFee(gasPrice, gasLimit, l1Fee),
+	}}
 }
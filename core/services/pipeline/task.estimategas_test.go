@@ -0,0 +1,110 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	gasmocks "github.com/smartcontractkit/chainlink/core/chains/evm/gas/mocks"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	txmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/txmgr/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+type l1FeeEstimator struct {
+	*gasmocks.Estimator
+	*gasmocks.L1FeeEstimator
+}
+
+func TestEstimateGasLimitTask(t *testing.T) {
+	t.Parallel()
+
+	const gasLimit uint32 = 21_000
+	data := []byte{0xde, 0xad}
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmGasLimitDefault = null.IntFrom(500_000)
+	cfg.Overrides.GlobalEvmEIP1559DynamicFees = null.BoolFrom(false)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	newChainSet := func(t *testing.T, estimator interface{}) *evmmocks.ChainSet {
+		ethClient := evmmocks.NewClient(t)
+		ethClient.On("EstimateGas", mock.Anything, mock.Anything).Return(uint64(gasLimit), nil)
+
+		ch := evmmocks.NewChain(t)
+		ch.On("Client").Return(ethClient)
+		ch.On("Config").Return(evmcfg)
+		if estimator != nil {
+			txm := txmmocks.NewTxManager(t)
+			txm.On("GetGasEstimator").Return(estimator)
+			ch.On("TxManager").Return(txm)
+		}
+		cc := evmmocks.NewChainSet(t)
+		cc.On("Default").Return(ch, nil)
+		return cc
+	}
+
+	newTask := func(includeFee string) *pipeline.EstimateGasLimitTask {
+		return &pipeline.EstimateGasLimitTask{
+			BaseTask:   pipeline.NewBaseTask(0, "estimategas", nil, nil, 0),
+			To:         "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+			Data:       "$(data)",
+			IncludeFee: includeFee,
+		}
+	}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"data": data})
+
+	t.Run("returns the gas limit", func(t *testing.T) {
+		task := newTask("")
+		task.HelperSetDependencies(newChainSet(t, nil), nil, pipeline.FluxMonitorJobType)
+
+		result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		assert.False(t, runInfo.IsRetryable)
+		require.NoError(t, result.Error)
+		assert.Equal(t, gasLimit, result.Value)
+	})
+
+	t.Run("includes the L1 fee if the estimator supports it", func(t *testing.T) {
+		estimator := l1FeeEstimator{gasmocks.NewEstimator(t), gasmocks.NewL1FeeEstimator(t)}
+		estimator.Estimator.On("GetLegacyGas", data, gasLimit, mock.Anything).Return(big.NewInt(10), gasLimit, nil)
+		estimator.L1FeeEstimator.On("GetL1Fee", mock.Anything, data).Return(assets.GWei(1), nil)
+
+		task := newTask("true")
+		task.HelperSetDependencies(newChainSet(t, estimator), nil, pipeline.FluxMonitorJobType)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, map[string]interface{}{
+			"gasLimit": gasLimit,
+			"gasPrice": big.NewInt(10),
+			"l1Fee":    assets.GWei(1),
+			"totalFee": big.NewInt(1_000_210_000),
+		}, result.Value)
+	})
+
+	t.Run("reports a zero L1 fee on other chains", func(t *testing.T) {
+		estimator := gasmocks.NewEstimator(t)
+		estimator.On("GetLegacyGas", data, gasLimit, mock.Anything).Return(big.NewInt(10), gasLimit, nil)
+
+		task := newTask("true")
+		task.HelperSetDependencies(newChainSet(t, estimator), nil, pipeline.FluxMonitorJobType)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.NoError(t, result.Error)
+		assert.Equal(t, map[string]interface{}{
+			"gasLimit": gasLimit,
+			"gasPrice": big.NewInt(10),
+			"l1Fee":    big.NewInt(0),
+			"totalFee": big.NewInt(210_000),
+		}, result.Value)
+	})
+}
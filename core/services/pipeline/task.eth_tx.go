@@ -36,12 +36,19 @@ type ETHTxTask struct {
 	FailOnRevert    string `json:"failOnRevert"`
 	EVMChainID      string `json:"evmChainID" mapstructure:"evmChainID"`
 	TransmitChecker string `json:"transmitChecker"`
+	// Priority, if set, orders the transaction among the unstarted transactions of its from
+	// address. See txmgr.TxPriority.
+	Priority string `json:"priority"`
 
 	forwardingAllowed bool
 	specGasLimit      *uint32
 	keyStore          ETHKeyStore
 	chainSet          evm.ChainSet
 	jobType           string
+
+	// simulatedOutput is set by Runner.SimulateRun; the task resolves its params but returns
+	// this result instead of sending a transaction
+	simulatedOutput *Result
 }
 
 //go:generate mockery --name ETHKeyStore --output ./mocks/ --case=underscore
@@ -85,6 +92,7 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		maybeMinConfirmations MaybeUint64Param
 		transmitCheckerMap    MapParam
 		failOnRevert          BoolParam
+		maybePriority         MaybeInt32Param
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -95,6 +103,7 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(t.MinConfirmations)), "minConfirmations"),
 		errors.Wrap(ResolveParam(&transmitCheckerMap, From(VarExpr(t.TransmitChecker, vars), JSONWithVarExprs(t.TransmitChecker, vars, false), MapParam{})), "transmitChecker"),
 		errors.Wrap(ResolveParam(&failOnRevert, From(NonemptyString(t.FailOnRevert), false)), "failOnRevert"),
+		errors.Wrap(ResolveParam(&maybePriority, From(VarExpr(t.Priority, vars), t.Priority)), "priority"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -118,6 +127,11 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		return Result{Error: err}, runInfo
 	}
 
+	if t.simulatedOutput != nil {
+		lggr.Debugw("Simulated ETHTxTask, not sending transaction", "to", common.Address(toAddr), "data", []byte(data), "gasLimit", gasLimit, "meta", txMeta)
+		return *t.simulatedOutput, runInfo
+	}
+
 	fromAddr, err := t.keyStore.GetRoundRobinAddress(chain.ID(), fromAddrs...)
 	if err != nil {
 		err = errors.Wrap(err, "ETHTxTask failed to get fromAddress")
@@ -146,6 +160,7 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		ForwarderAddress: forwarderAddress,
 		Strategy:         strategy,
 		Checker:          transmitChecker,
+		Priority:         txPriority(t.jobType, maybePriority),
 	}
 
 	if minOutgoingConfirmations > 0 {
@@ -166,6 +181,18 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 	return Result{Value: nil}, runInfo
 }
 
+// txPriority returns the priority set on the task, if any. Keeper upkeeps default to
+// txmgr.TxPriorityLow, so that they never delay the transactions of other jobs.
+func txPriority(jobType string, maybePriority MaybeInt32Param) *txmgr.TxPriority {
+	priority := txmgr.TxPriorityLow
+	if p, isSet := maybePriority.Int32(); isSet {
+		priority = txmgr.TxPriority(p)
+	} else if jobType != KeeperJobType {
+		return nil
+	}
+	return &priority
+}
+
 func decodeMeta(metaMap MapParam) (*txmgr.EthTxMeta, error) {
 	var txMeta txmgr.EthTxMeta
 	metaDecoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
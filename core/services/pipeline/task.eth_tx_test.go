@@ -586,3 +586,57 @@ func TestETHTxTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHTxTask_Priority(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	high := txmgr.TxPriorityHigh
+	low := txmgr.TxPriorityLow
+	custom := txmgr.TxPriority(42)
+
+	tests := []struct {
+		name     string
+		jobType  string
+		priority string
+		vars     pipeline.Vars
+		expected *txmgr.TxPriority
+	}{
+		{"unset", pipeline.DirectRequestJobType, "", pipeline.NewVarsFrom(nil), nil},
+		{"unset for keeper", pipeline.KeeperJobType, "", pipeline.NewVarsFrom(nil), &low},
+		{"set", pipeline.DirectRequestJobType, "100", pipeline.NewVarsFrom(nil), &high},
+		{"set for keeper", pipeline.KeeperJobType, "100", pipeline.NewVarsFrom(nil), &high},
+		{"set with vars", pipeline.DirectRequestJobType, "$(priority)", pipeline.NewVarsFrom(map[string]interface{}{"priority": 42}), &custom},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ETHTxTask{
+				BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+				From:             from.Hex(),
+				To:               to.Hex(),
+				Data:             "foobar",
+				GasLimit:         "12345",
+				MinConfirmations: "0",
+				Priority:         test.priority,
+			}
+
+			keyStore := keystoremocks.NewEth(t)
+			txManager := txmmocks.NewTxManager(t)
+			db := pgtest.NewSqlxDB(t)
+			cfg := configtest.NewTestGeneralConfig(t)
+			cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+
+			keyStore.On("GetRoundRobinAddress", testutils.FixtureChainID, from).Return(from, nil)
+			txManager.On("CreateEthTransaction", mock.MatchedBy(func(newTx txmgr.NewTx) bool {
+				return assert.Equal(t, test.expected, newTx.Priority)
+			})).Return(txmgr.EthTx{}, nil)
+			task.HelperSetDependencies(cc, keyStore, nil, test.jobType)
+
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, nil)
+			require.NoError(t, result.Error)
+		})
+	}
+}
@@ -0,0 +1,628 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ExprTask evaluates an arithmetic and boolean expression over the pipeline
+// vars and the task's inputs, e.g.
+//
+//	expr = "(ds1_parse + ds2_parse) / 2 * $(jobRun.requestBody.multiplier)"
+//
+// The expression uses Go syntax. Identifiers refer to pipeline vars, and
+// fields and elements can be selected with `.` and `[]`. $(keypath) is
+// accepted as well. `input` is the value of the first input, and `inputs`
+// the values of all inputs.
+//
+// Numbers are evaluated as decimals, and strings used in arithmetic are
+// parsed as decimals. Quotients are rounded to `precision` decimal places
+// (default: 16, at most 1024). The following functions are available:
+//
+//	abs(x), ceil(x), floor(x), round(x[, places])
+//	min(xs...), max(xs...), sum(xs...), mean(xs...)
+//	pow(x, n), len(x), ifelse(cond, a, b)
+//
+// Evaluation is deterministic and has no side effects. It is aborted when
+// the task times out, and fails if an expression or a number grows too large.
+//
+// Return types:
+//
+//	decimal.Decimal
+//	bool
+//	string
+//	map[string]interface{}
+//	[]interface{}
+//	nil
+type ExprTask struct {
+	BaseTask  `mapstructure:",squash"`
+	Expr      string `json:"expr"`
+	Precision string `json:"precision"`
+}
+
+var _ Task = (*ExprTask)(nil)
+
+const (
+	// maxExprNodes limits the size of an expression.
+	maxExprNodes = 1000
+	// maxExprBitLen limits the size of a decimal's coefficient.
+	maxExprBitLen = 4096
+	// maxExprExponent limits the size of a decimal's exponent.
+	maxExprExponent = 1024
+)
+
+var ErrExprOverflow = errors.New("expression overflow")
+
+func (t *ExprTask) Type() TaskType {
+	return TaskTypeExpr
+}
+
+func (t *ExprTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		expr           StringParam
+		maybePrecision MaybeInt32Param
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&expr, From(NonemptyString(t.Expr))), "expr"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	e := exprEvaluator{ctx: ctx, vars: vars, inputs: inputs, precision: int32(decimal.DivisionPrecision)}
+	if precision, isSet := maybePrecision.Int32(); isSet {
+		if precision < 0 || precision > maxExprExponent {
+			return Result{Error: errors.Wrapf(ErrBadInput, "precision: %d out of range [0, %d]", precision, maxExprExponent)}, runInfo
+		}
+		e.precision = precision
+	}
+
+	node, err := parseExpr(string(expr))
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	value, err := e.eval(node)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	switch value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64,
+		float32, float64, json.Number, big.Int, *big.Int, *decimal.Decimal:
+		// Numbers read from vars are returned as decimals as well.
+		value, err = exprDecimal(value)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+	return Result{Value: value}, runInfo
+}
+
+// exprKeypathRegexp matches $(keypath) like variableRegexp, but does not
+// allow whitespace, which can not be part of a keypath in an expression.
+var exprKeypathRegexp = regexp.MustCompile(`\$\(([a-zA-Z0-9_\.]+)\)`)
+
+// parseExpr rewrites $(keypath) references to selectors and parses expr.
+func parseExpr(expr string) (ast.Expr, error) {
+	var rewriteErr error
+	expr = exprKeypathRegexp.ReplaceAllStringFunc(expr, func(s string) string {
+		parts := strings.Split(exprKeypathRegexp.FindStringSubmatch(s)[1], KeypathSeparator)
+		if !token.IsIdentifier(parts[0]) {
+			rewriteErr = errors.Wrapf(ErrBadInput, "invalid variable %q", s)
+			return s
+		}
+		var sb strings.Builder
+		sb.WriteString("(")
+		sb.WriteString(parts[0])
+		for _, part := range parts[1:] {
+			if token.IsIdentifier(part) {
+				sb.WriteString("." + part)
+			} else {
+				sb.WriteString("[" + strconv.Quote(part) + "]")
+			}
+		}
+		sb.WriteString(")")
+		return sb.String()
+	})
+	if rewriteErr != nil {
+		return nil, rewriteErr
+	}
+
+	node, err := parser.ParseExpr(expr)
+	if err != nil {
+		return nil, errors.Wrapf(ErrBadInput, "invalid expression: %v", err)
+	}
+	var nodes int
+	ast.Inspect(node, func(ast.Node) bool {
+		nodes++
+		return true
+	})
+	if nodes > maxExprNodes {
+		return nil, errors.Wrapf(ErrBadInput, "expression too large: %d nodes (max %d)", nodes, maxExprNodes)
+	}
+	return node, nil
+}
+
+type exprEvaluator struct {
+	ctx       context.Context
+	vars      Vars
+	inputs    []Result
+	precision int32
+}
+
+func (e *exprEvaluator) eval(node ast.Expr) (interface{}, error) {
+	if err := e.ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, "expression evaluation aborted")
+	}
+
+	switch n := node.(type) {
+	case *ast.ParenExpr:
+		return e.eval(n.X)
+	case *ast.BasicLit:
+		return evalLiteral(n)
+	case *ast.Ident:
+		return e.lookup(n.Name)
+	case *ast.SelectorExpr:
+		x, err := e.eval(n.X)
+		if err != nil {
+			return nil, err
+		}
+		return exprIndex(x, n.Sel.Name)
+	case *ast.IndexExpr:
+		x, err := e.eval(n.X)
+		if err != nil {
+			return nil, err
+		}
+		idx, err := e.eval(n.Index)
+		if err != nil {
+			return nil, err
+		}
+		return exprIndex(x, idx)
+	case *ast.UnaryExpr:
+		return e.evalUnary(n)
+	case *ast.BinaryExpr:
+		return e.evalBinary(n)
+	case *ast.CallExpr:
+		return e.evalCall(n)
+	}
+	return nil, errors.Wrapf(ErrBadInput, "unsupported expression %T", node)
+}
+
+func (e *exprEvaluator) lookup(name string) (interface{}, error) {
+	switch name {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "nil":
+		return nil, nil
+	case "input":
+		if len(e.inputs) == 0 {
+			return nil, errors.Wrap(ErrIndexOutOfRange, "input")
+		}
+		return e.inputs[0].Value, nil
+	case "inputs":
+		values := make([]interface{}, len(e.inputs))
+		for i, input := range e.inputs {
+			values[i] = input.Value
+		}
+		return values, nil
+	}
+	return e.vars.Get(name)
+}
+
+func (e *exprEvaluator) evalUnary(n *ast.UnaryExpr) (interface{}, error) {
+	x, err := e.eval(n.X)
+	if err != nil {
+		return nil, err
+	}
+	switch n.Op {
+	case token.NOT:
+		b, ok := x.(bool)
+		if !ok {
+			return nil, errors.Wrapf(ErrBadInput, "operator ! not defined on %T", x)
+		}
+		return !b, nil
+	case token.SUB:
+		d, err := exprDecimal(x)
+		if err != nil {
+			return nil, err
+		}
+		return d.Neg(), nil
+	case token.ADD:
+		return exprDecimal(x)
+	}
+	return nil, errors.Wrapf(ErrBadInput, "unsupported operator %s", n.Op)
+}
+
+func (e *exprEvaluator) evalBinary(n *ast.BinaryExpr) (interface{}, error) {
+	x, err := e.eval(n.X)
+	if err != nil {
+		return nil, err
+	}
+
+	// && and || short circuit.
+	if n.Op == token.LAND || n.Op == token.LOR {
+		a, ok := x.(bool)
+		if !ok {
+			return nil, errors.Wrapf(ErrBadInput, "operator %s not defined on %T", n.Op, x)
+		}
+		if a == (n.Op == token.LOR) {
+			return a, nil
+		}
+		y, err := e.eval(n.Y)
+		if err != nil {
+			return nil, err
+		}
+		b, ok := y.(bool)
+		if !ok {
+			return nil, errors.Wrapf(ErrBadInput, "operator %s not defined on %T", n.Op, y)
+		}
+		return b, nil
+	}
+
+	y, err := e.eval(n.Y)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.Op {
+	case token.EQL, token.NEQ:
+		eq, err := exprEqual(x, y)
+		if err != nil {
+			return nil, err
+		}
+		return eq == (n.Op == token.EQL), nil
+	}
+
+	a, err := exprDecimal(x)
+	if err != nil {
+		return nil, err
+	}
+	b, err := exprDecimal(y)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.Op {
+	case token.LSS:
+		return a.LessThan(b), nil
+	case token.LEQ:
+		return a.LessThanOrEqual(b), nil
+	case token.GTR:
+		return a.GreaterThan(b), nil
+	case token.GEQ:
+		return a.GreaterThanOrEqual(b), nil
+	case token.ADD:
+		return checkExprDecimal(a.Add(b))
+	case token.SUB:
+		return checkExprDecimal(a.Sub(b))
+	case token.MUL:
+		return checkExprDecimal(a.Mul(b))
+	case token.QUO:
+		if b.IsZero() {
+			return nil, ErrDivideByZero
+		}
+		return checkExprDecimal(a.DivRound(b, e.precision))
+	case token.REM:
+		if b.IsZero() {
+			return nil, ErrDivideByZero
+		}
+		return checkExprDecimal(a.Mod(b))
+	}
+	return nil, errors.Wrapf(ErrBadInput, "unsupported operator %s", n.Op)
+}
+
+func (e *exprEvaluator) evalCall(n *ast.CallExpr) (interface{}, error) {
+	fn, ok := n.Fun.(*ast.Ident)
+	if !ok || n.Ellipsis.IsValid() {
+		return nil, errors.Wrap(ErrBadInput, "unsupported function call")
+	}
+
+	// ifelse only evaluates the chosen branch.
+	if fn.Name == "ifelse" {
+		if len(n.Args) != 3 {
+			return nil, errors.Wrap(ErrBadInput, "ifelse: expected 3 arguments")
+		}
+		x, err := e.eval(n.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		cond, ok := x.(bool)
+		if !ok {
+			return nil, errors.Wrapf(ErrBadInput, "ifelse: expected bool condition, got %T", x)
+		}
+		if cond {
+			return e.eval(n.Args[1])
+		}
+		return e.eval(n.Args[2])
+	}
+
+	f, ok := exprFuncs[fn.Name]
+	if !ok {
+		return nil, errors.Wrapf(ErrBadInput, "unknown function %s", fn.Name)
+	}
+	args := make([]interface{}, len(n.Args))
+	for i, arg := range n.Args {
+		var err error
+		if args[i], err = e.eval(arg); err != nil {
+			return nil, err
+		}
+	}
+	v, err := f(args)
+	return v, errors.Wrap(err, fn.Name)
+}
+
+var exprFuncs = map[string]func(args []interface{}) (interface{}, error){
+	"abs": func(args []interface{}) (interface{}, error) {
+		x, err := exprUnaryDecimal(args)
+		return x.Abs(), err
+	},
+	"ceil": func(args []interface{}) (interface{}, error) {
+		x, err := exprUnaryDecimal(args)
+		return x.Ceil(), err
+	},
+	"floor": func(args []interface{}) (interface{}, error) {
+		x, err := exprUnaryDecimal(args)
+		return x.Floor(), err
+	},
+	"round": func(args []interface{}) (interface{}, error) {
+		if len(args) != 1 && len(args) != 2 {
+			return nil, errors.Wrap(ErrBadInput, "expected 1 or 2 arguments")
+		}
+		x, err := exprDecimal(args[0])
+		if err != nil {
+			return nil, err
+		}
+		var places int64
+		if len(args) == 2 {
+			if places, err = exprInt(args[1], maxExprExponent); err != nil {
+				return nil, err
+			}
+		}
+		return x.Round(int32(places)), nil
+	},
+	"min": func(args []interface{}) (interface{}, error) {
+		xs, err := exprDecimals(args)
+		if err != nil {
+			return nil, err
+		}
+		return decimal.Min(xs[0], xs[1:]...), nil
+	},
+	"max": func(args []interface{}) (interface{}, error) {
+		xs, err := exprDecimals(args)
+		if err != nil {
+			return nil, err
+		}
+		return decimal.Max(xs[0], xs[1:]...), nil
+	},
+	"sum": func(args []interface{}) (interface{}, error) {
+		xs, err := exprDecimals(args)
+		if err != nil {
+			return nil, err
+		}
+		return checkExprDecimal(decimal.Sum(xs[0], xs[1:]...))
+	},
+	"mean": func(args []interface{}) (interface{}, error) {
+		xs, err := exprDecimals(args)
+		if err != nil {
+			return nil, err
+		}
+		return checkExprDecimal(decimal.Avg(xs[0], xs[1:]...))
+	},
+	"pow": func(args []interface{}) (interface{}, error) {
+		if len(args) != 2 {
+			return nil, errors.Wrap(ErrBadInput, "expected 2 arguments")
+		}
+		x, err := exprDecimal(args[0])
+		if err != nil {
+			return nil, err
+		}
+		n, err := exprInt(args[1], maxExprExponent)
+		if err != nil {
+			return nil, err
+		}
+		if x.IsZero() && n < 0 {
+			return nil, ErrDivideByZero
+		}
+		if int64(x.NumDigits())*exprAbs(n) > maxExprBitLen {
+			return nil, errors.Wrapf(ErrExprOverflow, "%s^%d", x, n)
+		}
+		return checkExprDecimal(x.Pow(decimal.New(n, 0)))
+	},
+	"len": func(args []interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, errors.Wrap(ErrBadInput, "expected 1 argument")
+		}
+		switch v := args[0].(type) {
+		case string:
+			return decimal.New(int64(len(v)), 0), nil
+		case []byte:
+			return decimal.New(int64(len(v)), 0), nil
+		case []interface{}:
+			return decimal.New(int64(len(v)), 0), nil
+		case map[string]interface{}:
+			return decimal.New(int64(len(v)), 0), nil
+		}
+		return nil, errors.Wrapf(ErrBadInput, "expected string, slice or map, got %T", args[0])
+	},
+}
+
+func exprAbs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func evalLiteral(lit *ast.BasicLit) (interface{}, error) {
+	switch lit.Kind {
+	case token.INT:
+		n, ok := new(big.Int).SetString(strings.ReplaceAll(lit.Value, "_", ""), 0)
+		if !ok {
+			return nil, errors.Wrapf(ErrBadInput, "invalid integer %s", lit.Value)
+		}
+		return checkExprDecimal(decimal.NewFromBigInt(n, 0))
+	case token.FLOAT:
+		d, err := decimal.NewFromString(strings.ReplaceAll(lit.Value, "_", ""))
+		if err != nil {
+			return nil, errors.Wrapf(ErrBadInput, "invalid number %s: %v", lit.Value, err)
+		}
+		return checkExprDecimal(d)
+	case token.STRING:
+		s, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return nil, errors.Wrapf(ErrBadInput, "invalid string %s: %v", lit.Value, err)
+		}
+		return s, nil
+	}
+	return nil, errors.Wrapf(ErrBadInput, "unsupported literal %s", lit.Value)
+}
+
+// exprIndex selects a field of a map or an element of a slice.
+func exprIndex(x interface{}, idx interface{}) (interface{}, error) {
+	switch v := x.(type) {
+	case map[string]interface{}:
+		key, ok := idx.(string)
+		if !ok {
+			return nil, errors.Wrapf(ErrBadInput, "invalid map key %v", idx)
+		}
+		val, exists := v[key]
+		if !exists {
+			return nil, errors.Wrapf(ErrKeypathNotFound, "key %s", key)
+		}
+		return val, nil
+	case []interface{}:
+		var i int64
+		var err error
+		if s, ok := idx.(string); ok {
+			i, err = strconv.ParseInt(s, 10, 64)
+		} else {
+			i, err = exprInt(idx, int64(len(v)))
+		}
+		if err != nil {
+			return nil, errors.Wrapf(ErrBadInput, "invalid index %v", idx)
+		}
+		if i < 0 || i >= int64(len(v)) {
+			return nil, errors.Wrapf(ErrIndexOutOfRange, "index %d out of range (length %d)", i, len(v))
+		}
+		return v[i], nil
+	}
+	return nil, errors.Wrapf(ErrKeypathNotFound, "can not index %T", x)
+}
+
+func exprEqual(x, y interface{}) (bool, error) {
+	if x == nil || y == nil {
+		return x == nil && y == nil, nil
+	}
+	switch a := x.(type) {
+	case bool:
+		b, ok := y.(bool)
+		return ok && a == b, nil
+	case string:
+		if b, ok := y.(string); ok {
+			return a == b, nil
+		}
+	}
+	if _, ok := y.(bool); ok {
+		return false, nil
+	}
+	a, err := exprDecimal(x)
+	if err != nil {
+		return false, err
+	}
+	b, err := exprDecimal(y)
+	if err != nil {
+		return false, err
+	}
+	return a.Equal(b), nil
+}
+
+func exprDecimal(x interface{}) (decimal.Decimal, error) {
+	switch v := x.(type) {
+	case json.Number:
+		x = v.String()
+	case *ObjectParam:
+		if v != nil && v.Type == DecimalType {
+			return v.DecimalValue.Decimal(), nil
+		}
+	case ObjectParam:
+		if v.Type == DecimalType {
+			return v.DecimalValue.Decimal(), nil
+		}
+	}
+	d, err := utils.ToDecimal(x)
+	if err != nil {
+		return decimal.Decimal{}, errors.Wrap(ErrBadInput, err.Error())
+	}
+	return checkExprDecimal(d)
+}
+
+func exprUnaryDecimal(args []interface{}) (decimal.Decimal, error) {
+	if len(args) != 1 {
+		return decimal.Decimal{}, errors.Wrap(ErrBadInput, "expected 1 argument")
+	}
+	return exprDecimal(args[0])
+}
+
+// exprDecimals converts args, or the elements of a single slice arg, to
+// decimals.
+func exprDecimals(args []interface{}) ([]decimal.Decimal, error) {
+	if len(args) == 1 {
+		if s, ok := args[0].([]interface{}); ok {
+			args = s
+		}
+	}
+	if len(args) == 0 {
+		return nil, errors.Wrap(ErrBadInput, "expected at least 1 argument")
+	}
+	xs := make([]decimal.Decimal, len(args))
+	for i, arg := range args {
+		var err error
+		if xs[i], err = exprDecimal(arg); err != nil {
+			return nil, err
+		}
+	}
+	return xs, nil
+}
+
+// exprInt converts x to an integer with an absolute value of at most max.
+func exprInt(x interface{}, max int64) (int64, error) {
+	d, err := exprDecimal(x)
+	if err != nil {
+		return 0, err
+	}
+	if !d.IsInteger() {
+		return 0, errors.Wrapf(ErrBadInput, "expected integer, got %s", d)
+	}
+	if d.Abs().GreaterThan(decimal.New(max, 0)) {
+		return 0, errors.Wrapf(ErrBadInput, "integer %s out of range (max %d)", d, max)
+	}
+	return d.IntPart(), nil
+}
+
+func checkExprDecimal(d decimal.Decimal) (decimal.Decimal, error) {
+	if e := d.Exponent(); e > maxExprExponent || e < -maxExprExponent || d.Coefficient().BitLen() > maxExprBitLen {
+		return decimal.Decimal{}, errors.Wrapf(ErrExprOverflow, "number too large")
+	}
+	return d, nil
+}
@@ -0,0 +1,170 @@
+package pipeline_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestExprTask_Happy(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"ds1_parse": float64(1.5),
+		"ds2_parse": "2.5",
+		"ds3_parse": json.Number("123456789012345678901234567890"),
+		"jobRun": map[string]interface{}{
+			"requestBody": map[string]interface{}{
+				"multiplier": int64(100),
+				"prices":     []interface{}{"3", int64(1), float64(2)},
+				"symbol":     "ETH",
+				"enabled":    true,
+			},
+		},
+	})
+
+	tests := []struct {
+		name      string
+		expr      string
+		precision string
+		inputs    []pipeline.Result
+		expected  interface{}
+	}{
+		{"arithmetic", "(ds1_parse + ds2_parse) / 2 * $(jobRun.requestBody.multiplier)", "", nil, mustDecimal(t, "200")},
+		{"operator precedence", "1 + 2 * 3 - 4 % 3", "", nil, mustDecimal(t, "6")},
+		{"unary", "-ds1_parse + +1", "", nil, mustDecimal(t, "-0.5")},
+		{"large numbers", "ds3_parse * 10 + 1", "", nil, mustDecimal(t, "1234567890123456789012345678901")},
+		{"literals", "1_000 + 0x10 + 1.5e3 + .5", "", nil, mustDecimal(t, "2516.5")},
+		{"default precision", "1 / 3", "", nil, mustDecimal(t, "0.3333333333333333")},
+		{"precision", "1 / 3", "2", nil, mustDecimal(t, "0.33")},
+		{"zero precision", "2 / 3", "0", nil, mustDecimal(t, "1")},
+		{"max precision", "1 / 4", "1024", nil, mustDecimal(t, "0.25")},
+		{"input", "input * 2", "", []pipeline.Result{{Value: "21"}}, mustDecimal(t, "42")},
+		{"inputs", "sum(inputs)", "", []pipeline.Result{{Value: "1"}, {Value: 2}, {Value: decimal.NewFromInt(3)}}, mustDecimal(t, "6")},
+		{"index", "jobRun.requestBody.prices[1] + $(jobRun.requestBody.prices.0)", "", nil, mustDecimal(t, "4")},
+		{"map index", `jobRun["requestBody"].symbol`, "", nil, "ETH"},
+		{"comparison", "ds1_parse < ds2_parse && ds2_parse >= 2.5", "", nil, true},
+		{"string equality", `jobRun.requestBody.symbol == "ETH"`, "", nil, true},
+		{"number equality", `ds2_parse == 2.50`, "", nil, true},
+		{"bool", "!jobRun.requestBody.enabled || false", "", nil, false},
+		{"short circuit", "false && missing > 0", "", nil, false},
+		{"nil", "nil", "", nil, nil},
+		{"structured value", "jobRun.requestBody.prices", "", nil, []interface{}{"3", int64(1), float64(2)}},
+		{"abs", "abs(-1.5)", "", nil, mustDecimal(t, "1.5")},
+		{"ceil", "ceil(1.2)", "", nil, mustDecimal(t, "2")},
+		{"floor", "floor(-1.2)", "", nil, mustDecimal(t, "-2")},
+		{"round", "round(1.2345, 2) + round(0.5)", "", nil, mustDecimal(t, "2.23")},
+		{"min", "min(3, ds1_parse, ds2_parse)", "", nil, mustDecimal(t, "1.5")},
+		{"max of slice", "max(jobRun.requestBody.prices)", "", nil, mustDecimal(t, "3")},
+		{"mean", "mean(1, 2, 3, 4)", "", nil, mustDecimal(t, "2.5")},
+		{"pow", "pow(10, 18) * ds1_parse + pow(2, -1)", "", nil, mustDecimal(t, "1500000000000000000.5")},
+		{"len", `len(jobRun.requestBody.prices) + len("foo")`, "", nil, mustDecimal(t, "6")},
+		{"ifelse", "ifelse(ds1_parse > 1, ds1_parse, missing)", "", nil, mustDecimal(t, "1.5")},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ExprTask{
+				BaseTask:  pipeline.NewBaseTask(0, "expr", nil, nil, 0),
+				Expr:      test.expr,
+				Precision: test.precision,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			if expected, ok := test.expected.(*decimal.Decimal); ok {
+				require.Equal(t, expected.String(), result.Value.(decimal.Decimal).String())
+			} else {
+				require.Equal(t, test.expected, result.Value)
+			}
+		})
+	}
+}
+
+func TestExprTask_Errors(t *testing.T) {
+	t.Parallel()
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"foo": map[string]interface{}{"bar": []interface{}{int64(1)}},
+	})
+
+	tests := []struct {
+		name              string
+		expr              string
+		precision         string
+		inputs            []pipeline.Result
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{"empty", "", "", nil, pipeline.ErrParameterEmpty, "expr"},
+		{"syntax error", "1 +", "", nil, pipeline.ErrBadInput, "invalid expression"},
+		{"unsupported expression", "func() {}", "", nil, pipeline.ErrBadInput, "unsupported expression"},
+		{"unsupported operator", "1 << 2", "", nil, pipeline.ErrBadInput, "unsupported operator"},
+		{"unknown function", "exec(1)", "", nil, pipeline.ErrBadInput, "unknown function"},
+		{"method call", "foo.bar(1)", "", nil, pipeline.ErrBadInput, "unsupported function call"},
+		{"missing var", "missing + 1", "", nil, pipeline.ErrKeypathNotFound, ""},
+		{"missing key", "foo.baz", "", nil, pipeline.ErrKeypathNotFound, ""},
+		{"index out of range", "foo.bar[1]", "", nil, pipeline.ErrIndexOutOfRange, ""},
+		{"no input", "input", "", nil, pipeline.ErrIndexOutOfRange, ""},
+		{"not a number", `"foo" * 2`, "", nil, pipeline.ErrBadInput, ""},
+		{"not a bool", "1 && true", "", nil, pipeline.ErrBadInput, "not defined"},
+		{"divide by zero", "1 / (2 - 2)", "", nil, pipeline.ErrDivideByZero, ""},
+		{"modulo by zero", "1 % 0", "", nil, pipeline.ErrDivideByZero, ""},
+		{"large exponent", "pow(10, 100000)", "", nil, pipeline.ErrBadInput, "out of range"},
+		{"overflow", "pow(pow(pow(10, 1000), 1000), 1000)", "", nil, pipeline.ErrExprOverflow, ""},
+		{"large literal", "1e100000", "", nil, pipeline.ErrExprOverflow, ""},
+		{"wrong number of arguments", "abs(1, 2)", "", nil, pipeline.ErrBadInput, "abs"},
+		{"input error", "1", "", []pipeline.Result{{Error: errors.New("foo")}}, pipeline.ErrTooManyErrors, "task inputs"},
+		{"negative precision", "1 / 3", "-1", nil, pipeline.ErrBadInput, "precision"},
+		{"precision too large", "1 / 3", "1025", nil, pipeline.ErrBadInput, "precision"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ExprTask{
+				BaseTask:  pipeline.NewBaseTask(0, "expr", nil, nil, 0),
+				Expr:      test.expr,
+				Precision: test.precision,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+			if test.wantErrorContains != "" {
+				require.Contains(t, result.Error.Error(), test.wantErrorContains)
+			}
+			require.Nil(t, result.Value)
+		})
+	}
+
+	t.Run("too large", func(t *testing.T) {
+		expr := "1"
+		for i := 0; i < 1000; i++ {
+			expr += " + 1"
+		}
+		task := pipeline.ExprTask{BaseTask: pipeline.NewBaseTask(0, "expr", nil, nil, 0), Expr: expr}
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+		require.Contains(t, result.Error.Error(), "expression too large")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		cancel()
+		task := pipeline.ExprTask{BaseTask: pipeline.NewBaseTask(0, "expr", nil, nil, 0), Expr: "1 + 1"}
+		result, _ := task.Run(ctx, logger.TestLogger(t), vars, nil)
+		require.ErrorIs(t, result.Error, context.Canceled)
+	})
+}
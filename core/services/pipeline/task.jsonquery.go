@@ -0,0 +1,109 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/jmespath/go-jmespath"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// JSONQueryTask evaluates a JMESPath query (https://jmespath.org) against its
+// input, e.g. to filter arrays or reshape a response in a single task.
+//
+// Numbers are evaluated as float64. Integers which float64 can not represent
+// exactly are passed through unchanged, but can not be compared or used in
+// functions.
+//
+// Return types:
+//
+//	float64
+//	int64
+//	uint64
+//	*big.Int
+//	string
+//	bool
+//	map[string]interface{}
+//	[]interface{}
+//	nil
+type JSONQueryTask struct {
+	BaseTask `mapstructure:",squash"`
+	Query    string `json:"query"`
+	Data     string `json:"data"`
+}
+
+var _ Task = (*JSONQueryTask)(nil)
+
+// maxExactFloat64Int is 2^53, up to which all integers can be represented
+// exactly as float64.
+var maxExactFloat64Int = big.NewInt(1 << 53)
+
+func (t *JSONQueryTask) Type() TaskType {
+	return TaskTypeJSONQuery
+}
+
+func (t *JSONQueryTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, 0, 1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		query StringParam
+		data  JSONParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&query, From(VarExpr(t.Query, vars), NonemptyString(t.Query))), "query"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), Input(inputs, 0))), "data"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	compiled, err := jmespath.Compile(string(query))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "invalid query: %v", err)}, runInfo
+	}
+
+	value, err := compiled.Search(jmespathNumbers(data.Value))
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "query failed")}, runInfo
+	}
+
+	value, err = reinterpetJsonNumbers(value)
+	if err != nil {
+		return Result{Error: multierr.Combine(ErrBadInput, err)}, runInfo
+	}
+
+	return Result{Value: value}, runInfo
+}
+
+// jmespathNumbers converts the numbers in val to float64, which is the only
+// number type JMESPath supports, unless they would lose precision.
+func jmespathNumbers(val interface{}) interface{} {
+	switch v := val.(type) {
+	case json.Number:
+		if n, ok := new(big.Int).SetString(v.String(), 10); ok && n.CmpAbs(maxExactFloat64Int) > 0 {
+			return v
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = jmespathNumbers(v[i])
+		}
+		return v
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = jmespathNumbers(v[k])
+		}
+		return v
+	}
+	return val
+}
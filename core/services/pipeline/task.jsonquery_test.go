@@ -0,0 +1,190 @@
+package pipeline_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestJSONQueryTask(t *testing.T) {
+	t.Parallel()
+
+	bigInt, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+
+	tests := []struct {
+		name              string
+		query             string
+		data              string
+		vars              pipeline.Vars
+		inputs            []pipeline.Result
+		wantData          interface{}
+		wantErrorCause    error
+		wantErrorContains string
+	}{
+		{
+			"field",
+			"data.price",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":{"price":3.14}}`}},
+			3.14,
+			nil,
+			"",
+		},
+		{
+			"filter array",
+			"data[?price > `1`].name",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":[{"name":"foo","price":2},{"name":"bar","price":0.5},{"name":"baz","price":1.5}]}`}},
+			[]interface{}{"foo", "baz"},
+			nil,
+			"",
+		},
+		{
+			"reshape",
+			"{symbol: data.sym, prices: data.quotes[*].usd}",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":{"sym":"ETH","quotes":[{"usd":1},{"usd":2}]}}`}},
+			map[string]interface{}{"symbol": "ETH", "prices": []interface{}{float64(1), float64(2)}},
+			nil,
+			"",
+		},
+		{
+			"function",
+			"max(data[*].price)",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: []byte(`{"data":[{"price":2},{"price":7},{"price":5}]}`)}},
+			float64(7),
+			nil,
+			"",
+		},
+		{
+			"no match",
+			"data.missing",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":{}}`}},
+			nil,
+			nil,
+			"",
+		},
+		{
+			"large int passed through",
+			"data.some_id",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":{"some_id":1564679049192120321}}`}},
+			int64(1564679049192120321),
+			nil,
+			"",
+		},
+		{
+			"big int passed through",
+			"data[0]",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":[123456789012345678901234567890]}`}},
+			bigInt,
+			nil,
+			"",
+		},
+		{
+			"structured input",
+			"foo.bar",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: map[string]interface{}{"foo": map[string]interface{}{"bar": "baz"}}}},
+			"baz",
+			nil,
+			"",
+		},
+		{
+			"data and query from vars",
+			"$(query)",
+			"$(foo.bar)",
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"query": "[?@ > `1`]",
+				"foo":   map[string]interface{}{"bar": `[1, 2, 3]`},
+			}),
+			[]pipeline.Result{},
+			[]interface{}{float64(2), float64(3)},
+			nil,
+			"",
+		},
+		{
+			"invalid query",
+			"data[?",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":[]}`}},
+			nil,
+			pipeline.ErrBadInput,
+			"invalid query",
+		},
+		{
+			"missing query",
+			"",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":[]}`}},
+			nil,
+			pipeline.ErrParameterEmpty,
+			"query",
+		},
+		{
+			"invalid JSON",
+			"data",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Value: `{"data":`}},
+			nil,
+			pipeline.ErrBadInput,
+			"invalid JSON",
+		},
+		{
+			"input error",
+			"data",
+			"",
+			pipeline.NewVarsFrom(nil),
+			[]pipeline.Result{{Error: errors.New("foo")}},
+			nil,
+			pipeline.ErrTooManyErrors,
+			"task inputs",
+		},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.JSONQueryTask{
+				BaseTask: pipeline.NewBaseTask(0, "jsonquery", nil, nil, 0),
+				Query:    test.query,
+				Data:     test.data,
+			}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), test.vars, test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+
+			if test.wantErrorCause != nil {
+				require.Equal(t, test.wantErrorCause, errors.Cause(result.Error))
+				if test.wantErrorContains != "" {
+					require.Contains(t, result.Error.Error(), test.wantErrorContains)
+				}
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.wantData, result.Value)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package pipeline
 
 import (
+	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"math"
@@ -730,6 +731,49 @@ func (p *JSONPathParam) UnmarshalPipelineParam(val interface{}) error {
 	return nil
 }
 
+// JSONParam is a JSON document, or a value produced by another task, decoded
+// into the types used by encoding/json. Numbers are decoded as json.Number.
+type JSONParam struct {
+	Value interface{}
+}
+
+// UnmarshalPipelineParam decodes val if it is a string or []byte, and
+// normalises any other value by encoding it as JSON first.
+func (p *JSONParam) UnmarshalPipelineParam(val interface{}) error {
+	var bs []byte
+	switch v := val.(type) {
+	case string:
+		bs = []byte(v)
+	case []byte:
+		bs = v
+	case *ObjectParam:
+		if v == nil {
+			return errors.Wrap(ErrBadInput, "expected JSON, got nil")
+		}
+		return p.UnmarshalPipelineParam(*v)
+	case ObjectParam:
+		if v.Type == StringType {
+			return p.UnmarshalPipelineParam(string(v.StringValue))
+		}
+	}
+	if bs == nil {
+		var err error
+		bs, err = json.Marshal(val)
+		if err != nil {
+			return errors.Wrapf(ErrBadInput, "expected JSON, got %T: %v", val, err)
+		}
+	}
+
+	var decoded interface{}
+	d := json.NewDecoder(bytes.NewReader(bs))
+	d.UseNumber()
+	if err := d.Decode(&decoded); err != nil {
+		return errors.Wrapf(ErrBadInput, "invalid JSON: %v", err)
+	}
+	p.Value = decoded
+	return nil
+}
+
 type MaybeBigIntParam struct {
 	n *big.Int
 }
@@ -1,6 +1,7 @@
 package pipeline_test
 
 import (
+	"encoding/json"
 	"math"
 	"math/big"
 	"net/url"
@@ -661,6 +662,37 @@ func TestJSONPathParam_UnmarshalPipelineParam(t *testing.T) {
 	}
 }
 
+func TestJSONParam_UnmarshalPipelineParam(t *testing.T) {
+	t.Parallel()
+
+	var nilObjectParam *pipeline.ObjectParam
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		expected interface{}
+		err      error
+	}{
+		{"string", `{"foo":[1,"bar"]}`, map[string]interface{}{"foo": []interface{}{json.Number("1"), "bar"}}, nil},
+		{"[]byte", []byte(`[1.5,true]`), []interface{}{json.Number("1.5"), true}, nil},
+		{"map", map[string]interface{}{"foo": 42}, map[string]interface{}{"foo": json.Number("42")}, nil},
+		{"slice", []interface{}{"foo", nil}, []interface{}{"foo", nil}, nil},
+		{"*object", mustNewObjectParam(t, `{"foo":"bar"}`), map[string]interface{}{"foo": "bar"}, nil},
+		{"object map", *mustNewObjectParam(t, map[string]interface{}{"foo": "bar"}), map[string]interface{}{"foo": "bar"}, nil},
+		{"invalid JSON", `{"foo":`, nil, pipeline.ErrBadInput},
+		{"nil ObjectParam", nilObjectParam, nil, pipeline.ErrBadInput},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var p pipeline.JSONParam
+			err := p.UnmarshalPipelineParam(test.input)
+			require.Equal(t, test.err, errors.Cause(err))
+			require.Equal(t, test.expected, p.Value)
+		})
+	}
+}
+
 func TestResolveValue(t *testing.T) {
 	t.Parallel()
 
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -31,6 +32,7 @@ type Delegate struct {
 	q    pg.Q
 	pr   pipeline.Runner
 	porm pipeline.ORM
+	orm  ORM
 	ks   keystore.Master
 	cc   evm.ChainSet
 	lggr logger.Logger
@@ -46,6 +48,8 @@ type Config interface {
 	EvmFinalityDepth() uint32
 	EvmGasLimitDefault() uint32
 	EvmGasLimitVRFJobType() *uint32
+	JobPipelineReaperInterval() time.Duration
+	JobPipelineReaperThreshold() time.Duration
 	KeySpecificMaxGasPriceWei(addr common.Address) *big.Int
 	MinIncomingConfirmations() uint32
 }
@@ -55,6 +59,7 @@ func NewDelegate(
 	ks keystore.Master,
 	pr pipeline.Runner,
 	porm pipeline.ORM,
+	orm ORM,
 	chainSet evm.ChainSet,
 	lggr logger.Logger,
 	cfg pg.LogConfig) *Delegate {
@@ -63,6 +68,7 @@ func NewDelegate(
 		ks:   ks,
 		pr:   pr,
 		porm: porm,
+		orm:  orm,
 		cc:   chainSet,
 		lggr: lggr,
 	}
@@ -145,12 +151,14 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.ServiceCtx, error) {
 				d.cc,
 				chain.LogBroadcaster(),
 				d.q,
+				d.orm,
 				coordinatorV2,
 				batchCoordinatorV2,
 				aggregator,
 				chain.TxManager(),
 				d.pr,
 				d.ks.Eth(),
+				chain.KeyPool(),
 				jb,
 				utils.NewHighCapacityMailbox[log.Broadcast](),
 				func() {},
@@ -71,7 +71,7 @@ func buildVrfUni(t *testing.T, db *sqlx.DB, cfg *configtest.TestGeneralConfig) v
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{LogBroadcaster: lb, KeyStore: ks.Eth(), Client: ec, DB: db, GeneralConfig: cfg, TxManager: txm})
 	jrm := job.NewORM(db, cc, prm, ks, lggr, cfg)
 	t.Cleanup(func() { jrm.Close() })
-	pr := pipeline.NewRunner(prm, cfg, cc, ks.Eth(), ks.VRF(), lggr, nil, nil)
+	pr := pipeline.NewRunner(prm, cfg, cc, ks.Eth(), ks.VRF(), ks, lggr, nil, nil)
 	require.NoError(t, ks.Unlock(testutils.Password))
 	k, err := ks.Eth().Create(testutils.FixtureChainID)
 	require.NoError(t, err)
@@ -138,6 +138,7 @@ func setup(t *testing.T) (vrfUniverse, *listenerV1, job.Job) {
 		vuni.ks,
 		vuni.pr,
 		vuni.prm,
+		NewORM(db, logger.TestLogger(t), cfg),
 		vuni.cc,
 		logger.TestLogger(t),
 		cfg)
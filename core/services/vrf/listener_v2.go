@@ -86,12 +86,14 @@ func newListenerV2(
 	keyUpdater keyConfigUpdater,
 	logBroadcaster log.Broadcaster,
 	q pg.Q,
+	orm ORM,
 	coordinator vrf_coordinator_v2.VRFCoordinatorV2Interface,
 	batchCoordinator batch_vrf_coordinator_v2.BatchVRFCoordinatorV2Interface,
 	aggregator *aggregator_v3_interface.AggregatorV3Interface,
 	txm txmgr.TxManager,
 	pipelineRunner pipeline.Runner,
 	gethks keystore.Eth,
+	keyPool txmgr.EthKeyPool,
 	job job.Job,
 	reqLogs *utils.Mailbox[log.Broadcast],
 	reqAdded func(),
@@ -111,7 +113,9 @@ func newListenerV2(
 		pipelineRunner:     pipelineRunner,
 		job:                job,
 		q:                  q,
+		orm:                orm,
 		gethks:             gethks,
+		keyPool:            keyPool,
 		reqLogs:            reqLogs,
 		chStop:             make(chan struct{}),
 		reqAdded:           reqAdded,
@@ -167,9 +171,16 @@ type listenerV2 struct {
 	pipelineRunner pipeline.Runner
 	job            job.Job
 	q              pg.Q
-	gethks         keystore.Eth
-	reqLogs        *utils.Mailbox[log.Broadcast]
-	chStop         chan struct{}
+	// orm records the lifecycle of the requests
+	orm ORM
+	// lifecycles buffers the lifecycle updates of a pass of the request
+	// handler. It is only used by the request handler.
+	lifecycles lifecycleUpdates
+	gethks     keystore.Eth
+	// keyPool picks the least loaded of the job's from addresses for fulfillments
+	keyPool txmgr.EthKeyPool
+	reqLogs *utils.Mailbox[log.Broadcast]
+	chStop  chan struct{}
 	// We can keep these pending logs in memory because we
 	// only mark them confirmed once we send a corresponding fulfillment transaction.
 	// So on node restart in the middle of processing, the lb will resend them.
@@ -257,6 +268,12 @@ func (lsn *listenerV2) Start(ctx context.Context) error {
 		go func() {
 			lsn.runRequestHandler(spec.PollPeriod, lsn.wg)
 		}()
+
+		// Lifecycles of finished requests are reaped along with the pipeline runs.
+		if lsn.cfg.JobPipelineReaperInterval() != time.Duration(0) {
+			lsn.wg.Add(1)
+			go lsn.runLifecycleReaper(lsn.wg)
+		}
 		return nil
 	})
 }
@@ -394,6 +411,7 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context) {
 				}
 			}
 		}
+		lsn.flushLifecycleUpdates(toKeep)
 		// There could be logs accumulated to this slice while request processor is running,
 		// so we merged the new ones with the ones that need to be requeued.
 		lsn.reqsMu.Lock()
@@ -424,11 +442,14 @@ func (lsn *listenerV2) processPendingVRFRequests(ctx context.Context) {
 					lsn.l.Infow("Skipping requests without valid subscription", "subID", subID, "reqID", req.req.RequestId)
 					processed[req.req.RequestId.String()] = struct{}{}
 				}
+				lsn.recordRequestStatus(reqs, RequestStatusSkipped, "subscription not found")
 			} else {
 				lsn.l.Errorw("Unable to read subscription balance", "subID", subID, "err", err)
+				lsn.recordRequestStatus(reqs, RequestStatusRetrying, fmt.Sprintf("unable to read subscription balance: %v", err))
 			}
 			continue
 		}
+		lsn.recordSubscriptionBalance(reqs, sub.Balance)
 
 		// Sort requests in ascending order by CallbackGasLimit
 		// so that we process the "cheapest" requests for each subscription
@@ -506,6 +527,7 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 		lsn.q, startBalance, lsn.chainID.Uint64(), subID)
 	if err != nil {
 		lsn.l.Errorw("Couldn't get reserved LINK for subscription", "sub", reqs[0].req.SubId, "err", err)
+		lsn.recordRequestStatus(reqs, RequestStatusRetrying, fmt.Sprintf("unable to get reserved LINK of subscription: %v", err))
 		return processed
 	}
 
@@ -567,13 +589,16 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 		} else if err != nil {
 			l.Errorw("Error checking for already fulfilled requests, proceeding anyway", "err", err)
 		}
+		var fulfilled []pendingRequest
 		for i, a := range alreadyFulfilled {
 			if a {
 				processed[chunk[i].req.RequestId.String()] = struct{}{}
+				fulfilled = append(fulfilled, chunk[i])
 			} else {
 				unfulfilled = append(unfulfilled, chunk[i])
 			}
 		}
+		lsn.recordRequestStatus(fulfilled, RequestStatusSkipped, "already fulfilled")
 
 		fromAddresses := lsn.fromAddresses()
 		err = setMaxGasPriceGWei(fromAddresses, lsn.job.VRFSpec.MaxGasPriceGWei, lsn.keyUpdater, lsn.chainID)
@@ -582,9 +607,10 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 				"err", err, "fromAddresses", fromAddresses)
 		}
 
-		fromAddress, err := lsn.gethks.GetRoundRobinAddress(lsn.chainID, fromAddresses...)
+		fromAddress, err := lsn.keyPool.LeastLoadedAddress(ctx, fromAddresses...)
 		if err != nil {
 			l.Errorw("Couldn't get next from address", "err", err)
+			lsn.recordRequestStatus(unfulfilled, RequestStatusRetrying, fmt.Sprintf("unable to get a from address: %v", err))
 			continue
 		}
 		maxGasPriceWei := lsn.cfg.KeySpecificMaxGasPriceWei(fromAddress)
@@ -615,6 +641,7 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 			if p.err != nil {
 				if startBalanceNoReserveLink.Cmp(p.juelsNeeded) < 0 && errors.Is(p.err, errPossiblyInsufficientFunds{}) {
 					ll.Infow("Insufficient link balance to fulfill a request based on estimate, breaking", "err", p.err)
+					lsn.recordInsufficientBalance(p.req, p.juelsNeeded, startBalanceNoReserveLink)
 					outOfBalance = true
 
 					// break out of this inner loop to process the currently constructed batch
@@ -628,6 +655,7 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 				} else {
 					ll.Errorw("Pipeline error", "err", p.err)
 				}
+				lsn.recordRequestStatus([]pendingRequest{p.req}, RequestStatusRetrying, fmt.Sprintf("pipeline error: %v", p.err))
 				continue
 			}
 
@@ -636,6 +664,7 @@ func (lsn *listenerV2) processRequestsPerSubBatch(
 				// Break out of the loop now and process what we are able to process
 				// in the constructed batches.
 				ll.Infow("Insufficient link balance to fulfill a request, breaking")
+				lsn.recordInsufficientBalance(p.req, p.maxLink, startBalanceNoReserveLink)
 				break
 			}
 
@@ -683,6 +712,7 @@ func (lsn *listenerV2) processRequestsPerSub(
 		lsn.q, startBalance, lsn.ethClient.ChainID().Uint64(), subID)
 	if err != nil {
 		lsn.l.Errorw("Couldn't get reserved LINK for subscription", "sub", reqs[0].req.SubId, "err", err)
+		lsn.recordRequestStatus(reqs, RequestStatusRetrying, fmt.Sprintf("unable to get reserved LINK of subscription: %v", err))
 		return processed
 	}
 
@@ -725,13 +755,16 @@ func (lsn *listenerV2) processRequestsPerSub(
 		} else if err != nil {
 			l.Errorw("Error checking for already fulfilled requests, proceeding anyway", "err", err)
 		}
+		var fulfilled []pendingRequest
 		for i, a := range alreadyFulfilled {
 			if a {
 				processed[chunk[i].req.RequestId.String()] = struct{}{}
+				fulfilled = append(fulfilled, chunk[i])
 			} else {
 				unfulfilled = append(unfulfilled, chunk[i])
 			}
 		}
+		lsn.recordRequestStatus(fulfilled, RequestStatusSkipped, "already fulfilled")
 
 		fromAddresses := lsn.fromAddresses()
 		err = setMaxGasPriceGWei(fromAddresses, lsn.job.VRFSpec.MaxGasPriceGWei, lsn.keyUpdater, lsn.chainID)
@@ -740,9 +773,10 @@ func (lsn *listenerV2) processRequestsPerSub(
 				"err", err, "fromAddresses", fromAddresses)
 		}
 
-		fromAddress, err := lsn.gethks.GetRoundRobinAddress(lsn.chainID, fromAddresses...)
+		fromAddress, err := lsn.keyPool.LeastLoadedAddress(ctx, fromAddresses...)
 		if err != nil {
 			l.Errorw("Couldn't get next from address", "err", err)
+			lsn.recordRequestStatus(unfulfilled, RequestStatusRetrying, fmt.Sprintf("unable to get a from address: %v", err))
 			continue
 		}
 		maxGasPriceWei := lsn.cfg.KeySpecificMaxGasPriceWei(fromAddress)
@@ -765,6 +799,7 @@ func (lsn *listenerV2) processRequestsPerSub(
 			if p.err != nil {
 				if startBalanceNoReserveLink.Cmp(p.juelsNeeded) < 0 && errors.Is(p.err, errPossiblyInsufficientFunds{}) {
 					ll.Infow("Insufficient link balance to fulfill a request based on estimate, returning", "err", p.err)
+					lsn.recordInsufficientBalance(p.req, p.juelsNeeded, startBalanceNoReserveLink)
 					return processed
 				}
 
@@ -775,12 +810,14 @@ func (lsn *listenerV2) processRequestsPerSub(
 				} else {
 					ll.Errorw("Pipeline error", "err", p.err)
 				}
+				lsn.recordRequestStatus([]pendingRequest{p.req}, RequestStatusRetrying, fmt.Sprintf("pipeline error: %v", p.err))
 				continue
 			}
 
 			if startBalanceNoReserveLink.Cmp(p.maxLink) < 0 {
 				// Insufficient funds, have to wait for a user top up. Leave it unprocessed for now
 				ll.Infow("Insufficient link balance to fulfill a request, returning")
+				lsn.recordInsufficientBalance(p.req, p.maxLink, startBalanceNoReserveLink)
 				return processed
 			}
 
@@ -815,10 +852,14 @@ func (lsn *listenerV2) processRequestsPerSub(
 						VRFRequestBlockNumber: new(big.Int).SetUint64(p.req.req.Raw.BlockNumber),
 					},
 				}, pg.WithQueryer(tx), pg.WithParentCtx(ctx))
-				return err
+				if err != nil {
+					return err
+				}
+				return lsn.orm.UpdateRequestEnqueued(lsn.job.ID, p.req.req.RequestId.String(), p.run.ID, ethTX.ID, pg.WithQueryer(tx))
 			})
 			if err != nil {
 				ll.Errorw("Error enqueuing fulfillment, requeuing request", "err", err)
+				lsn.recordRequestStatus([]pendingRequest{p.req}, RequestStatusRetrying, fmt.Sprintf("error enqueuing fulfillment: %v", err))
 				continue
 			}
 			ll.Infow("Enqueued fulfillment", "ethTxID", ethTX.ID)
@@ -1064,6 +1105,31 @@ func (lsn *listenerV2) runRequestHandler(pollPeriod time.Duration, wg *sync.Wait
 	}
 }
 
+func (lsn *listenerV2) runLifecycleReaper(wg *sync.WaitGroup) {
+	defer wg.Done()
+	tick := time.NewTicker(utils.WithJitter(lsn.cfg.JobPipelineReaperInterval()))
+	defer tick.Stop()
+	for {
+		select {
+		case <-lsn.chStop:
+			return
+		case <-tick.C:
+			lsn.reapLifecycles()
+			tick.Reset(utils.WithJitter(lsn.cfg.JobPipelineReaperInterval()))
+		}
+	}
+}
+
+// reapLifecycles deletes the lifecycles of the requests which were fulfilled
+// or skipped longer than the pipeline runs are kept.
+func (lsn *listenerV2) reapLifecycles() {
+	ctx, cancel := utils.ContextFromChanWithDeadline(lsn.chStop, lsn.cfg.JobPipelineReaperInterval())
+	defer cancel()
+	if err := lsn.orm.DeleteRequestLifecyclesOlderThan(ctx, lsn.job.ID, lsn.cfg.JobPipelineReaperThreshold()); err != nil {
+		lsn.l.Errorw("Request lifecycle reaper failed", "err", err)
+	}
+}
+
 func (lsn *listenerV2) runLogListener(unsubscribes []func(), minConfs uint32, wg *sync.WaitGroup) {
 	defer wg.Done()
 	lsn.l.Infow("Listening for run requests",
@@ -1135,6 +1201,8 @@ func (lsn *listenerV2) handleLog(lb log.Broadcast, minConfs uint32) {
 			blockNumber: v.Raw.BlockNumber,
 			reqID:       v.RequestId.String(),
 		})
+		err = lsn.orm.UpdateRequestFulfilled(lsn.job.ID, v.RequestId.String(), v.Raw.TxHash, v.Raw.BlockNumber, v.Success)
+		lsn.l.ErrorIf(err, "Unable to record fulfillment of request")
 		lsn.markLogAsConsumed(lb)
 		return
 	}
@@ -1155,6 +1223,16 @@ func (lsn *listenerV2) handleLog(lb log.Broadcast, minConfs uint32) {
 
 	confirmedAt := lsn.getConfirmedAt(req, minConfs)
 	lsn.l.Infow("VRFListenerV2: Received log request", "reqID", req.RequestId, "confirmedAt", confirmedAt, "subID", req.SubId, "sender", req.Sender)
+	err = lsn.orm.CreateRequestLifecycle(&RequestLifecycle{
+		JobID:              lsn.job.ID,
+		RequestID:          req.RequestId.String(),
+		SubID:              req.SubId,
+		Status:             RequestStatusWaiting,
+		RequestTxHash:      req.Raw.TxHash,
+		RequestBlockNumber: req.Raw.BlockNumber,
+		ConfirmedAtBlock:   confirmedAt,
+	})
+	lsn.l.ErrorIf(err, "Unable to record request")
 	lsn.reqsMu.Lock()
 	lsn.reqs = append(lsn.reqs, pendingRequest{
 		confirmedAtBlock: confirmedAt,
@@ -1171,6 +1249,43 @@ func (lsn *listenerV2) markLogAsConsumed(lb log.Broadcast) {
 	lsn.l.ErrorIf(err, fmt.Sprintf("Unable to mark log %v as consumed", lb.String()))
 }
 
+// recordRequestStatus records in the lifecycle of the requests why an attempt
+// did not fulfill them. It is written at the end of the pass.
+func (lsn *listenerV2) recordRequestStatus(reqs []pendingRequest, status RequestStatus, reason string) {
+	for _, req := range reqs {
+		lsn.lifecycles.addStatus(RequestStatusUpdate{
+			RequestID: req.req.RequestId.String(),
+			Status:    status,
+			Reason:    reason,
+			Attempt:   int32(req.attempts + 1),
+		})
+	}
+}
+
+// recordSubscriptionBalance records in the lifecycle of the requests the
+// balance of their subscription. It is written at the end of the pass.
+func (lsn *listenerV2) recordSubscriptionBalance(reqs []pendingRequest, balance *big.Int) {
+	for _, req := range reqs {
+		lsn.lifecycles.addBalance(req.req.RequestId.String(), balance)
+	}
+}
+
+// flushLifecycleUpdates writes the lifecycle updates of a pass which differ
+// from the ones last written, and forgets the requests which are not kept.
+func (lsn *listenerV2) flushLifecycleUpdates(kept []pendingRequest) {
+	requestIDs, balances, statuses := lsn.lifecycles.changed()
+	err := lsn.orm.UpdateRequestSubscriptions(lsn.job.ID, requestIDs, balances)
+	lsn.l.ErrorIf(err, "Unable to record subscription balance of requests")
+	err2 := lsn.orm.UpdateRequestStatuses(lsn.job.ID, statuses)
+	lsn.l.ErrorIf(err2, "Unable to record status of requests")
+	lsn.lifecycles.flushed(err == nil, err2 == nil, kept)
+}
+
+func (lsn *listenerV2) recordInsufficientBalance(req pendingRequest, needed, balance *big.Int) {
+	lsn.recordRequestStatus([]pendingRequest{req}, RequestStatusRetrying, fmt.Sprintf(
+		"insufficient subscription balance: %s juels needed, %s juels available", needed, balance))
+}
+
 // Close complies with job.Service
 func (lsn *listenerV2) Close() error {
 	return lsn.StopOnce("VRFListenerV2", func() error {
@@ -1,6 +1,7 @@
 package vrf
 
 import (
+	"fmt"
 	"math/big"
 	"time"
 
@@ -163,11 +164,27 @@ func (lsn *listenerV2) processBatch(
 				RequestTxHashes: txHashes,
 			},
 		}, pg.WithQueryer(tx))
+		if err != nil {
+			return errors.Wrap(err, "create batch fulfillment eth transaction")
+		}
 
-		return errors.Wrap(err, "create batch fulfillment eth transaction")
+		for i, reqID := range batch.reqIDs {
+			err = lsn.orm.UpdateRequestEnqueued(lsn.job.ID, reqID.String(), batch.runs[i].ID, ethTX.ID, pg.WithQueryer(tx))
+			if err != nil {
+				return errors.Wrap(err, "recording enqueued requests")
+			}
+		}
+		return nil
 	})
 	if err != nil {
 		ll.Errorw("Error enqueuing batch fulfillments, requeuing requests", "err", err)
+		for _, reqID := range batch.reqIDs {
+			lsn.lifecycles.addStatus(RequestStatusUpdate{
+				RequestID: reqID.String(),
+				Status:    RequestStatusRetrying,
+				Reason:    fmt.Sprintf("error enqueuing batch fulfillment: %v", err),
+			})
+		}
 		return
 	}
 	ll.Infow("Enqueued fulfillment", "ethTxID", ethTX.ID)
@@ -187,6 +204,10 @@ func (lsn *listenerV2) processBatch(
 // getUnconsumed returns the requests in the given slice that are not expired
 // and not marked consumed in the log broadcaster.
 func (lsn *listenerV2) getUnconsumed(l logger.Logger, reqs []pendingRequest) (unconsumed []pendingRequest, processed []string) {
+	var expired []pendingRequest
+	defer func() {
+		lsn.recordRequestStatus(expired, RequestStatusSkipped, "request timed out")
+	}()
 	for _, req := range reqs {
 		// Check if we can ignore the request due to its age.
 		if time.Now().UTC().Sub(req.utcTimestamp) >= lsn.job.VRFSpec.RequestTimeout {
@@ -195,6 +216,7 @@ func (lsn *listenerV2) getUnconsumed(l logger.Logger, reqs []pendingRequest) (un
 				"txHash", req.req.Raw.TxHash)
 			lsn.markLogAsConsumed(req.lb)
 			processed = append(processed, req.req.RequestId.String())
+			expired = append(expired, req)
 			incDroppedReqs(lsn.job.Name.ValueOrZero(), lsn.job.ExternalJobID, v2, reasonAge)
 			continue
 		}
@@ -226,3 +248,75 @@ func batchFulfillmentGasEstimate(
 		gasMultiplier * float64((uint64(maxCallbackGasLimit)+400_000)+batchSize*BatchFulfillmentIterationGasCost),
 	)
 }
+
+// lifecycleUpdates buffers the subscription balances and statuses recorded in
+// the lifecycles of the requests during a pass of the request handler, so that
+// they are written with one query each at the end of the pass. Updates which
+// do not change what was last written for a request are dropped.
+type lifecycleUpdates struct {
+	balances map[string]*big.Int
+	statuses map[string]RequestStatusUpdate
+	// writtenBalances and writtenStatuses are what was last written for the
+	// pending requests.
+	writtenBalances map[string]*big.Int
+	writtenStatuses map[string]RequestStatusUpdate
+}
+
+func (u *lifecycleUpdates) addBalance(requestID string, balance *big.Int) {
+	if u.balances == nil {
+		u.balances = make(map[string]*big.Int)
+	}
+	u.balances[requestID] = balance
+}
+
+// addStatus buffers the status of a request, replacing the one buffered
+// earlier in the pass.
+func (u *lifecycleUpdates) addStatus(update RequestStatusUpdate) {
+	if u.statuses == nil {
+		u.statuses = make(map[string]RequestStatusUpdate)
+	}
+	u.statuses[update.RequestID] = update
+}
+
+// changed returns the buffered updates which differ from the ones last
+// written.
+func (u *lifecycleUpdates) changed() (requestIDs []string, balances []*big.Int, statuses []RequestStatusUpdate) {
+	for requestID, balance := range u.balances {
+		if written, ok := u.writtenBalances[requestID]; ok && written.Cmp(balance) == 0 {
+			continue
+		}
+		requestIDs = append(requestIDs, requestID)
+		balances = append(balances, balance)
+	}
+	for requestID, update := range u.statuses {
+		if written, ok := u.writtenStatuses[requestID]; ok && written.Status == update.Status && written.Reason == update.Reason {
+			continue
+		}
+		statuses = append(statuses, update)
+	}
+	return
+}
+
+// flushed clears the buffered updates, remembering them as written if their
+// query succeeded. Only the requests which are kept pending are remembered.
+func (u *lifecycleUpdates) flushed(balancesOK, statusesOK bool, kept []pendingRequest) {
+	writtenBalances := make(map[string]*big.Int)
+	writtenStatuses := make(map[string]RequestStatusUpdate)
+	for _, req := range kept {
+		requestID := req.req.RequestId.String()
+		if balance, ok := u.balances[requestID]; ok && balancesOK {
+			writtenBalances[requestID] = balance
+		} else if balance, ok := u.writtenBalances[requestID]; ok {
+			writtenBalances[requestID] = balance
+		}
+		if update, ok := u.statuses[requestID]; ok && statusesOK {
+			writtenStatuses[requestID] = update
+		} else if update, ok := u.writtenStatuses[requestID]; ok {
+			writtenStatuses[requestID] = update
+		}
+	}
+	u.balances = nil
+	u.statuses = nil
+	u.writtenBalances = writtenBalances
+	u.writtenStatuses = writtenStatuses
+}
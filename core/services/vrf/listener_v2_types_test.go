@@ -51,3 +51,44 @@ func Test_BatchFulfillments_AddRun(t *testing.T) {
 	})
 	require.Len(t, bfs.fulfillments, 2)
 }
+
+func Test_LifecycleUpdates(t *testing.T) {
+	newReq := func(id int64) pendingRequest {
+		return pendingRequest{req: &vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{RequestId: big.NewInt(id)}}
+	}
+	retrying := RequestStatusUpdate{RequestID: "1", Status: RequestStatusRetrying, Reason: "pipeline error", Attempt: 1}
+
+	var u lifecycleUpdates
+	u.addBalance("1", big.NewInt(100))
+	u.addStatus(RequestStatusUpdate{RequestID: "1", Status: RequestStatusWaiting})
+	u.addStatus(retrying)
+	requestIDs, balances, statuses := u.changed()
+	require.Equal(t, []string{"1"}, requestIDs)
+	require.Equal(t, []*big.Int{big.NewInt(100)}, balances)
+	require.Equal(t, []RequestStatusUpdate{retrying}, statuses)
+	u.flushed(true, true, []pendingRequest{newReq(1)})
+
+	// Unchanged updates are dropped
+	u.addBalance("1", big.NewInt(100))
+	retrying.Attempt = 2
+	u.addStatus(retrying)
+	requestIDs, balances, statuses = u.changed()
+	require.Empty(t, requestIDs)
+	require.Empty(t, balances)
+	require.Empty(t, statuses)
+	u.flushed(true, true, []pendingRequest{newReq(1)})
+
+	// Updates which failed to be written are written again
+	u.addBalance("1", big.NewInt(50))
+	requestIDs, _, _ = u.changed()
+	require.Equal(t, []string{"1"}, requestIDs)
+	u.flushed(false, true, []pendingRequest{newReq(1)})
+	u.addBalance("1", big.NewInt(50))
+	requestIDs, _, _ = u.changed()
+	require.Equal(t, []string{"1"}, requestIDs)
+
+	// Requests which are not kept are forgotten
+	u.flushed(true, true, nil)
+	require.Empty(t, u.writtenBalances)
+	require.Empty(t, u.writtenStatuses)
+}
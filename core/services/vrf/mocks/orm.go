@@ -0,0 +1,153 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	big "math/big"
+
+	common "github.com/ethereum/go-ethereum/common"
+
+	mock "github.com/stretchr/testify/mock"
+
+	pg "github.com/smartcontractkit/chainlink/core/services/pg"
+
+	vrf "github.com/smartcontractkit/chainlink/core/services/vrf"
+
+	context "context"
+
+	time "time"
+)
+
+// ORM is an autogenerated mock type for the ORM type
+type ORM struct {
+	mock.Mock
+}
+
+// CreateRequestLifecycle provides a mock function with given fields: l
+func (_m *ORM) CreateRequestLifecycle(l *vrf.RequestLifecycle) error {
+	ret := _m.Called(l)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*vrf.RequestLifecycle) error); ok {
+		r0 = rf(l)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRequestLifecyclesOlderThan provides a mock function with given fields: ctx, jobID, threshold
+func (_m *ORM) DeleteRequestLifecyclesOlderThan(ctx context.Context, jobID int32, threshold time.Duration) error {
+	ret := _m.Called(ctx, jobID, threshold)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, time.Duration) error); ok {
+		r0 = rf(ctx, jobID, threshold)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindRequestLifecycles provides a mock function with given fields: requestID
+func (_m *ORM) FindRequestLifecycles(requestID string) ([]vrf.RequestLifecycle, error) {
+	ret := _m.Called(requestID)
+
+	var r0 []vrf.RequestLifecycle
+	if rf, ok := ret.Get(0).(func(string) []vrf.RequestLifecycle); ok {
+		r0 = rf(requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]vrf.RequestLifecycle)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateRequestEnqueued provides a mock function with given fields: jobID, requestID, pipelineRunID, ethTxID, qopts
+func (_m *ORM) UpdateRequestEnqueued(jobID int32, requestID string, pipelineRunID int64, ethTxID int64, qopts ...pg.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, jobID, requestID, pipelineRunID, ethTxID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, string, int64, int64, ...pg.QOpt) error); ok {
+		r0 = rf(jobID, requestID, pipelineRunID, ethTxID, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateRequestFulfilled provides a mock function with given fields: jobID, requestID, txHash, blockNumber, success
+func (_m *ORM) UpdateRequestFulfilled(jobID int32, requestID string, txHash common.Hash, blockNumber uint64, success bool) error {
+	ret := _m.Called(jobID, requestID, txHash, blockNumber, success)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, string, common.Hash, uint64, bool) error); ok {
+		r0 = rf(jobID, requestID, txHash, blockNumber, success)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateRequestStatuses provides a mock function with given fields: jobID, updates
+func (_m *ORM) UpdateRequestStatuses(jobID int32, updates []vrf.RequestStatusUpdate) error {
+	ret := _m.Called(jobID, updates)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, []vrf.RequestStatusUpdate) error); ok {
+		r0 = rf(jobID, updates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateRequestSubscriptions provides a mock function with given fields: jobID, requestIDs, balances
+func (_m *ORM) UpdateRequestSubscriptions(jobID int32, requestIDs []string, balances []*big.Int) error {
+	ret := _m.Called(jobID, requestIDs, balances)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, []string, []*big.Int) error); ok {
+		r0 = rf(jobID, requestIDs, balances)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewORM interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewORM creates a new instance of ORM. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewORM(t mockConstructorTestingTNewORM) *ORM {
+	mock := &ORM{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
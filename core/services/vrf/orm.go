@@ -0,0 +1,243 @@
+package vrf
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+//go:generate mockery --name ORM --output ./mocks --case=underscore
+
+// ORM records the lifecycle of the VRF v2 requests seen by VRF jobs.
+type ORM interface {
+	CreateRequestLifecycle(l *RequestLifecycle) error
+	UpdateRequestSubscriptions(jobID int32, requestIDs []string, balances []*big.Int) error
+	UpdateRequestStatuses(jobID int32, updates []RequestStatusUpdate) error
+	UpdateRequestEnqueued(jobID int32, requestID string, pipelineRunID, ethTxID int64, qopts ...pg.QOpt) error
+	UpdateRequestFulfilled(jobID int32, requestID string, txHash common.Hash, blockNumber uint64, success bool) error
+	FindRequestLifecycles(requestID string) ([]RequestLifecycle, error)
+	DeleteRequestLifecyclesOlderThan(ctx context.Context, jobID int32, threshold time.Duration) error
+}
+
+// RequestStatus is the stage a VRF v2 request has reached.
+type RequestStatus string
+
+const (
+	// RequestStatusWaiting is used for requests whose log was seen, until
+	// they are confirmed and processed.
+	RequestStatusWaiting RequestStatus = "waiting"
+	// RequestStatusRetrying is used for requests which could not be fulfilled
+	// on the last attempt, and will be retried.
+	RequestStatusRetrying RequestStatus = "retrying"
+	// RequestStatusSkipped is used for requests which will not be fulfilled
+	// by the job.
+	RequestStatusSkipped RequestStatus = "skipped"
+	// RequestStatusEnqueued is used for requests whose fulfillment
+	// transaction was created.
+	RequestStatusEnqueued RequestStatus = "enqueued"
+	// RequestStatusFulfilled is used for requests whose fulfillment log was
+	// seen, whether this node fulfilled them or not.
+	RequestStatusFulfilled RequestStatus = "fulfilled"
+)
+
+// RequestStatusUpdate is the outcome of an attempt which did not fulfill a
+// request.
+type RequestStatusUpdate struct {
+	RequestID string
+	Status    RequestStatus
+	Reason    string
+	// Attempt is the number of the attempt, starting at 1.
+	Attempt int32
+}
+
+// RequestLifecycle is the lifecycle of a VRF v2 request, as seen by the
+// listener of a VRF job.
+type RequestLifecycle struct {
+	JobID              int32
+	RequestID          string
+	SubID              uint64
+	Status             RequestStatus
+	RequestTxHash      common.Hash
+	RequestBlockNumber uint64
+	// ConfirmedAtBlock is the block the request waits for before it is
+	// processed.
+	ConfirmedAtBlock      uint64
+	LogSeenAt             time.Time
+	Attempts              int32
+	SubscriptionBalance   *utils.Big
+	SubscriptionCheckedAt null.Time
+	// SkipReason is the reason the last attempt did not fulfill the request.
+	SkipReason    null.String
+	PipelineRunID null.Int
+	EthTxID       null.Int
+	// The fulfillment log, which may come from another node.
+	FulfillmentLogTxHash      *common.Hash
+	FulfillmentLogBlockNumber null.Int
+	FulfillmentSuccess        null.Bool
+	UpdatedAt                 time.Time
+
+	// EthTxState, FulfillmentTxHash and FulfillmentReceiptBlockNumber
+	// describe the fulfillment transaction of this node. They are only
+	// loaded by FindRequestLifecycles.
+	EthTxState                    null.String
+	FulfillmentTxHash             *common.Hash
+	FulfillmentReceiptBlockNumber null.Int
+}
+
+type orm struct {
+	q pg.Q
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ORM {
+	namedLogger := lggr.Named("VRFORM")
+	return &orm{pg.NewQ(db, namedLogger, cfg)}
+}
+
+// CreateRequestLifecycle records that the log of a request was seen. A log
+// delivered again, e.g. after a reorg, updates the request's block, unless
+// its fulfillment was already enqueued.
+func (o *orm) CreateRequestLifecycle(l *RequestLifecycle) error {
+	sql := `INSERT INTO vrf_v2_request_lifecycles (job_id, request_id, sub_id, status, request_tx_hash, request_block_number, confirmed_at_block, log_seen_at, updated_at)
+	VALUES (:job_id, :request_id, :sub_id, :status, :request_tx_hash, :request_block_number, :confirmed_at_block, now(), now())
+	ON CONFLICT (job_id, request_id) DO UPDATE SET
+		request_tx_hash = EXCLUDED.request_tx_hash,
+		request_block_number = EXCLUDED.request_block_number,
+		confirmed_at_block = EXCLUDED.confirmed_at_block,
+		status = CASE WHEN vrf_v2_request_lifecycles.status IN ('enqueued', 'fulfilled') THEN vrf_v2_request_lifecycles.status ELSE EXCLUDED.status END,
+		updated_at = now()
+	RETURNING log_seen_at, updated_at;`
+	return errors.Wrap(o.q.GetNamed(sql, l, l), "CreateRequestLifecycle failed")
+}
+
+// UpdateRequestSubscriptions records the subscription balance each request
+// was checked against. Unchanged balances are not written again, so
+// subscription_checked_at is when the balance was last found changed.
+func (o *orm) UpdateRequestSubscriptions(jobID int32, requestIDs []string, balances []*big.Int) error {
+	if len(requestIDs) == 0 {
+		return nil
+	}
+	decimals := make([]string, len(balances))
+	for i, balance := range balances {
+		decimals[i] = balance.String()
+	}
+	sql := `UPDATE vrf_v2_request_lifecycles l SET subscription_balance = u.balance, subscription_checked_at = now(), updated_at = now()
+	FROM unnest($2::text[], $3::numeric[]) AS u(request_id, balance)
+	WHERE l.job_id = $1 AND l.request_id = u.request_id AND l.subscription_balance IS DISTINCT FROM u.balance;`
+	err := o.q.ExecQ(sql, jobID, pq.Array(requestIDs), pq.Array(decimals))
+	return errors.Wrap(err, "UpdateRequestSubscriptions failed")
+}
+
+// UpdateRequestStatuses records attempts which did not fulfill the requests.
+// Requests whose fulfillment was already enqueued are left unchanged, as are
+// requests whose status and reason did not change.
+func (o *orm) UpdateRequestStatuses(jobID int32, updates []RequestStatusUpdate) error {
+	if len(updates) == 0 {
+		return nil
+	}
+	requestIDs := make([]string, len(updates))
+	statuses := make([]string, len(updates))
+	reasons := make([]string, len(updates))
+	attempts := make([]int32, len(updates))
+	for i, u := range updates {
+		requestIDs[i] = u.RequestID
+		statuses[i] = string(u.Status)
+		reasons[i] = u.Reason
+		attempts[i] = u.Attempt
+	}
+	sql := `UPDATE vrf_v2_request_lifecycles l SET status = u.status, skip_reason = u.reason, attempts = GREATEST(l.attempts, u.attempt), updated_at = now()
+	FROM unnest($2::text[], $3::text[], $4::text[], $5::int[]) AS u(request_id, status, reason, attempt)
+	WHERE l.job_id = $1 AND l.request_id = u.request_id AND l.status NOT IN ('enqueued', 'fulfilled')
+	AND (l.status IS DISTINCT FROM u.status OR l.skip_reason IS DISTINCT FROM u.reason);`
+	err := o.q.ExecQ(sql, jobID, pq.Array(requestIDs), pq.Array(statuses), pq.Array(reasons), pq.Array(attempts))
+	return errors.Wrap(err, "UpdateRequestStatuses failed")
+}
+
+// UpdateRequestEnqueued records the pipeline run and the transaction of a
+// request's fulfillment.
+func (o *orm) UpdateRequestEnqueued(jobID int32, requestID string, pipelineRunID, ethTxID int64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	sql := `UPDATE vrf_v2_request_lifecycles SET status = 'enqueued', skip_reason = NULL, attempts = attempts + 1,
+		pipeline_run_id = $3, eth_tx_id = $4, updated_at = now()
+	WHERE job_id = $1 AND request_id = $2;`
+	err := q.ExecQ(sql, jobID, requestID, pipelineRunID, ethTxID)
+	return errors.Wrap(err, "UpdateRequestEnqueued failed")
+}
+
+// UpdateRequestFulfilled records the fulfillment log of a request.
+func (o *orm) UpdateRequestFulfilled(jobID int32, requestID string, txHash common.Hash, blockNumber uint64, success bool) error {
+	sql := `UPDATE vrf_v2_request_lifecycles SET status = 'fulfilled', fulfillment_log_tx_hash = $3,
+		fulfillment_log_block_number = $4, fulfillment_success = $5, updated_at = now()
+	WHERE job_id = $1 AND request_id = $2;`
+	err := o.q.ExecQ(sql, jobID, requestID, txHash, blockNumber, success)
+	return errors.Wrap(err, "UpdateRequestFulfilled failed")
+}
+
+// FindRequestLifecycles returns the lifecycle of a request for each job which
+// saw it, the most recently updated first. The fulfillment transaction is
+// described by its latest broadcast attempt, preferring one with a receipt.
+func (o *orm) FindRequestLifecycles(requestID string) (lifecycles []RequestLifecycle, err error) {
+	sql := `SELECT l.*, eth_txes.state AS eth_tx_state, a.hash AS fulfillment_tx_hash, a.block_number AS fulfillment_receipt_block_number
+	FROM vrf_v2_request_lifecycles l
+	LEFT JOIN eth_txes ON eth_txes.id = l.eth_tx_id
+	LEFT JOIN LATERAL (
+		SELECT eth_tx_attempts.hash, eth_receipts.block_number FROM eth_tx_attempts
+		LEFT JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+		WHERE eth_tx_attempts.eth_tx_id = l.eth_tx_id AND eth_tx_attempts.state = 'broadcast'
+		ORDER BY eth_receipts.block_number IS NULL, eth_tx_attempts.id DESC
+		LIMIT 1
+	) a ON true
+	WHERE l.request_id = $1
+	ORDER BY l.updated_at DESC;`
+	err = o.q.Select(&lifecycles, sql, requestID)
+	return lifecycles, errors.Wrap(err, "FindRequestLifecycles failed")
+}
+
+// DeleteRequestLifecyclesOlderThan deletes the lifecycles of the job's
+// requests which were fulfilled or skipped longer than threshold ago. Pending
+// requests are kept however long they wait, as their unchanged statuses are not
+// written again.
+// Caller is expected to set timeout on calling context.
+func (o *orm) DeleteRequestLifecyclesOlderThan(ctx context.Context, jobID int32, threshold time.Duration) error {
+	q := o.q.WithOpts(pg.WithParentCtxInheritTimeout(ctx))
+	queryThreshold := time.Now().Add(-threshold)
+	err := pg.Batch(func(_, limit uint) (count uint, err error) {
+		result, cancel, err := q.ExecQIter(`
+WITH batched_lifecycles AS (
+	SELECT request_id FROM vrf_v2_request_lifecycles
+	WHERE job_id = $1 AND status IN ('fulfilled', 'skipped') AND updated_at < $2
+	ORDER BY updated_at ASC
+	LIMIT $3
+)
+DELETE FROM vrf_v2_request_lifecycles
+USING batched_lifecycles
+WHERE vrf_v2_request_lifecycles.job_id = $1 AND vrf_v2_request_lifecycles.request_id = batched_lifecycles.request_id`,
+			jobID,
+			queryThreshold,
+			limit,
+		)
+		defer cancel()
+		if err != nil {
+			return count, errors.Wrap(err, "DeleteRequestLifecyclesOlderThan failed to delete old vrf_v2_request_lifecycles")
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return count, errors.Wrap(err, "DeleteRequestLifecyclesOlderThan failed to get rows affected")
+		}
+
+		return uint(rowsAffected), err
+	})
+	return errors.Wrap(err, "DeleteRequestLifecyclesOlderThan failed")
+}
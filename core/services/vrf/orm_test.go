@@ -0,0 +1,167 @@
+package vrf_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestORM_RequestLifecycles(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := vrf.NewORM(db, logger.TestLogger(t), cfg)
+	borm := cltest.NewTxmORM(t, db, cfg)
+	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
+	_, from := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+
+	jb1, _ := cltest.MustInsertWebhookSpec(t, db)
+	jb2, _ := cltest.MustInsertWebhookSpec(t, db)
+	requestTxHash := utils.NewHash()
+	for _, jobID := range []int32{jb1.ID, jb2.ID} {
+		require.NoError(t, orm.CreateRequestLifecycle(&vrf.RequestLifecycle{
+			JobID:              jobID,
+			RequestID:          "16",
+			SubID:              2,
+			Status:             vrf.RequestStatusWaiting,
+			RequestTxHash:      requestTxHash,
+			RequestBlockNumber: 100,
+			ConfirmedAtBlock:   103,
+		}))
+	}
+
+	require.NoError(t, orm.UpdateRequestSubscriptions(jb1.ID, []string{"16"}, []*big.Int{big.NewInt(1000)}))
+	require.NoError(t, orm.UpdateRequestStatuses(jb1.ID, []vrf.RequestStatusUpdate{
+		{RequestID: "16", Status: vrf.RequestStatusRetrying, Reason: "pipeline error", Attempt: 1},
+	}))
+	require.NoError(t, orm.UpdateRequestStatuses(jb2.ID, []vrf.RequestStatusUpdate{
+		{RequestID: "16", Status: vrf.RequestStatusRetrying, Reason: "pipeline error", Attempt: 1},
+	}))
+	// Unchanged statuses are not written again
+	require.NoError(t, orm.UpdateRequestStatuses(jb2.ID, []vrf.RequestStatusUpdate{
+		{RequestID: "16", Status: vrf.RequestStatusRetrying, Reason: "pipeline error", Attempt: 2},
+	}))
+	require.NoError(t, orm.UpdateRequestStatuses(jb2.ID, []vrf.RequestStatusUpdate{
+		{RequestID: "16", Status: vrf.RequestStatusSkipped, Reason: "subscription not found", Attempt: 3},
+	}))
+
+	run := cltest.MustInsertPipelineRun(t, db)
+	etx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 0, 1, from)
+	fulfillmentTxHash := etx.EthTxAttempts[0].Hash
+	cltest.MustInsertEthReceipt(t, borm, 105, utils.NewHash(), fulfillmentTxHash)
+	require.NoError(t, orm.UpdateRequestEnqueued(jb1.ID, "16", run.ID, etx.ID))
+
+	// Neither a later attempt nor a redelivered log override the enqueued status
+	require.NoError(t, orm.UpdateRequestStatuses(jb1.ID, []vrf.RequestStatusUpdate{
+		{RequestID: "16", Status: vrf.RequestStatusRetrying, Reason: "pipeline error", Attempt: 2},
+	}))
+	require.NoError(t, orm.CreateRequestLifecycle(&vrf.RequestLifecycle{
+		JobID:              jb1.ID,
+		RequestID:          "16",
+		SubID:              2,
+		Status:             vrf.RequestStatusWaiting,
+		RequestTxHash:      requestTxHash,
+		RequestBlockNumber: 100,
+		ConfirmedAtBlock:   103,
+	}))
+	require.NoError(t, orm.UpdateRequestFulfilled(jb1.ID, "16", fulfillmentTxHash, 105, true))
+
+	lifecycles, err := orm.FindRequestLifecycles("16")
+	require.NoError(t, err)
+	require.Len(t, lifecycles, 2)
+	byJob := map[int32]vrf.RequestLifecycle{}
+	for _, l := range lifecycles {
+		byJob[l.JobID] = l
+	}
+
+	l := byJob[jb1.ID]
+	assert.Equal(t, vrf.RequestStatusFulfilled, l.Status)
+	assert.Equal(t, requestTxHash, l.RequestTxHash)
+	assert.Equal(t, uint64(100), l.RequestBlockNumber)
+	assert.Equal(t, uint64(103), l.ConfirmedAtBlock)
+	assert.Equal(t, int32(2), l.Attempts)
+	assert.Equal(t, "1000", l.SubscriptionBalance.String())
+	assert.True(t, l.SubscriptionCheckedAt.Valid)
+	assert.False(t, l.SkipReason.Valid)
+	assert.Equal(t, run.ID, l.PipelineRunID.Int64)
+	assert.Equal(t, etx.ID, l.EthTxID.Int64)
+	assert.Equal(t, "confirmed", l.EthTxState.String)
+	require.NotNil(t, l.FulfillmentTxHash)
+	assert.Equal(t, fulfillmentTxHash, *l.FulfillmentTxHash)
+	assert.Equal(t, int64(105), l.FulfillmentReceiptBlockNumber.Int64)
+	assert.Equal(t, &fulfillmentTxHash, l.FulfillmentLogTxHash)
+	assert.Equal(t, int64(105), l.FulfillmentLogBlockNumber.Int64)
+	assert.True(t, l.FulfillmentSuccess.Bool)
+
+	l = byJob[jb2.ID]
+	assert.Equal(t, vrf.RequestStatusSkipped, l.Status)
+	assert.Equal(t, int32(3), l.Attempts)
+	assert.Equal(t, "subscription not found", l.SkipReason.String)
+	assert.Nil(t, l.SubscriptionBalance)
+	assert.False(t, l.EthTxID.Valid)
+	assert.False(t, l.EthTxState.Valid)
+	assert.Nil(t, l.FulfillmentTxHash)
+	assert.Nil(t, l.FulfillmentLogTxHash)
+
+	lifecycles, err = orm.FindRequestLifecycles("17")
+	require.NoError(t, err)
+	assert.Empty(t, lifecycles)
+}
+
+func TestORM_DeleteRequestLifecyclesOlderThan(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := vrf.NewORM(db, logger.TestLogger(t), cfg)
+
+	jb1, _ := cltest.MustInsertWebhookSpec(t, db)
+	jb2, _ := cltest.MustInsertWebhookSpec(t, db)
+	for i, status := range []vrf.RequestStatus{vrf.RequestStatusFulfilled, vrf.RequestStatusSkipped, vrf.RequestStatusRetrying, vrf.RequestStatusEnqueued} {
+		for _, jobID := range []int32{jb1.ID, jb2.ID} {
+			require.NoError(t, orm.CreateRequestLifecycle(&vrf.RequestLifecycle{
+				JobID:              jobID,
+				RequestID:          fmt.Sprint(i),
+				SubID:              2,
+				Status:             status,
+				RequestTxHash:      utils.NewHash(),
+				RequestBlockNumber: 100,
+				ConfirmedAtBlock:   103,
+			}))
+		}
+	}
+	// A request fulfilled recently
+	require.NoError(t, orm.CreateRequestLifecycle(&vrf.RequestLifecycle{
+		JobID:              jb1.ID,
+		RequestID:          "4",
+		SubID:              2,
+		Status:             vrf.RequestStatusWaiting,
+		RequestTxHash:      utils.NewHash(),
+		RequestBlockNumber: 100,
+		ConfirmedAtBlock:   103,
+	}))
+	_, err := db.Exec(`UPDATE vrf_v2_request_lifecycles SET updated_at = now() - interval '2 hours' WHERE request_id != '4'`)
+	require.NoError(t, err)
+	require.NoError(t, orm.UpdateRequestFulfilled(jb1.ID, "4", utils.NewHash(), 105, true))
+
+	// Only the fulfilled and skipped requests of the job are deleted, however long the others wait
+	require.NoError(t, orm.DeleteRequestLifecyclesOlderThan(testutils.Context(t), jb1.ID, time.Hour))
+
+	var requestIDs []string
+	require.NoError(t, db.Select(&requestIDs, `SELECT request_id FROM vrf_v2_request_lifecycles WHERE job_id = $1 ORDER BY request_id`, jb1.ID))
+	assert.Equal(t, []string{"2", "3", "4"}, requestIDs)
+	require.NoError(t, db.Select(&requestIDs, `SELECT request_id FROM vrf_v2_request_lifecycles WHERE job_id = $1 ORDER BY request_id`, jb2.ID))
+	assert.Equal(t, []string{"0", "1", "2", "3"}, requestIDs)
+}
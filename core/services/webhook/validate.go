@@ -63,3 +63,17 @@ func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalIn
 
 	return jb, nil
 }
+
+// ExternalInitiatorNames returns the names of the external initiators
+// referenced by a webhook job spec.
+func ExternalInitiatorNames(tomlString string) ([]string, error) {
+	var tomlSpec TOMLWebhookSpec
+	if err := toml.Unmarshal([]byte(tomlString), &tomlSpec); err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, eiSpec := range tomlSpec.ExternalInitiators {
+		names = append(names, eiSpec.Name)
+	}
+	return names, nil
+}
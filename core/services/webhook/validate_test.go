@@ -156,3 +156,28 @@ func TestValidatedWebJobSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestExternalInitiatorNames(t *testing.T) {
+	t.Parallel()
+
+	names, err := webhook.ExternalInitiatorNames(`
+	type            = "webhook"
+	schemaVersion   = 1
+	externalInitiators = [
+		{ name = "foo", spec = '{"foo": 42}' },
+		{ name = "bar", spec = '{}' }
+	]
+	observationSource   = """
+		ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+	"""
+	`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"foo", "bar"}, names)
+
+	names, err = webhook.ExternalInitiatorNames(`type = "webhook"`)
+	require.NoError(t, err)
+	assert.Empty(t, names)
+
+	_, err = webhook.ExternalInitiatorNames(`bad`)
+	require.Error(t, err)
+}
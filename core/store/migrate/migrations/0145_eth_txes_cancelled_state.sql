@@ -0,0 +1,50 @@
+-- +goose NO TRANSACTION
+-- ALTER TYPE ... ADD VALUE cannot run inside a transaction block on Postgres v11,
+-- and the new value cannot be used in the same transaction on later versions.
+
+-- +goose Up
+ALTER TYPE eth_txes_state ADD VALUE IF NOT EXISTS 'cancelled';
+
+ALTER TABLE eth_txes ADD COLUMN cancel_requested_at timestamptz;
+ALTER TABLE eth_tx_attempts ADD COLUMN is_cancellation boolean NOT NULL DEFAULT FALSE;
+
+ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+    state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL AND initial_broadcast_at IS NULL
+    OR
+    state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL AND initial_broadcast_at IS NULL
+    OR
+    state = 'fatal_error'::eth_txes_state AND nonce IS NULL AND error IS NOT NULL
+    OR
+    state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+    OR
+    state = 'cancelled'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL AND cancel_requested_at IS NOT NULL
+) NOT VALID; -- NOT VALID gives large speedup and no existing rows can be cancelled
+
+-- +goose Down
+-- Postgres does not support removing a value from an enum, so 'cancelled' is
+-- left in eth_txes_state. Cancelled transactions had their nonce consumed on
+-- chain, so they are reverted to confirmed.
+UPDATE eth_txes SET state = 'confirmed' WHERE state = 'cancelled';
+
+ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+    state = 'unstarted'::eth_txes_state AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL AND initial_broadcast_at IS NULL
+    OR
+    state = 'in_progress'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL AND initial_broadcast_at IS NULL
+    OR
+    state = 'fatal_error'::eth_txes_state AND nonce IS NULL AND error IS NOT NULL
+    OR
+    state = 'unconfirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+    OR
+    state = 'confirmed_missing_receipt'::eth_txes_state AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL AND initial_broadcast_at IS NOT NULL
+) NOT VALID;
+
+ALTER TABLE eth_tx_attempts DROP COLUMN is_cancellation;
+ALTER TABLE eth_txes DROP COLUMN cancel_requested_at;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN priority integer NOT NULL DEFAULT 0;
+CREATE INDEX idx_eth_txes_unstarted_priority ON eth_txes (evm_chain_id, from_address, priority DESC, created_at ASC, id ASC) WHERE state = 'unstarted'::eth_txes_state;
+
+-- +goose Down
+DROP INDEX idx_eth_txes_unstarted_priority;
+ALTER TABLE eth_txes DROP COLUMN priority;
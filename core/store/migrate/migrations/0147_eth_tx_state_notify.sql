@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION public.notifyethtxstatechange() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+        BEGIN
+		PERFORM pg_notify('eth_tx_state_changed'::text, NEW.id::text);
+		RETURN NULL;
+        END
+        $$;
+
+CREATE TRIGGER notify_eth_tx_insert AFTER INSERT ON public.eth_txes FOR EACH ROW EXECUTE PROCEDURE public.notifyethtxstatechange();
+CREATE TRIGGER notify_eth_tx_state_change AFTER UPDATE OF state ON public.eth_txes FOR EACH ROW WHEN (OLD.state IS DISTINCT FROM NEW.state) EXECUTE PROCEDURE public.notifyethtxstatechange();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS notify_eth_tx_state_change ON public.eth_txes;
+DROP TRIGGER IF EXISTS notify_eth_tx_insert ON public.eth_txes;
+DROP FUNCTION IF EXISTS public.notifyethtxstatechange();
+-- +goose StatementEnd
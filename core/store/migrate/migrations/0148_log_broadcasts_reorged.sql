@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE log_broadcasts ADD COLUMN reorged_at timestamptz;
+CREATE INDEX idx_log_broadcasts_reorged ON log_broadcasts (evm_chain_id, block_number) WHERE reorged_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_log_broadcasts_reorged;
+ALTER TABLE log_broadcasts DROP COLUMN reorged_at;
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE bridge_types
+    ADD COLUMN rate_limit double precision NOT NULL DEFAULT 0 CHECK (rate_limit >= 0),
+    ADD COLUMN rate_limit_burst bigint NOT NULL DEFAULT 0 CHECK (rate_limit_burst >= 0),
+    ADD COLUMN circuit_breaker_threshold bigint NOT NULL DEFAULT 0 CHECK (circuit_breaker_threshold >= 0),
+    ADD COLUMN circuit_breaker_cooldown bigint NOT NULL DEFAULT 0 CHECK (circuit_breaker_cooldown >= 0),
+    ADD COLUMN cache_ttl bigint NOT NULL DEFAULT 0 CHECK (cache_ttl >= 0);
+
+-- +goose Down
+ALTER TABLE bridge_types
+    DROP COLUMN rate_limit,
+    DROP COLUMN rate_limit_burst,
+    DROP COLUMN circuit_breaker_threshold,
+    DROP COLUMN circuit_breaker_cooldown,
+    DROP COLUMN cache_ttl;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE bridge_types
+    ADD COLUMN encrypted_signing_secret bytea,
+    ADD COLUMN client_certificate text,
+    ADD COLUMN encrypted_client_key bytea,
+    ADD CONSTRAINT chk_client_certificate CHECK ((client_certificate IS NULL) = (encrypted_client_key IS NULL));
+
+-- +goose Down
+ALTER TABLE bridge_types
+    DROP CONSTRAINT chk_client_certificate,
+    DROP COLUMN encrypted_signing_secret,
+    DROP COLUMN client_certificate,
+    DROP COLUMN encrypted_client_key;
@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE job_spec_versions (
+    id bigserial PRIMARY KEY,
+    job_id int NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    external_job_id uuid NOT NULL,
+    version int NOT NULL CHECK (version > 0),
+    pipeline_spec_id int NOT NULL REFERENCES pipeline_specs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    toml text,
+    created_at timestamptz NOT NULL
+);
+CREATE UNIQUE INDEX idx_job_spec_versions_external_job_id_version ON job_spec_versions (external_job_id, version);
+CREATE INDEX idx_job_spec_versions_job_id ON job_spec_versions (job_id);
+CREATE INDEX idx_job_spec_versions_pipeline_spec_id ON job_spec_versions (pipeline_spec_id);
+
+-- The specs of existing jobs were not recorded, so their first version has no TOML.
+INSERT INTO job_spec_versions (job_id, external_job_id, version, pipeline_spec_id, created_at)
+SELECT id, external_job_id, 1, pipeline_spec_id, created_at FROM jobs;
+
+-- job_pipeline_specs maps jobs to the pipeline specs of all their versions, so
+-- that runs of earlier versions are still found by job.
+CREATE VIEW job_pipeline_specs AS
+    SELECT id AS job_id, pipeline_spec_id FROM jobs
+    UNION
+    SELECT job_id, pipeline_spec_id FROM job_spec_versions;
+
+-- +goose Down
+DROP VIEW job_pipeline_specs;
+DROP TABLE job_spec_versions;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN paused_at timestamptz;
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN paused_at;
@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE direct_request_specs ADD COLUMN requester_limits jsonb NOT NULL DEFAULT '[]';
+
+CREATE TABLE direct_request_rejections (
+    id bigserial PRIMARY KEY,
+    job_id int NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    request_id bytea NOT NULL CHECK (octet_length(request_id) = 32),
+    requester bytea NOT NULL CHECK (octet_length(requester) = 20),
+    payment numeric(78,0),
+    reason text NOT NULL,
+    tx_hash bytea NOT NULL CHECK (octet_length(tx_hash) = 32),
+    block_number bigint NOT NULL,
+    created_at timestamptz NOT NULL
+);
+CREATE INDEX idx_direct_request_rejections_job_id_created_at ON direct_request_rejections (job_id, created_at);
+
+-- +goose Down
+DROP TABLE direct_request_rejections;
+ALTER TABLE direct_request_specs DROP COLUMN requester_limits;
@@ -0,0 +1,29 @@
+-- +goose Up
+CREATE TABLE vrf_v2_request_lifecycles (
+    job_id int NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    request_id text NOT NULL,
+    sub_id bigint NOT NULL,
+    status text NOT NULL,
+    request_tx_hash bytea NOT NULL CHECK (octet_length(request_tx_hash) = 32),
+    request_block_number bigint NOT NULL,
+    confirmed_at_block bigint NOT NULL,
+    log_seen_at timestamptz NOT NULL,
+    attempts int NOT NULL DEFAULT 0,
+    subscription_balance numeric(78,0),
+    subscription_checked_at timestamptz,
+    skip_reason text,
+    pipeline_run_id bigint REFERENCES pipeline_runs (id) ON DELETE SET NULL DEFERRABLE INITIALLY IMMEDIATE,
+    eth_tx_id bigint REFERENCES eth_txes (id) ON DELETE SET NULL DEFERRABLE INITIALLY IMMEDIATE,
+    fulfillment_log_tx_hash bytea CHECK (octet_length(fulfillment_log_tx_hash) = 32),
+    fulfillment_log_block_number bigint,
+    fulfillment_success boolean,
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (job_id, request_id)
+);
+CREATE INDEX idx_vrf_v2_request_lifecycles_request_id ON vrf_v2_request_lifecycles (request_id);
+CREATE INDEX idx_vrf_v2_request_lifecycles_pipeline_run_id ON vrf_v2_request_lifecycles (pipeline_run_id) WHERE pipeline_run_id IS NOT NULL;
+CREATE INDEX idx_vrf_v2_request_lifecycles_eth_tx_id ON vrf_v2_request_lifecycles (eth_tx_id) WHERE eth_tx_id IS NOT NULL;
+CREATE INDEX idx_vrf_v2_request_lifecycles_updated_at ON vrf_v2_request_lifecycles (updated_at);
+
+-- +goose Down
+DROP TABLE vrf_v2_request_lifecycles;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE direct_request_accepted_requests (
+    job_id int NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    request_id bytea NOT NULL CHECK (octet_length(request_id) = 32),
+    requester bytea NOT NULL CHECK (octet_length(requester) = 20),
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (job_id, request_id)
+);
+CREATE INDEX idx_direct_request_accepted_requests_requester_created_at ON direct_request_accepted_requests (job_id, requester, created_at);
+
+DELETE FROM direct_request_rejections a USING direct_request_rejections b
+WHERE a.job_id = b.job_id AND a.request_id = b.request_id AND a.id > b.id;
+ALTER TABLE direct_request_rejections ADD CONSTRAINT direct_request_rejections_job_id_request_id_key UNIQUE (job_id, request_id);
+
+-- +goose Down
+ALTER TABLE direct_request_rejections DROP CONSTRAINT direct_request_rejections_job_id_request_id_key;
+DROP TABLE direct_request_accepted_requests;
@@ -270,6 +270,8 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/pipeline/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs/MOCK", true, true, true},
+	{"POST", "/v2/jobs/MOCK/flux_monitor/poll", false, false, false},
+	{"GET", "/v2/jobs/MOCK/flux_monitor/deviation", false, true, true},
 	{"GET", "/v2/features", true, true, true},
 	{"DELETE", "/v2/pipeline/job_spec_errors/MOCK", false, false, true},
 	{"GET", "/v2/log", true, true, true},
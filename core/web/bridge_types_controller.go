@@ -1,8 +1,10 @@
 package web
 
 import (
+	"crypto/tls"
 	"database/sql"
 	"fmt"
+	"math"
 	"net/http"
 	"strings"
 
@@ -31,6 +33,10 @@ func ValidateBridgeTypeNotExist(bt *bridges.BridgeTypeRequest, orm bridges.ORM)
 	return fe.CoerceEmptyToNil()
 }
 
+// minBridgeSigningSecretLength is the minimum length of a bridge's signing
+// secret.
+const minBridgeSigningSecretLength = 16
+
 // ValidateBridgeType checks that the bridge type has the required field with valid values.
 func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 	fe := models.NewJSONAPIErrors()
@@ -48,6 +54,29 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if bt.RateLimit < 0 || math.IsNaN(bt.RateLimit) || math.IsInf(bt.RateLimit, 0) {
+		fe.Add("RateLimit must be a positive number")
+	}
+	if bt.CircuitBreakerCooldown < 0 {
+		fe.Add("CircuitBreakerCooldown must be positive")
+	}
+	if bt.CircuitBreakerThreshold > 0 && bt.CircuitBreakerCooldown == 0 {
+		fe.Add("CircuitBreakerCooldown must be set if CircuitBreakerThreshold is set")
+	}
+	if bt.CacheTTL < 0 {
+		fe.Add("CacheTTL must be positive")
+	}
+	if bt.SigningSecret != nil && *bt.SigningSecret != "" && len(*bt.SigningSecret) < minBridgeSigningSecretLength {
+		fe.Add(fmt.Sprintf("SigningSecret must be at least %d characters", minBridgeSigningSecretLength))
+	}
+	if (bt.ClientCertificate == nil) != (bt.ClientKey == nil) ||
+		(bt.ClientCertificate != nil && (*bt.ClientCertificate == "") != (*bt.ClientKey == "")) {
+		fe.Add("ClientCertificate and ClientKey must be set together")
+	} else if bt.ClientCertificate != nil && *bt.ClientCertificate != "" {
+		if _, err := tls.X509KeyPair([]byte(*bt.ClientCertificate), []byte(*bt.ClientKey)); err != nil {
+			fe.Add(fmt.Sprintf("invalid ClientCertificate or ClientKey: %v", err))
+		}
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -78,6 +107,10 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, e)
 		return
 	}
+	if e := bt.SetCredentials(btr, btc.App.GetKeyStore()); e != nil {
+		jsonAPIError(c, http.StatusInternalServerError, e)
+		return
+	}
 	if e := orm.CreateBridgeType(bt); e != nil {
 		jsonAPIError(c, http.StatusInternalServerError, e)
 		return
@@ -165,10 +198,15 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
 	}
+	if err := bt.SetCredentials(btr, btc.App.GetKeyStore()); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
 	if err := orm.UpdateBridgeType(&bt, btr); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	btc.App.PipelineRunner().EvictBridge(bt.Name)
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
 }
@@ -206,6 +244,7 @@ func (btc *BridgeTypesController) Destroy(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to delete bridge: %+v", err))
 		return
 	}
+	btc.App.PipelineRunner().EvictBridge(bt.Name)
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
 }
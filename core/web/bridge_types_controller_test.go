@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
@@ -24,6 +25,10 @@ import (
 func TestValidateBridgeType(t *testing.T) {
 	t.Parallel()
 
+	certPEM, keyPEM := testutils.MustNewCertificate(t)
+	_, otherKeyPEM := testutils.MustNewCertificate(t)
+	str := func(s string) *string { return &s }
+
 	tests := []struct {
 		description string
 		request     bridges.BridgeTypeRequest
@@ -86,6 +91,94 @@ func TestValidateBridgeType(t *testing.T) {
 			},
 			models.NewJSONAPIErrorsWith("MinimumContractPayment must be positive"),
 		},
+		{
+			"valid limits",
+			bridges.BridgeTypeRequest{
+				Name:                    "adapterwithlimits",
+				URL:                     cltest.WebURL(t, "https://denergy.eth"),
+				RateLimit:               0.5,
+				RateLimitBurst:          2,
+				CircuitBreakerThreshold: 3,
+				CircuitBreakerCooldown:  models.Interval(time.Minute),
+				CacheTTL:                models.Interval(time.Second),
+			},
+			nil,
+		},
+		{
+			"invalid RateLimit negative",
+			bridges.BridgeTypeRequest{
+				Name:      "adapterwithlimits",
+				URL:       cltest.WebURL(t, "https://denergy.eth"),
+				RateLimit: -1,
+			},
+			models.NewJSONAPIErrorsWith("RateLimit must be a positive number"),
+		},
+		{
+			"invalid CircuitBreakerThreshold without cooldown",
+			bridges.BridgeTypeRequest{
+				Name:                    "adapterwithlimits",
+				URL:                     cltest.WebURL(t, "https://denergy.eth"),
+				CircuitBreakerThreshold: 3,
+			},
+			models.NewJSONAPIErrorsWith("CircuitBreakerCooldown must be set if CircuitBreakerThreshold is set"),
+		},
+		{
+			"invalid CacheTTL negative",
+			bridges.BridgeTypeRequest{
+				Name:     "adapterwithlimits",
+				URL:      cltest.WebURL(t, "https://denergy.eth"),
+				CacheTTL: models.Interval(-time.Second),
+			},
+			models.NewJSONAPIErrorsWith("CacheTTL must be positive"),
+		},
+		{
+			"short signing secret",
+			bridges.BridgeTypeRequest{
+				Name:          "signedadapter",
+				URL:           cltest.WebURL(t, "https://denergy.eth"),
+				SigningSecret: str("tooshort"),
+			},
+			models.NewJSONAPIErrorsWith("SigningSecret must be at least 16 characters"),
+		},
+		{
+			"signing secret removed",
+			bridges.BridgeTypeRequest{
+				Name:          "signedadapter",
+				URL:           cltest.WebURL(t, "https://denergy.eth"),
+				SigningSecret: str(""),
+			},
+			nil,
+		},
+		{
+			"client certificate without key",
+			bridges.BridgeTypeRequest{
+				Name:              "mtlsadapter",
+				URL:               cltest.WebURL(t, "https://denergy.eth"),
+				ClientCertificate: str(certPEM),
+			},
+			models.NewJSONAPIErrorsWith("ClientCertificate and ClientKey must be set together"),
+		},
+		{
+			"client certificate with mismatched key",
+			bridges.BridgeTypeRequest{
+				Name:              "mtlsadapter",
+				URL:               cltest.WebURL(t, "https://denergy.eth"),
+				ClientCertificate: str(certPEM),
+				ClientKey:         str(otherKeyPEM),
+			},
+			models.NewJSONAPIErrorsWith("invalid ClientCertificate or ClientKey: tls: private key does not match public key"),
+		},
+		{
+			"credentials",
+			bridges.BridgeTypeRequest{
+				Name:              "mtlsadapter",
+				URL:               cltest.WebURL(t, "https://denergy.eth"),
+				SigningSecret:     str("0123456789abcdef"),
+				ClientCertificate: str(certPEM),
+				ClientKey:         str(keyPEM),
+			},
+			nil,
+		},
 		{
 			"existing core adapter (no longer fails since core adapters no longer exist)",
 			bridges.BridgeTypeRequest{
@@ -3,8 +3,11 @@ package web
 import (
 	"database/sql"
 	"net/http"
+	"strings"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -46,3 +49,73 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Cancel requests the cancellation of an unconfirmed Ethereum Transaction.
+// Its nonce will be replaced by a zero-value self-send at a bumped gas price.
+// The transaction is identified by its ID, or by the 0x prefixed hash of one
+// of its attempts.
+// Example:
+//  "<application>/transactions/evm/:ID/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	// The parameter shares its name with the Show route's
+	idOrHash := c.Param("TxHash")
+
+	var etxID int64
+	if strings.HasPrefix(idOrHash, "0x") {
+		ethTxAttempt, err := tc.App.TxmORM().FindEthTxAttempt(common.HexToHash(idOrHash))
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+			return
+		}
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		etxID = ethTxAttempt.EthTxID
+	} else {
+		id, err := stringutils.ToInt64(idOrHash)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid transaction ID"))
+			return
+		}
+		etxID = id
+	}
+
+	etx, err := tc.App.TxmORM().FindEthTxWithAttempts(etxID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	chain, err := tc.App.GetChains().EVM.Get(etx.EVMChainID.ToInt())
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err = chain.TxManager().CancelEthTx(etx.ID)
+	if errors.Is(err, txmgr.ErrEthTxNotCancellable) {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	etx, err = tc.App.TxmORM().FindEthTxWithAttempts(etx.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(etx.EthTxAttempts) == 0 {
+		jsonAPIResponse(c, presenters.NewEthTxResource(etx), "transaction")
+		return
+	}
+	etx.EthTxAttempts[0].EthTx = etx
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(etx.EthTxAttempts[0]), "transaction")
+}
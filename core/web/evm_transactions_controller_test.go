@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strconv"
 	"testing"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
@@ -125,3 +126,103 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Cancel_Success(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	borm := app.TxmORM()
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, from)
+	require.Len(t, tx.EthTxAttempts, 1)
+	attempt := tx.EthTxAttempts[0]
+
+	resp, cleanup := client.Post("/v2/transactions/evm/"+strconv.FormatInt(tx.ID, 10)+"/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	ptx := presenters.EthTxResource{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+	assert.Equal(t, attempt.Hash, ptx.Hash)
+
+	tx, err := borm.FindEthTxWithAttempts(tx.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, tx.CancelRequestedAt)
+}
+
+func TestTransactionsController_Cancel_ByHash(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	borm := app.TxmORM()
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+	tx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, borm, 1, from)
+	require.Len(t, tx.EthTxAttempts, 1)
+	attempt := tx.EthTxAttempts[0]
+
+	resp, cleanup := client.Post("/v2/transactions/evm/"+attempt.Hash.Hex()+"/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	ptx := presenters.EthTxResource{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+	assert.Equal(t, attempt.Hash, ptx.Hash)
+
+	tx, err := borm.FindEthTxWithAttempts(tx.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, tx.CancelRequestedAt)
+}
+
+func TestTransactionsController_Cancel_NotCancellable(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	borm := app.TxmORM()
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+	tx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, borm, 0, 1, from)
+	require.Len(t, tx.EthTxAttempts, 1)
+	attempt := tx.EthTxAttempts[0]
+
+	resp, cleanup := client.Post("/v2/transactions/evm/"+attempt.Hash.Hex()+"/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestTransactionsController_Cancel_NotFound(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	resp, cleanup := client.Post("/v2/transactions/evm/"+utils.NewHash().Hex()+"/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+
+	resp, cleanup = client.Post("/v2/transactions/evm/999999/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestTransactionsController_Cancel_InvalidID(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	resp, cleanup := client.Post("/v2/transactions/evm/not-an-id/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+}
@@ -0,0 +1,325 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/loader"
+)
+
+// GraphQL over websocket subprotocols. Both the graphql-ws protocol and the
+// legacy subscriptions-transport-ws protocol are supported.
+const (
+	gqlWSProtocol       = "graphql-transport-ws"
+	gqlWSProtocolLegacy = "graphql-ws"
+)
+
+// GraphQL over websocket message types
+const (
+	gqlWSConnectionInit = "connection_init"
+	gqlWSConnectionAck  = "connection_ack"
+	gqlWSPing           = "ping"
+	gqlWSPong           = "pong"
+	gqlWSSubscribe      = "subscribe"
+	gqlWSNext           = "next"
+	gqlWSError          = "error"
+	gqlWSComplete       = "complete"
+
+	// Legacy message types
+	gqlWSConnectionError     = "connection_error"
+	gqlWSConnectionTerminate = "connection_terminate"
+	gqlWSKeepAlive           = "ka"
+	gqlWSStart               = "start"
+	gqlWSData                = "data"
+	gqlWSStop                = "stop"
+)
+
+const (
+	// gqlWSInitTimeout is how long clients have to initialise the connection
+	gqlWSInitTimeout = 10 * time.Second
+	// gqlWSKeepAliveInterval is how often connections are pinged
+	gqlWSKeepAliveInterval = 30 * time.Second
+	// gqlWSWriteTimeout is the timeout for writing a single message
+	gqlWSWriteTimeout = 10 * time.Second
+)
+
+type gqlWSMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type gqlWSOperation struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type gqlWSErrorPayload struct {
+	Message string `json:"message"`
+}
+
+// graphqlWSHandler serves GraphQL operations, and in particular subscriptions,
+// over websockets. Connections from browsers are only accepted from
+// allowOrigins, like the CORS requests of the UI.
+func graphqlWSHandler(app chainlink.Application, schema *graphql.Schema, allowOrigins string) gin.HandlerFunc {
+	lggr := app.GetLogger().Named("GQLWSHandler")
+	upgrader := websocket.Upgrader{
+		Subprotocols: []string{gqlWSProtocol, gqlWSProtocolLegacy},
+		CheckOrigin:  gqlWSCheckOrigin(allowOrigins),
+	}
+
+	return func(c *gin.Context) {
+		if !websocket.IsWebSocketUpgrade(c.Request) {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			// Upgrade already replied with an error
+			lggr.Debugw("Failed to upgrade websocket connection", "err", err)
+			return
+		}
+
+		ws := &gqlWSConn{
+			conn:   conn,
+			schema: schema,
+			app:    app,
+			legacy: conn.Subprotocol() != gqlWSProtocol,
+			lggr:   lggr,
+			ops:    make(map[string]context.CancelFunc),
+		}
+		ws.serve(c.Request.Context())
+	}
+}
+
+// gqlWSCheckOrigin returns a websocket.Upgrader CheckOrigin func, which accepts
+// requests without an Origin header, i.e. not from browsers, and requests from
+// one of the comma separated allowOrigins, or any origin if it is "*".
+func gqlWSCheckOrigin(allowOrigins string) func(r *http.Request) bool {
+	allowed := make(map[string]struct{})
+	for _, origin := range strings.Split(allowOrigins, ",") {
+		allowed[strings.TrimSpace(origin)] = struct{}{}
+	}
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" || allowOrigins == "*" {
+			return true
+		}
+		_, ok := allowed[origin]
+		return ok
+	}
+}
+
+// gqlWSConn is a single GraphQL websocket connection, which may run several
+// operations concurrently.
+type gqlWSConn struct {
+	conn   *websocket.Conn
+	schema *graphql.Schema
+	app    chainlink.Application
+	legacy bool
+	lggr   logger.Logger
+
+	writeMu sync.Mutex
+
+	opsMu sync.Mutex
+	ops   map[string]context.CancelFunc
+	wg    sync.WaitGroup
+}
+
+func (c *gqlWSConn) serve(ctx context.Context) {
+	defer c.conn.Close()
+	defer c.wg.Wait()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The http server's timeouts do not apply to hijacked connections.
+	if err := c.conn.SetReadDeadline(time.Now().Add(gqlWSInitTimeout)); err != nil {
+		return
+	}
+	var init gqlWSMessage
+	if err := c.conn.ReadJSON(&init); err != nil {
+		c.closeWithError(4408, "Connection initialisation timeout")
+		return
+	} else if init.Type != gqlWSConnectionInit {
+		c.closeWithError(4401, "Unauthorized")
+		return
+	}
+	if err := c.write(gqlWSMessage{Type: gqlWSConnectionAck}); err != nil {
+		return
+	}
+
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(2 * gqlWSKeepAliveInterval))
+	})
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.keepAlive(ctx)
+	}()
+
+	for {
+		if err := c.conn.SetReadDeadline(time.Now().Add(2 * gqlWSKeepAliveInterval)); err != nil {
+			return
+		}
+		var msg gqlWSMessage
+		if err := c.conn.ReadJSON(&msg); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				c.lggr.Debugw("Failed to read websocket message", "err", err)
+			}
+			return
+		}
+
+		switch msg.Type {
+		case gqlWSSubscribe, gqlWSStart:
+			c.start(ctx, msg)
+		case gqlWSComplete, gqlWSStop:
+			c.stop(msg.ID)
+		case gqlWSPing:
+			if err := c.write(gqlWSMessage{Type: gqlWSPong, Payload: msg.Payload}); err != nil {
+				return
+			}
+		case gqlWSPong:
+		case gqlWSConnectionTerminate:
+			return
+		default:
+			if !c.legacy {
+				c.closeWithError(4400, "Unknown message type "+msg.Type)
+				return
+			}
+			c.sendError(msg.ID, "unknown message type "+msg.Type)
+		}
+	}
+}
+
+// start executes the operation in msg until it completes or is stopped.
+func (c *gqlWSConn) start(ctx context.Context, msg gqlWSMessage) {
+	var op gqlWSOperation
+	if err := json.Unmarshal(msg.Payload, &op); err != nil || msg.ID == "" {
+		c.sendError(msg.ID, "invalid operation")
+		return
+	}
+
+	c.opsMu.Lock()
+	if _, exists := c.ops[msg.ID]; exists {
+		c.opsMu.Unlock()
+		c.sendError(msg.ID, "operation "+msg.ID+" already exists")
+		return
+	}
+	ctx, cancel := context.WithCancel(loader.InjectDataloader(ctx, c.app))
+	c.ops[msg.ID] = cancel
+	c.opsMu.Unlock()
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer c.stop(msg.ID)
+
+		responses, err := c.schema.Subscribe(ctx, op.Query, op.OperationName, op.Variables)
+		if err != nil {
+			c.sendError(msg.ID, err.Error())
+			return
+		}
+
+		dataType := gqlWSNext
+		if c.legacy {
+			dataType = gqlWSData
+		}
+		for resp := range responses {
+			payload, err := json.Marshal(resp)
+			if err != nil {
+				c.lggr.Errorw("Failed to marshal GraphQL response", "err", err)
+				continue
+			}
+			if err := c.write(gqlWSMessage{ID: msg.ID, Type: dataType, Payload: payload}); err != nil {
+				// Drain the responses so the subscription can shut down.
+				cancel()
+				for range responses {
+				}
+				return
+			}
+		}
+		// Operations which were stopped by the client must not be completed.
+		stopped := ctx.Err() != nil
+		// Free the id before completing, so clients may reuse it right away.
+		c.stop(msg.ID)
+		if !stopped {
+			_ = c.write(gqlWSMessage{ID: msg.ID, Type: gqlWSComplete})
+		}
+	}()
+}
+
+// stop cancels the operation with id, if it is still running.
+func (c *gqlWSConn) stop(id string) {
+	c.opsMu.Lock()
+	defer c.opsMu.Unlock()
+	if cancel, ok := c.ops[id]; ok {
+		cancel()
+		delete(c.ops, id)
+	}
+}
+
+func (c *gqlWSConn) keepAlive(ctx context.Context) {
+	ticker := time.NewTicker(gqlWSKeepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		c.writeMu.Lock()
+		err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(gqlWSWriteTimeout))
+		c.writeMu.Unlock()
+		if err == nil && c.legacy {
+			err = c.write(gqlWSMessage{Type: gqlWSKeepAlive})
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (c *gqlWSConn) sendError(id string, message string) {
+	var payload interface{} = gqlWSErrorPayload{Message: message}
+	if !c.legacy {
+		payload = []gqlWSErrorPayload{{Message: message}}
+	}
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+	_ = c.write(gqlWSMessage{ID: id, Type: gqlWSError, Payload: b})
+}
+
+func (c *gqlWSConn) closeWithError(code int, message string) {
+	if c.legacy {
+		b, _ := json.Marshal(gqlWSErrorPayload{Message: message})
+		_ = c.write(gqlWSMessage{Type: gqlWSConnectionError, Payload: b})
+		return
+	}
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_ = c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, message), time.Now().Add(gqlWSWriteTimeout))
+}
+
+func (c *gqlWSConn) write(msg gqlWSMessage) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := c.conn.SetWriteDeadline(time.Now().Add(gqlWSWriteTimeout)); err != nil {
+		return err
+	}
+	return c.conn.WriteJSON(msg)
+}
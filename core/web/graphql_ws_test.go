@@ -0,0 +1,190 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	coremocks "github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+const wsTestSchema = `
+	schema {
+		query: Query
+		subscription: Subscription
+	}
+
+	type Query {
+		hello: String!
+	}
+
+	type Subscription {
+		count(to: Int!): Int!
+	}
+`
+
+type wsTestResolver struct{}
+
+func (*wsTestResolver) Hello() string { return "world" }
+
+func (*wsTestResolver) Count(ctx context.Context, args struct{ To int32 }) <-chan int32 {
+	ch := make(chan int32)
+	go func() {
+		defer close(ch)
+		for i := int32(1); i <= args.To; i++ {
+			select {
+			case ch <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+func newGQLWSTestServer(t *testing.T) string {
+	app := coremocks.NewApplication(t)
+	app.On("GetLogger").Return(logger.TestLogger(t))
+
+	engine := gin.New()
+	engine.GET("/query", graphqlWSHandler(app, graphql.MustParseSchema(wsTestSchema, &wsTestResolver{}), "http://localhost:3000,http://localhost:6688"))
+	srv := httptest.NewServer(engine)
+	t.Cleanup(srv.Close)
+
+	return "ws" + strings.TrimPrefix(srv.URL, "http") + "/query"
+}
+
+func dialGQLWS(t *testing.T, url, protocol string) *websocket.Conn {
+	dialer := websocket.Dialer{Subprotocols: []string{protocol}}
+	conn, _, err := dialer.Dial(url, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	require.Equal(t, protocol, conn.Subprotocol())
+
+	require.NoError(t, conn.WriteJSON(gqlWSMessage{Type: gqlWSConnectionInit}))
+	var ack gqlWSMessage
+	require.NoError(t, conn.ReadJSON(&ack))
+	require.Equal(t, gqlWSConnectionAck, ack.Type)
+
+	return conn
+}
+
+func readGQLWS(t *testing.T, conn *websocket.Conn) gqlWSMessage {
+	var msg gqlWSMessage
+	require.NoError(t, conn.ReadJSON(&msg))
+	return msg
+}
+
+func TestGQLWSCheckOrigin(t *testing.T) {
+	t.Parallel()
+
+	request := func(origin string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/query", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		return r
+	}
+
+	check := gqlWSCheckOrigin("http://localhost:3000,http://localhost:6688")
+	assert.True(t, check(request("")))
+	assert.True(t, check(request("http://localhost:3000")))
+	assert.True(t, check(request("http://localhost:6688")))
+	assert.False(t, check(request("http://evil.test")))
+
+	check = gqlWSCheckOrigin("*")
+	assert.True(t, check(request("http://evil.test")))
+}
+
+func TestGraphqlWSHandler(t *testing.T) {
+	t.Parallel()
+
+	url := newGQLWSTestServer(t)
+	subscribe := func(t *testing.T, conn *websocket.Conn, typ, id, query string) {
+		payload, err := json.Marshal(gqlWSOperation{Query: query})
+		require.NoError(t, err)
+		require.NoError(t, conn.WriteJSON(gqlWSMessage{ID: id, Type: typ, Payload: payload}))
+	}
+
+	for _, tt := range []struct {
+		protocol      string
+		subscribeType string
+		dataType      string
+	}{
+		{gqlWSProtocol, gqlWSSubscribe, gqlWSNext},
+		{gqlWSProtocolLegacy, gqlWSStart, gqlWSData},
+	} {
+		tt := tt
+		t.Run(tt.protocol, func(t *testing.T) {
+			t.Run("subscription", func(t *testing.T) {
+				conn := dialGQLWS(t, url, tt.protocol)
+				subscribe(t, conn, tt.subscribeType, "1", `subscription { count(to: 2) }`)
+
+				for _, expected := range []string{`{"data":{"count":1}}`, `{"data":{"count":2}}`} {
+					msg := readGQLWS(t, conn)
+					assert.Equal(t, tt.dataType, msg.Type)
+					assert.Equal(t, "1", msg.ID)
+					assert.JSONEq(t, expected, string(msg.Payload))
+				}
+				assert.Equal(t, gqlWSMessage{ID: "1", Type: gqlWSComplete}, readGQLWS(t, conn))
+			})
+
+			t.Run("query", func(t *testing.T) {
+				conn := dialGQLWS(t, url, tt.protocol)
+				subscribe(t, conn, tt.subscribeType, "q", `{ hello }`)
+
+				msg := readGQLWS(t, conn)
+				assert.Equal(t, tt.dataType, msg.Type)
+				assert.JSONEq(t, `{"data":{"hello":"world"}}`, string(msg.Payload))
+				assert.Equal(t, gqlWSMessage{ID: "q", Type: gqlWSComplete}, readGQLWS(t, conn))
+			})
+
+			t.Run("invalid operation", func(t *testing.T) {
+				conn := dialGQLWS(t, url, tt.protocol)
+				require.NoError(t, conn.WriteJSON(gqlWSMessage{ID: "1", Type: tt.subscribeType, Payload: json.RawMessage(`"query"`)}))
+
+				msg := readGQLWS(t, conn)
+				assert.Equal(t, gqlWSError, msg.Type)
+				assert.Contains(t, string(msg.Payload), "invalid operation")
+			})
+		})
+	}
+
+	t.Run("ping", func(t *testing.T) {
+		conn := dialGQLWS(t, url, gqlWSProtocol)
+		require.NoError(t, conn.WriteJSON(gqlWSMessage{Type: gqlWSPing}))
+		assert.Equal(t, gqlWSMessage{Type: gqlWSPong}, readGQLWS(t, conn))
+	})
+
+	t.Run("checks the origin", func(t *testing.T) {
+		dialer := websocket.Dialer{Subprotocols: []string{gqlWSProtocol}}
+		conn, _, err := dialer.Dial(url, http.Header{"Origin": {"http://localhost:6688"}})
+		require.NoError(t, err)
+		conn.Close()
+
+		_, resp, err := dialer.Dial(url, http.Header{"Origin": {"http://evil.test"}})
+		require.ErrorIs(t, err, websocket.ErrBadHandshake)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("requires connection init", func(t *testing.T) {
+		dialer := websocket.Dialer{Subprotocols: []string{gqlWSProtocol}}
+		conn, _, err := dialer.Dial(url, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		require.NoError(t, conn.WriteJSON(gqlWSMessage{Type: gqlWSPing}))
+		_, _, err = conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, 4401), err)
+	})
+}
@@ -3,13 +3,18 @@ package web
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"math"
 	"net/http"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
@@ -22,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -98,67 +104,414 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
-	jobType, err := job.ValidateSpec(request.TOML)
+	jb, status, err := jc.validateJobSpec(request.TOML)
 	if err != nil {
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML"))
+		jsonAPIError(c, status, err)
 		return
 	}
 
-	var jb job.Job
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	err = jc.App.AddJobV2(ctx, &jb)
+	if err != nil {
+		jsonAPIError(c, jobErrorStatus(err), err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// validateJobSpec parses and validates a TOML job spec of any type. If the
+// spec is invalid, it returns the status to respond with.
+func (jc *JobsController) validateJobSpec(tomlString string) (jb job.Job, status int, err error) {
+	jobType, err := job.ValidateSpec(tomlString)
+	if err != nil {
+		return jb, http.StatusUnprocessableEntity, errors.Wrap(err, "failed to parse TOML")
+	}
+
 	config := jc.App.GetConfig()
 	switch jobType {
 	case job.OffchainReporting:
-		jb, err = ocr.ValidatedOracleSpecToml(jc.App.GetChains().EVM, request.TOML)
+		jb, err = ocr.ValidatedOracleSpecToml(jc.App.GetChains().EVM, tomlString)
 		if !config.Dev() && !config.FeatureOffchainReporting() {
-			jsonAPIError(c, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration"))
-			return
+			return jb, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration")
 		}
 	case job.OffchainReporting2:
-		jb, err = validate.ValidatedOracleSpecToml(jc.App.GetConfig(), request.TOML)
+		jb, err = validate.ValidatedOracleSpecToml(jc.App.GetConfig(), tomlString)
 		if !config.Dev() && !config.FeatureOffchainReporting2() {
-			jsonAPIError(c, http.StatusNotImplemented, errors.New("The Offchain Reporting 2 feature is disabled by configuration"))
-			return
+			return jb, http.StatusNotImplemented, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
 		}
 	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(request.TOML)
+		jb, err = directrequest.ValidatedDirectRequestSpec(tomlString)
 	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(jc.App.GetConfig(), request.TOML)
+		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(jc.App.GetConfig(), tomlString)
 	case job.Keeper:
-		jb, err = keeper.ValidatedKeeperSpec(request.TOML)
+		jb, err = keeper.ValidatedKeeperSpec(tomlString)
 	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(request.TOML)
+		jb, err = cron.ValidatedCronSpec(tomlString)
 	case job.VRF:
-		jb, err = vrf.ValidatedVRFSpec(request.TOML)
+		jb, err = vrf.ValidatedVRFSpec(tomlString)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(request.TOML, jc.App.GetExternalInitiatorManager())
+		jb, err = webhook.ValidatedWebhookSpec(tomlString, jc.App.GetExternalInitiatorManager())
 	case job.BlockhashStore:
-		jb, err = blockhashstore.ValidatedSpec(request.TOML)
+		jb, err = blockhashstore.ValidatedSpec(tomlString)
 	case job.Bootstrap:
-		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(request.TOML)
+		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(tomlString)
 	default:
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType))
+		return jb, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType)
+	}
+	if err != nil {
+		return jb, http.StatusBadRequest, err
+	}
+	jb.TOML = tomlString
+	return jb, 0, nil
+}
+
+// jobErrorStatus returns the status to respond with when a job could not be
+// saved.
+func jobErrorStatus(err error) int {
+	if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// UpdateJobRequest represents a request to replace the spec of a job.
+type UpdateJobRequest struct {
+	TOML string `json:"toml"`
+}
+
+// Update validates a new spec for a job and swaps the running job for it.
+// The job keeps its ID, external job ID and runs, and the previous spec is
+// kept as an earlier version.
+// Example:
+// "PUT <application>/jobs/:ID"
+func (jc *JobsController) Update(c *gin.Context) {
+	request := UpdateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	existing, ok := jc.findJob(c)
+	if !ok {
 		return
 	}
+
+	jb, status, err := jc.validateJobSpec(request.TOML)
 	if err != nil {
-		jsonAPIError(c, http.StatusBadRequest, err)
+		jsonAPIError(c, status, err)
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
-	defer cancel()
-	err = jc.App.AddJobV2(ctx, &jb)
+	jc.updateJob(c, existing, jb)
+}
+
+// Versions lists all versions of a job's spec, latest first.
+// Example:
+// "GET <application>/jobs/:ID/versions"
+func (jc *JobsController) Versions(c *gin.Context) {
+	existing, ok := jc.findJob(c)
+	if !ok {
+		return
+	}
+
+	versions, err := jc.App.JobORM().FindSpecVersions(existing.ID)
 	if err != nil {
-		if errors.Is(errors.Cause(err), job.ErrNoSuchKeyBundle) || errors.As(err, &keystore.KeyNotFoundError{}) || errors.Is(errors.Cause(err), job.ErrNoSuchTransmitterKey) {
-			jsonAPIError(c, http.StatusBadRequest, err)
-			return
-		}
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
 
+	jsonAPIResponse(c, presenters.NewJobSpecVersionResources(versions), "jobSpecVersions")
+}
+
+// RollbackJobRequest represents a request to restore an earlier version of a
+// job's spec.
+type RollbackJobRequest struct {
+	Version int32 `json:"version"`
+}
+
+// Rollback restores an earlier version of a job's spec. The restored spec
+// becomes the job's latest version.
+// Example:
+// "POST <application>/jobs/:ID/rollback"
+func (jc *JobsController) Rollback(c *gin.Context) {
+	request := RollbackJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	existing, ok := jc.findJob(c)
+	if !ok {
+		return
+	}
+
+	version, err := jc.App.JobORM().FindSpecVersion(existing.ID, request.Version)
+	if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("version %d not found", request.Version))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if !version.TOML.Valid {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("the spec of version %d was not recorded", request.Version))
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(version.TOML.String)
+	if err != nil {
+		jsonAPIError(c, status, errors.Wrapf(err, "version %d is no longer valid", request.Version))
+		return
+	}
+
+	jc.updateJob(c, existing, jb)
+}
+
+// Pause stops the services of a job until it is resumed. The job's spec, keys
+// and runs are kept.
+// Example:
+// "POST <application>/jobs/:ID/pause"
+func (jc *JobsController) Pause(c *gin.Context) {
+	existing, ok := jc.findJob(c)
+	if !ok {
+		return
+	}
+
+	if err := jc.App.PauseJob(c.Request.Context(), existing.ID); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jc.showJob(c, existing.ID)
+}
+
+// Resume starts the services of a paused job again.
+// Example:
+// "POST <application>/jobs/:ID/resume"
+func (jc *JobsController) Resume(c *gin.Context) {
+	existing, ok := jc.findJob(c)
+	if !ok {
+		return
+	}
+
+	if err := jc.App.ResumeJob(c.Request.Context(), existing.ID); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jc.showJob(c, existing.ID)
+}
+
+// Poll makes a running flux monitor job poll and submit an answer, regardless
+// of deviation.
+// Example:
+// "POST <application>/jobs/:ID/flux_monitor/poll"
+func (jc *JobsController) Poll(c *gin.Context) {
+	existing, ok := jc.findFluxMonitorJob(c)
+	if !ok {
+		return
+	}
+
+	err := jc.App.RequestFluxMonitorPoll(c.Request.Context(), existing.ID)
+	if errors.Is(err, fluxmonitorv2.ErrJobNotRunning) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jc.showJob(c, existing.ID)
+}
+
+// Deviation runs the pipeline of a running flux monitor job and shows the
+// deviation of its answer from the latest submission. Nothing is submitted,
+// but as the pipeline is run it requires the run role.
+// Example:
+// "GET <application>/jobs/:ID/flux_monitor/deviation"
+func (jc *JobsController) Deviation(c *gin.Context) {
+	existing, ok := jc.findFluxMonitorJob(c)
+	if !ok {
+		return
+	}
+
+	preview, err := jc.App.PreviewFluxMonitorDeviation(c.Request.Context(), existing.ID)
+	if errors.Is(err, fluxmonitorv2.ErrJobNotRunning) {
+		jsonAPIError(c, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewDeviationPreviewResource(preview), "deviationPreviews")
+}
+
+// Rejections lists the oracle requests which a direct request job rejected
+// without running them, the most recent first.
+// Example:
+// "GET <application>/jobs/:ID/direct_request/rejections"
+func (jc *JobsController) Rejections(c *gin.Context, size, page, offset int) {
+	existing, ok := jc.findJob(c)
+	if !ok {
+		return
+	}
+	if existing.Type != job.DirectRequest {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %d is not a direct request job", existing.ID))
+		return
+	}
+
+	rejections, count, err := jc.App.DirectRequestORM().FindRejections(existing.ID, offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	paginatedResponse(c, "directRequestRejections", size, page, presenters.NewDirectRequestRejectionResources(rejections), count, err)
+}
+
+// BackfillBlockhashesRequest is a request for a batch of a blockhash store
+// backfill. Anchor is the anchor returned by the previous batch, if any.
+type BackfillBlockhashesRequest struct {
+	FromBlock uint64 `json:"fromBlock"`
+	ToBlock   uint64 `json:"toBlock"`
+	BatchSize uint64 `json:"batchSize"`
+	Anchor    uint64 `json:"anchor"`
+}
+
+// Backfill runs a batch of a backfill of the blockhashes of historical blocks
+// with unfulfilled VRF requests, for a blockhash store job. The range is
+// scanned backwards, the batch ends at toBlock and the response holds the
+// block and anchor the next batch starts from.
+// Example:
+// "POST <application>/jobs/:ID/blockhash_store/backfill"
+func (jc *JobsController) Backfill(c *gin.Context) {
+	existing, ok := jc.findJob(c)
+	if !ok {
+		return
+	}
+	if existing.Type != job.BlockhashStore {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %d is not a blockhash store job", existing.ID))
+		return
+	}
+
+	var request BackfillBlockhashesRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.FromBlock > request.ToBlock {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("fromBlock must not be after toBlock"))
+		return
+	}
+	if request.BatchSize == 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("batchSize must be greater than 0"))
+		return
+	}
+
+	progress, err := jc.App.BackfillBlockhashes(c.Request.Context(), existing.ID,
+		request.FromBlock, request.ToBlock, request.BatchSize, request.Anchor)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewBlockhashStoreBackfillResource(existing.ID, progress), "blockhashStoreBackfills")
+}
+
+// findFluxMonitorJob finds the flux monitor job with the :ID param, or
+// responds with an error.
+func (jc *JobsController) findFluxMonitorJob(c *gin.Context) (jb job.Job, ok bool) {
+	jb, ok = jc.findJob(c)
+	if !ok {
+		return jb, false
+	}
+	if jb.Type != job.FluxMonitor {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %d is not a flux monitor job", jb.ID))
+		return jb, false
+	}
+	return jb, true
+}
+
+func (jc *JobsController) showJob(c *gin.Context, id int32) {
+	jb, err := jc.App.JobORM().FindJob(c.Request.Context(), id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
+}
+
+// findJob finds the job with the :ID param, or responds with an error.
+func (jc *JobsController) findJob(c *gin.Context) (jb job.Job, ok bool) {
+	if err := jb.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return jb, false
+	}
+	jb, err := jc.App.JobORM().FindJob(c.Request.Context(), jb.ID)
+	if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return jb, false
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return jb, false
+	}
+	return jb, true
+}
+
+func (jc *JobsController) updateJob(c *gin.Context, existing job.Job, jb job.Job) {
+	if jb.Type != existing.Type {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job type can not be changed from %s to %s", existing.Type, jb.Type))
+		return
+	}
+	if jb.ExternalJobID != (uuid.UUID{}) && jb.ExternalJobID != existing.ExternalJobID {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("externalJobID can not be changed"))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	if err := jc.App.UpdateJobV2(ctx, existing.ID, &jb); err != nil {
+		jsonAPIError(c, jobErrorStatus(err), err)
+		return
+	}
+
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// SimulateJobRequest represents a request to execute a job's pipeline without creating the job.
+type SimulateJobRequest struct {
+	TOML string `json:"toml"`
+	// Vars are passed to the pipeline run, e.g. {"jobRun": {"requestBody": "..."}}
+	Vars map[string]interface{} `json:"vars"`
+	// EthTxOutputs are the stubbed outputs of ethtx tasks, keyed by task dot ID
+	EthTxOutputs map[string]interface{} `json:"ethTxOutputs"`
+}
+
+// Simulate validates a job spec and executes its pipeline in memory, returning
+// the results of every task. Nothing is saved and no transactions are sent, but
+// bridge and http tasks call their endpoints.
+// Example:
+// "POST <application>/jobs/simulate"
+func (jc *JobsController) Simulate(c *gin.Context) {
+	request := SimulateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	run, err := jc.App.SimulateJobV2(c.Request.Context(), request.TOML, request.Vars, request.EthTxOutputs)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineRunResource(run, jc.App.GetLogger()), "pipelineRun")
+}
+
 // Delete hard deletes a job spec.
 // Example:
 // "DELETE <application>/specs/:ID"
@@ -185,3 +538,301 @@ func (jc *JobsController) Delete(c *gin.Context) {
 
 	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
 }
+
+// Export returns a bundle of jobs together with the bridges and external
+// initiators they reference, to be imported on another node. Jobs are
+// selected with the comma separated ids query param, all jobs are exported if
+// it is empty.
+// Example:
+// "GET <application>/jobs/export?ids=1,2"
+func (jc *JobsController) Export(c *gin.Context) {
+	var jbs []job.Job
+	if ids := c.Query("ids"); ids != "" {
+		for _, id := range strings.Split(ids, ",") {
+			var jb job.Job
+			if err := jb.SetID(strings.TrimSpace(id)); err != nil {
+				jsonAPIError(c, http.StatusUnprocessableEntity, err)
+				return
+			}
+			jb, err := jc.App.JobORM().FindJob(c.Request.Context(), jb.ID)
+			if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+				jsonAPIError(c, http.StatusNotFound, errors.Errorf("job %s not found", strings.TrimSpace(id)))
+				return
+			} else if err != nil {
+				jsonAPIError(c, http.StatusInternalServerError, err)
+				return
+			}
+			jbs = append(jbs, jb)
+		}
+	} else {
+		all, _, err := jc.App.JobORM().FindJobs(0, math.MaxInt32)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		// Export the oldest jobs first, so that they are created in the same
+		// order on import.
+		sort.Slice(all, func(i, j int) bool { return all[i].ID < all[j].ID })
+		jbs = all
+	}
+
+	bundle, skipped, status, err := jc.exportBundle(jbs)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobBundleResource(bundle, skipped), "jobBundle")
+}
+
+// exportBundle skips the jobs whose spec was not recorded, which is the case
+// for jobs created before spec versions were, and returns them separately so
+// that the rest of the jobs can still be exported.
+func (jc *JobsController) exportBundle(jbs []job.Job) (bundle job.Bundle, skipped []presenters.JobBundleSkippedJob, status int, err error) {
+	bundle = job.Bundle{
+		Version:            job.BundleVersion,
+		Jobs:               []job.BundleJob{},
+		Bridges:            []bridges.BridgeTypeRequest{},
+		ExternalInitiators: []job.BundleExternalInitiator{},
+	}
+	var bridgeNames []bridges.BridgeName
+	seenBridges := make(map[bridges.BridgeName]struct{})
+	var eiNames []string
+	seenEIs := make(map[string]struct{})
+
+	for _, jb := range jbs {
+		versions, err := jc.App.JobORM().FindSpecVersions(jb.ID)
+		if err != nil {
+			return bundle, nil, http.StatusInternalServerError, err
+		}
+		if len(versions) == 0 || !versions[0].TOML.Valid {
+			skipped = append(skipped, presenters.JobBundleSkippedJob{
+				ID:     jb.ID,
+				Name:   jb.Name.ValueOrZero(),
+				Reason: "the spec of the job was not recorded, update the job to export it",
+			})
+			continue
+		}
+		toml := versions[0].TOML.String
+		bundle.Jobs = append(bundle.Jobs, job.BundleJob{
+			Name:          jb.Name.ValueOrZero(),
+			ExternalJobID: jb.ExternalJobID,
+			Type:          jb.Type,
+			TOML:          toml,
+		})
+
+		p, err := pipeline.Parse(jb.PipelineSpec.DotDagSource)
+		if err != nil {
+			return bundle, nil, http.StatusInternalServerError, errors.Wrapf(err, "failed to parse the pipeline of job %d", jb.ID)
+		}
+		names, err := job.BridgeNames(*p)
+		if err != nil {
+			return bundle, nil, http.StatusInternalServerError, err
+		}
+		for _, name := range names {
+			if _, ok := seenBridges[name]; !ok {
+				seenBridges[name] = struct{}{}
+				bridgeNames = append(bridgeNames, name)
+			}
+		}
+
+		if jb.Type == job.Webhook {
+			names, err := webhook.ExternalInitiatorNames(toml)
+			if err != nil {
+				return bundle, nil, http.StatusInternalServerError, err
+			}
+			for _, name := range names {
+				if _, ok := seenEIs[name]; !ok {
+					seenEIs[name] = struct{}{}
+					eiNames = append(eiNames, name)
+				}
+			}
+		}
+	}
+
+	orm := jc.App.BridgeORM()
+	if len(bridgeNames) > 0 {
+		bts, err := orm.FindBridges(bridgeNames)
+		if err != nil {
+			return bundle, nil, http.StatusInternalServerError, err
+		}
+		sort.Slice(bts, func(i, j int) bool { return bts[i].Name < bts[j].Name })
+		for _, bt := range bts {
+			bundle.Bridges = append(bundle.Bridges, bridges.BridgeTypeRequest{
+				Name:                    bt.Name,
+				URL:                     bt.URL,
+				Confirmations:           bt.Confirmations,
+				MinimumContractPayment:  bt.MinimumContractPayment,
+				RateLimit:               bt.RateLimit,
+				RateLimitBurst:          bt.RateLimitBurst,
+				CircuitBreakerThreshold: bt.CircuitBreakerThreshold,
+				CircuitBreakerCooldown:  bt.CircuitBreakerCooldown,
+				CacheTTL:                bt.CacheTTL,
+			})
+		}
+	}
+	for _, name := range eiNames {
+		ei, err := orm.FindExternalInitiatorByName(name)
+		if err != nil {
+			return bundle, nil, http.StatusInternalServerError, errors.Wrapf(err, "failed to find external initiator %s", name)
+		}
+		bundle.ExternalInitiators = append(bundle.ExternalInitiators, job.BundleExternalInitiator{Name: ei.Name, URL: ei.URL})
+	}
+	return bundle, skipped, 0, nil
+}
+
+// ImportJobsRequest represents a request to import a bundle of jobs.
+type ImportJobsRequest struct {
+	Bundle job.Bundle `json:"bundle"`
+	// OnConflict decides what happens to jobs and bridges which already
+	// exist, it defaults to job.BundleConflictFail.
+	OnConflict job.BundleConflictPolicy `json:"onConflict"`
+}
+
+// Import validates a bundle of jobs and creates its bridges and jobs in a
+// single transaction. Jobs conflict with existing jobs with the same external
+// job ID, bridges with existing bridges with the same name.
+// Example:
+// "POST <application>/jobs/import"
+func (jc *JobsController) Import(c *gin.Context) {
+	request := ImportJobsRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	switch request.OnConflict {
+	case "":
+		request.OnConflict = job.BundleConflictFail
+	case job.BundleConflictFail, job.BundleConflictSkip:
+	default:
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid conflict policy %q, must be %q or %q", request.OnConflict, job.BundleConflictFail, job.BundleConflictSkip))
+		return
+	}
+	bundle := request.Bundle
+	if bundle.Version != job.BundleVersion {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("unsupported bundle version %d, expected %d", bundle.Version, job.BundleVersion))
+		return
+	}
+
+	orm := jc.App.BridgeORM()
+	for _, ei := range bundle.ExternalInitiators {
+		_, err := orm.FindExternalInitiatorByName(ei.Name)
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("external initiator %s does not exist, it must be created before importing the bundle", ei.Name))
+			return
+		} else if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	var conflicts []string
+	var results []presenters.JobImportResultResource
+	var bts []*bridges.BridgeType
+	bundleBridges := make(map[bridges.BridgeName]struct{})
+	for i := range bundle.Bridges {
+		btr := bundle.Bridges[i]
+		if err := ValidateBridgeType(&btr); err != nil {
+			jsonAPIError(c, http.StatusBadRequest, errors.Wrapf(err, "bridge %s", btr.Name))
+			return
+		}
+		if _, ok := bundleBridges[btr.Name]; ok {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("bridge %s is in the bundle more than once", btr.Name))
+			return
+		}
+		bundleBridges[btr.Name] = struct{}{}
+
+		_, err := orm.FindBridge(btr.Name)
+		if err == nil {
+			conflicts = append(conflicts, fmt.Sprintf("bridge %s already exists", btr.Name))
+			results = append(results, presenters.JobImportResultResource{JAID: presenters.NewJAID(btr.Name.String()), Kind: "bridge", Name: btr.Name.String(), Status: "skipped"})
+			continue
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		bta, bt, err := bridges.NewBridgeType(&btr)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		if err = bt.SetCredentials(&btr, jc.App.GetKeyStore()); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		bts = append(bts, bt)
+		results = append(results, presenters.JobImportResultResource{JAID: presenters.NewJAID(bt.Name.String()), Kind: "bridge", Name: bt.Name.String(), Status: "created", IncomingToken: bta.IncomingToken})
+	}
+
+	var jbs []*job.Job
+	bundleJobs := make(map[uuid.UUID]struct{})
+	for i, bj := range bundle.Jobs {
+		jb, status, err := jc.validateJobSpec(bj.TOML)
+		if err != nil {
+			jsonAPIError(c, status, errors.Wrapf(err, "job %d (%s)", i, bj.Name))
+			return
+		}
+		// Keep the external job ID of specs which do not set it, so that
+		// the bundle can be imported again without duplicating jobs.
+		if jb.ExternalJobID == (uuid.UUID{}) {
+			jb.ExternalJobID = bj.ExternalJobID
+		} else if bj.ExternalJobID != (uuid.UUID{}) && bj.ExternalJobID != jb.ExternalJobID {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %d (%s): externalJobID %s does not match the spec's %s", i, bj.Name, bj.ExternalJobID, jb.ExternalJobID))
+			return
+		}
+
+		if jb.ExternalJobID != (uuid.UUID{}) {
+			if _, ok := bundleJobs[jb.ExternalJobID]; ok {
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %s is in the bundle more than once", jb.ExternalJobID))
+				return
+			}
+			bundleJobs[jb.ExternalJobID] = struct{}{}
+
+			existing, err := jc.App.JobORM().FindJobByExternalJobID(jb.ExternalJobID, pg.WithParentCtx(c.Request.Context()))
+			if err == nil {
+				conflicts = append(conflicts, fmt.Sprintf("job %s already exists with ID %d", jb.ExternalJobID, existing.ID))
+				results = append(results, presenters.JobImportResultResource{JAID: presenters.NewJAIDInt32(existing.ID), Kind: "job", Name: jb.Name.ValueOrZero(), Status: "skipped"})
+				continue
+			} else if !errors.Is(errors.Cause(err), sql.ErrNoRows) {
+				jsonAPIError(c, http.StatusInternalServerError, err)
+				return
+			}
+		}
+
+		names, err := job.BridgeNames(jb.Pipeline)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrapf(err, "job %d (%s)", i, bj.Name))
+			return
+		}
+		for _, name := range names {
+			if _, ok := bundleBridges[name]; ok {
+				continue
+			}
+			if _, err := orm.FindBridge(name); errors.Is(err, sql.ErrNoRows) {
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job %d (%s) calls bridge %s, which is neither in the bundle nor on this node", i, bj.Name, name))
+				return
+			} else if err != nil {
+				jsonAPIError(c, http.StatusInternalServerError, err)
+				return
+			}
+		}
+
+		jbs = append(jbs, &jb)
+	}
+
+	if len(conflicts) > 0 && request.OnConflict == job.BundleConflictFail {
+		jsonAPIError(c, http.StatusConflict, errors.Errorf("the bundle conflicts with this node: %s", strings.Join(conflicts, "; ")))
+		return
+	}
+
+	if err := jc.App.ImportJobs(c.Request.Context(), jbs, bts); err != nil {
+		jsonAPIError(c, jobErrorStatus(err), err)
+		return
+	}
+
+	for _, jb := range jbs {
+		results = append(results, presenters.JobImportResultResource{JAID: presenters.NewJAIDInt32(jb.ID), Kind: "job", Name: jb.Name.ValueOrZero(), Status: "created"})
+	}
+	jsonAPIResponse(c, results, "jobImportResults")
+}
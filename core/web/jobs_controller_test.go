@@ -8,12 +8,14 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	p2ppeer "github.com/libp2p/go-libp2p-core/peer"
+	"github.com/manyminds/api2go/jsonapi"
 	"github.com/pelletier/go-toml"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,6 +23,7 @@ import (
 
 	"github.com/smartcontractkit/sqlx"
 
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
@@ -29,6 +32,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/tomlutils"
 	"github.com/smartcontractkit/chainlink/core/web"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -373,6 +377,45 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJobsController_Simulate(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	tomlStr := `
+type            = "webhook"
+schemaVersion   = 1
+observationSource   = """
+    multiply [type="multiply" input="$(val)" times=100]
+"""
+`
+	body, _ := json.Marshal(web.SimulateJobRequest{
+		TOML: tomlStr,
+		Vars: map[string]interface{}{"val": 42},
+	})
+	response, cleanup := client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+	defer cleanup()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+	resource := presenters.PipelineRunResource{}
+	err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource)
+	require.NoError(t, err)
+	require.Len(t, resource.TaskRuns, 1)
+	require.Len(t, resource.Outputs, 1)
+	assert.Equal(t, "4200", *resource.Outputs[0])
+
+	// Nothing is persisted
+	runs, count, err := app.JobORM().PipelineRuns(nil, 0, 10)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	assert.Empty(t, runs)
+
+	body, _ = json.Marshal(web.SimulateJobRequest{TOML: "some wrong value"})
+	response, cleanup = client.Post("/v2/jobs/simulate", bytes.NewReader(body))
+	defer cleanup()
+	require.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+}
+
 func TestJobsController_FailToCreate_EmptyJsonAttribute(t *testing.T) {
 	app := cltest.NewApplicationEVMDisabled(t)
 	require.NoError(t, app.Start(testutils.Context(t)))
@@ -469,6 +512,314 @@ func TestJobsController_Show_NonExistentID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusNotFound)
 }
 
+func TestJobsController_Update_Versions_Rollback(t *testing.T) {
+	app, client, ocrJob, _, _, jobID := setupJobSpecsControllerTestsWithJobs(t)
+
+	spec := string(cltest.MustReadFile(t, "../testdata/tomlspecs/direct-request-spec.toml"))
+	update := func(t *testing.T, id interface{}, toml string, status int) presenters.JobResource {
+		body, err := json.Marshal(web.UpdateJobRequest{TOML: toml})
+		require.NoError(t, err)
+		response, cleanup := client.Put(fmt.Sprintf("/v2/jobs/%v", id), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, status)
+
+		var resource presenters.JobResource
+		if status == http.StatusOK {
+			require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		}
+		return resource
+	}
+	rollback := func(t *testing.T, version int32, status int) presenters.JobResource {
+		body, err := json.Marshal(web.RollbackJobRequest{Version: version})
+		require.NoError(t, err)
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%v/rollback", jobID), bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, status)
+
+		var resource presenters.JobResource
+		if status == http.StatusOK {
+			require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		}
+		return resource
+	}
+
+	updated := update(t, jobID, strings.Replace(spec, "times=100", "times=1000", 1), http.StatusOK)
+	assert.Equal(t, fmt.Sprintf("%v", jobID), updated.ID)
+	assert.Contains(t, updated.PipelineSpec.DotDAGSource, "times=1000")
+	updated = update(t, jobID, strings.Replace(spec, "times=100", "times=10", 1), http.StatusOK)
+	assert.Contains(t, updated.PipelineSpec.DotDAGSource, "times=10]")
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%v/versions", jobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var versions []presenters.JobSpecVersionResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &versions))
+	require.Len(t, versions, 3)
+	assert.Equal(t, int32(3), versions[0].Version)
+	assert.True(t, versions[0].TOML.Valid)
+	// The job was created without recording its spec.
+	assert.Equal(t, int32(1), versions[2].Version)
+	assert.False(t, versions[2].TOML.Valid)
+
+	rolledBack := rollback(t, 2, http.StatusOK)
+	assert.Contains(t, rolledBack.PipelineSpec.DotDAGSource, "times=1000")
+	rollback(t, 1, http.StatusUnprocessableEntity)
+	rollback(t, 9, http.StatusNotFound)
+
+	t.Run("rejects changes of the job type", func(t *testing.T) {
+		update(t, ocrJob.ID, spec, http.StatusUnprocessableEntity)
+	})
+
+	t.Run("rejects invalid specs", func(t *testing.T) {
+		update(t, jobID, "bad", http.StatusUnprocessableEntity)
+	})
+
+	t.Run("returns 404 for unknown jobs", func(t *testing.T) {
+		update(t, 999999999, spec, http.StatusNotFound)
+	})
+
+	jobs, _, err := app.JobORM().FindJobs(0, 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+}
+
+func TestJobsController_Pause_Resume(t *testing.T) {
+	app, client, _, _, _, jobID := setupJobSpecsControllerTestsWithJobs(t)
+
+	post := func(t *testing.T, path string, status int) presenters.JobResource {
+		response, cleanup := client.Post(path, nil)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, status)
+
+		var resource presenters.JobResource
+		if status == http.StatusOK {
+			require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		}
+		return resource
+	}
+
+	paused := post(t, fmt.Sprintf("/v2/jobs/%v/pause", jobID), http.StatusOK)
+	assert.True(t, paused.PausedAt.Valid)
+	assert.NotContains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	resumed := post(t, fmt.Sprintf("/v2/jobs/%v/resume", jobID), http.StatusOK)
+	assert.False(t, resumed.PausedAt.Valid)
+	assert.Contains(t, app.JobSpawner().ActiveJobs(), jobID)
+
+	post(t, "/v2/jobs/999999999/pause", http.StatusNotFound)
+	post(t, "/v2/jobs/999999999/resume", http.StatusNotFound)
+}
+
+func TestJobsController_FluxMonitor_PollDeviation(t *testing.T) {
+	_, client, _, jobID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%v/flux_monitor/poll", jobID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%v/flux_monitor/deviation", jobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Post("/v2/jobs/999999999/flux_monitor/poll", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	response, cleanup = client.Get("/v2/jobs/999999999/flux_monitor/deviation")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func TestJobsController_DirectRequest_Rejections(t *testing.T) {
+	app, client, _, ocrJobID, _, drJobID := setupJobSpecsControllerTestsWithJobs(t)
+
+	requester := testutils.NewAddress()
+	orm := app.DirectRequestORM()
+	for i := 0; i < 2; i++ {
+		require.NoError(t, orm.CreateRejection(&directrequest.Rejection{
+			JobID:       drJobID,
+			RequestID:   utils.NewHash(),
+			Requester:   requester,
+			Payment:     utils.NewBigI(99),
+			Reason:      directrequest.RejectionReasonInsufficientPayment,
+			TxHash:      utils.NewHash(),
+			BlockNumber: 10,
+		}))
+	}
+
+	response, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%v/direct_request/rejections?size=1", drJobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+
+	var links jsonapi.Links
+	var rejections []presenters.DirectRequestRejectionResource
+	require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, response), &rejections, &links))
+	assert.NotEmpty(t, links["next"].Href)
+	require.Len(t, rejections, 1)
+	assert.Equal(t, drJobID, rejections[0].JobID)
+	assert.Equal(t, requester, rejections[0].Requester)
+	assert.Equal(t, directrequest.RejectionReasonInsufficientPayment, rejections[0].Reason)
+
+	response, cleanup = client.Get(fmt.Sprintf("/v2/jobs/%v/direct_request/rejections", ocrJobID))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Get("/v2/jobs/999999999/direct_request/rejections")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func TestJobsController_BlockhashStore_Backfill(t *testing.T) {
+	_, client, _, jobID, _, _ := setupJobSpecsControllerTestsWithJobs(t)
+
+	body, err := json.Marshal(web.BackfillBlockhashesRequest{FromBlock: 10, ToBlock: 20, BatchSize: 5})
+	require.NoError(t, err)
+
+	response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%v/blockhash_store/backfill", jobID), bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Post("/v2/jobs/999999999/blockhash_store/backfill", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
+func TestJobsController_Export_Import(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetSqlxDB(), cltest.BridgeOpts{}, app.GetConfig())
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetSqlxDB(), cltest.BridgeOpts{}, app.GetConfig())
+
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	tomlStr := fmt.Sprintf(testspecs.WebhookSpecNoBody, fetchBridge.Name.String(), submitBridge.Name.String())
+	body, err := json.Marshal(web.CreateJobRequest{TOML: tomlStr})
+	require.NoError(t, err)
+	response, cleanup := client.Post("/v2/jobs", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusOK)
+	var created presenters.JobResource
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &created))
+
+	export := func(t *testing.T, path string, status int) job.Bundle {
+		response, cleanup := client.Get(path)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, status)
+
+		var resource presenters.JobBundleResource
+		if status == http.StatusOK {
+			require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		}
+		return resource.Bundle
+	}
+	importBundle := func(t *testing.T, bundle job.Bundle, onConflict job.BundleConflictPolicy, status int) []presenters.JobImportResultResource {
+		body, err := json.Marshal(web.ImportJobsRequest{Bundle: bundle, OnConflict: onConflict})
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/import", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, status)
+
+		var results []presenters.JobImportResultResource
+		if status == http.StatusOK {
+			require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &results))
+		}
+		return results
+	}
+
+	bundle := export(t, "/v2/jobs/export?ids="+created.ID, http.StatusOK)
+	assert.Equal(t, job.BundleVersion, bundle.Version)
+	require.Len(t, bundle.Jobs, 1)
+	assert.Equal(t, tomlStr, bundle.Jobs[0].TOML)
+	assert.Equal(t, job.Webhook, bundle.Jobs[0].Type)
+	assert.Equal(t, "0eec7e1d-d0d2-476c-a1a8-72dfb6633f53", bundle.Jobs[0].ExternalJobID.String())
+	require.Len(t, bundle.Bridges, 2)
+	for _, btr := range bundle.Bridges {
+		assert.Contains(t, []bridges.BridgeName{fetchBridge.Name, submitBridge.Name}, btr.Name)
+		assert.Nil(t, btr.SigningSecret)
+	}
+	assert.Empty(t, bundle.ExternalInitiators)
+
+	assert.Equal(t, bundle, export(t, "/v2/jobs/export", http.StatusOK))
+	export(t, "/v2/jobs/export?ids=999999999", http.StatusNotFound)
+	export(t, "/v2/jobs/export?ids=foo", http.StatusUnprocessableEntity)
+
+	t.Run("skips jobs without a recorded spec", func(t *testing.T) {
+		db := app.GetSqlxDB()
+		_, err := db.Exec(`UPDATE job_spec_versions SET toml = NULL WHERE job_id = $1`, created.ID)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_, err := db.Exec(`UPDATE job_spec_versions SET toml = $2 WHERE job_id = $1`, created.ID, tomlStr)
+			require.NoError(t, err)
+		})
+
+		response, cleanup := client.Get("/v2/jobs/export?ids=" + created.ID)
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+		var resource presenters.JobBundleResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		assert.Empty(t, resource.Jobs)
+		assert.Empty(t, resource.Bridges)
+		require.Len(t, resource.SkippedJobs, 1)
+		assert.Equal(t, created.ID, fmt.Sprintf("%d", resource.SkippedJobs[0].ID))
+	})
+
+	t.Run("fails or skips on conflicts", func(t *testing.T) {
+		importBundle(t, bundle, "", http.StatusConflict)
+		importBundle(t, bundle, "invalid", http.StatusUnprocessableEntity)
+
+		results := importBundle(t, bundle, job.BundleConflictSkip, http.StatusOK)
+		require.Len(t, results, 3)
+		for _, result := range results {
+			assert.Equal(t, "skipped", result.Status)
+		}
+		assert.Equal(t, created.ID, results[2].ID)
+	})
+
+	t.Run("rejects invalid bundles", func(t *testing.T) {
+		invalid := bundle
+		invalid.Version = 2
+		importBundle(t, invalid, job.BundleConflictSkip, http.StatusUnprocessableEntity)
+
+		invalid = bundle
+		invalid.Jobs = []job.BundleJob{{TOML: "bad"}}
+		importBundle(t, invalid, job.BundleConflictSkip, http.StatusUnprocessableEntity)
+
+		invalid = bundle
+		invalid.ExternalInitiators = []job.BundleExternalInitiator{{Name: "missing"}}
+		importBundle(t, invalid, job.BundleConflictSkip, http.StatusUnprocessableEntity)
+	})
+
+	response, cleanup = client.Delete("/v2/jobs/" + created.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, response, http.StatusNoContent)
+	require.NoError(t, app.BridgeORM().DeleteBridgeType(fetchBridge))
+
+	t.Run("rejects jobs calling missing bridges", func(t *testing.T) {
+		invalid := bundle
+		invalid.Bridges = nil
+		importBundle(t, invalid, job.BundleConflictFail, http.StatusUnprocessableEntity)
+	})
+
+	results := importBundle(t, bundle, job.BundleConflictSkip, http.StatusOK)
+	require.Len(t, results, 3)
+	byName := make(map[string]presenters.JobImportResultResource)
+	for _, result := range results {
+		byName[result.Name] = result
+	}
+	assert.Equal(t, "created", byName[fetchBridge.Name.String()].Status)
+	assert.NotEmpty(t, byName[fetchBridge.Name.String()].IncomingToken)
+	assert.Equal(t, "skipped", byName[submitBridge.Name.String()].Status)
+	assert.Empty(t, byName[submitBridge.Name.String()].IncomingToken)
+	assert.Equal(t, "created", results[2].Status)
+
+	imported, err := app.JobORM().FindJobByExternalJobID(bundle.Jobs[0].ExternalJobID)
+	require.NoError(t, err)
+	assert.Equal(t, results[2].ID, fmt.Sprintf("%v", imported.ID))
+	_, err = app.BridgeORM().FindBridge(fetchBridge.Name)
+	require.NoError(t, err)
+}
+
 func runOCRJobSpecAssertions(t *testing.T, ocrJobSpecFromFileDB job.Job, ocrJobSpecFromServer presenters.JobResource) {
 	ocrJobSpecFromFile := ocrJobSpecFromFileDB.OCROracleSpec
 	assert.Equal(t, ocrJobSpecFromFile.ContractAddress, ocrJobSpecFromServer.OffChainReportingSpec.ContractAddress)
@@ -0,0 +1,48 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// KeeperController inspects keeper registries and their upkeeps.
+type KeeperController struct {
+	App chainlink.Application
+}
+
+// UpkeepReport checks an upkeep at the latest head, the same way the keeper
+// job of the registry does, and reports whether this node would perform it.
+// Nothing is submitted.
+// Example:
+//  "<application>/v2/keeper_registries/:address/upkeeps/:upkeepID/report"
+func (kc *KeeperController) UpkeepReport(c *gin.Context) {
+	registryAddress, err := ethkey.NewEIP55Address(c.Param("address"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	var upkeepID utils.Big
+	if err = upkeepID.UnmarshalText([]byte(c.Param("upkeepID"))); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid upkeep ID"))
+		return
+	}
+
+	report, err := kc.App.ReportUpkeep(c.Request.Context(), registryAddress, upkeepID.ToInt())
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("registry or upkeep not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewUpkeepReportResource(report), "upkeep_reports")
+}
@@ -0,0 +1,35 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+)
+
+func TestKeeperController_UpkeepReport_Errors(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	tests := []struct {
+		name   string
+		path   string
+		status int
+	}{
+		{"invalid registry address", "/v2/keeper_registries/0xabc/upkeeps/1/report", http.StatusUnprocessableEntity},
+		{"invalid upkeep ID", "/v2/keeper_registries/0x5431F5F973781809D18643b87B44921b11355d81/upkeeps/one/report", http.StatusUnprocessableEntity},
+		{"unknown registry", "/v2/keeper_registries/0x5431F5F973781809D18643b87B44921b11355d81/upkeeps/1/report", http.StatusNotFound},
+	}
+	for _, tc := range tests {
+		resp, cleanup := client.Get(tc.path)
+		t.Cleanup(cleanup)
+		assert.Equal(t, tc.status, resp.StatusCode, tc.name)
+	}
+}
@@ -0,0 +1,43 @@
+package presenters
+
+import (
+	"strconv"
+
+	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+)
+
+// BlockhashStoreBackfillResource is a JSONAPI resource of the progress of a
+// blockhash store backfill after one of its batches. Its ID is the job ID.
+type BlockhashStoreBackfillResource struct {
+	JAID
+	FromBlock       uint64 `json:"fromBlock"`
+	ToBlock         uint64 `json:"toBlock"`
+	NextBlock       uint64 `json:"nextBlock"`
+	Anchor          uint64 `json:"anchor"`
+	Unfulfilled     int    `json:"unfulfilled"`
+	AlreadyStored   int    `json:"alreadyStored"`
+	Stored          int    `json:"stored"`
+	HeadersVerified int    `json:"headersVerified"`
+	Done            bool   `json:"done"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r BlockhashStoreBackfillResource) GetName() string {
+	return "blockhashStoreBackfills"
+}
+
+// NewBlockhashStoreBackfillResource returns a new BlockhashStoreBackfillResource
+func NewBlockhashStoreBackfillResource(jobID int32, p blockhashstore.BackfillProgress) *BlockhashStoreBackfillResource {
+	return &BlockhashStoreBackfillResource{
+		JAID:            NewJAID(strconv.FormatInt(int64(jobID), 10)),
+		FromBlock:       p.FromBlock,
+		ToBlock:         p.ToBlock,
+		NextBlock:       p.NextBlock,
+		Anchor:          p.Anchor,
+		Unfulfilled:     p.Unfulfilled,
+		AlreadyStored:   p.AlreadyStored,
+		Stored:          p.Stored,
+		HeadersVerified: p.HeadersVerified,
+		Done:            p.Done,
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
 // BridgeResource represents a Bridge JSONAPI resource.
@@ -14,10 +15,17 @@ type BridgeResource struct {
 	URL           string `json:"url"`
 	Confirmations uint32 `json:"confirmations"`
 	// The IncomingToken is only provided when creating a Bridge
-	IncomingToken          string       `json:"incomingToken,omitempty"`
-	OutgoingToken          string       `json:"outgoingToken"`
-	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	IncomingToken           string          `json:"incomingToken,omitempty"`
+	OutgoingToken           string          `json:"outgoingToken"`
+	MinimumContractPayment  *assets.Link    `json:"minimumContractPayment"`
+	RateLimit               float64         `json:"rateLimit"`
+	RateLimitBurst          uint32          `json:"rateLimitBurst"`
+	CircuitBreakerThreshold uint32          `json:"circuitBreakerThreshold"`
+	CircuitBreakerCooldown  models.Interval `json:"circuitBreakerCooldown"`
+	CacheTTL                models.Interval `json:"cacheTTL"`
+	SignsRequests           bool            `json:"signsRequests"`
+	ClientCertificate       string          `json:"clientCertificate,omitempty"`
+	CreatedAt               time.Time       `json:"createdAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -29,12 +37,19 @@ func (r BridgeResource) GetName() string {
 func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 	return &BridgeResource{
 		// Uses the name as the id...Should change this to the id
-		JAID:                   NewJAID(b.Name.String()),
-		Name:                   b.Name.String(),
-		URL:                    b.URL.String(),
-		Confirmations:          b.Confirmations,
-		OutgoingToken:          b.OutgoingToken,
-		MinimumContractPayment: b.MinimumContractPayment,
-		CreatedAt:              b.CreatedAt,
+		JAID:                    NewJAID(b.Name.String()),
+		Name:                    b.Name.String(),
+		URL:                     b.URL.String(),
+		Confirmations:           b.Confirmations,
+		OutgoingToken:           b.OutgoingToken,
+		MinimumContractPayment:  b.MinimumContractPayment,
+		RateLimit:               b.RateLimit,
+		RateLimitBurst:          b.RateLimitBurst,
+		CircuitBreakerThreshold: b.CircuitBreakerThreshold,
+		CircuitBreakerCooldown:  b.CircuitBreakerCooldown,
+		CacheTTL:                b.CacheTTL,
+		SignsRequests:           b.SignsRequests(),
+		ClientCertificate:       b.ClientCertificate.String,
+		CreatedAt:               b.CreatedAt,
 	}
 }
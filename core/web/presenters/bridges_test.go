@@ -45,6 +45,12 @@ func TestBridgeResource(t *testing.T) {
 			"confirmations":1,
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"rateLimit":0,
+			"rateLimitBurst":0,
+			"circuitBreakerThreshold":0,
+			"circuitBreakerCooldown":"0s",
+			"cacheTTL":"0s",
+			"signsRequests":false,
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
@@ -70,6 +76,12 @@ func TestBridgeResource(t *testing.T) {
 			"incomingToken": "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI",
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
 			"minimumContractPayment":"1",
+			"rateLimit":0,
+			"rateLimitBurst":0,
+			"circuitBreakerThreshold":0,
+			"circuitBreakerCooldown":"0s",
+			"cacheTTL":"0s",
+			"signsRequests":false,
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
 	}
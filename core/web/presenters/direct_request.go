@@ -0,0 +1,55 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// DirectRequestRejectionResource is a JSONAPI resource of an oracle request
+// rejected by a direct request job.
+type DirectRequestRejectionResource struct {
+	JAID
+	JobID       int32                         `json:"jobID"`
+	RequestID   common.Hash                   `json:"requestID"`
+	Requester   common.Address                `json:"requester"`
+	Payment     *utils.Big                    `json:"payment"`
+	Reason      directrequest.RejectionReason `json:"reason"`
+	TxHash      common.Hash                   `json:"txHash"`
+	BlockNumber int64                         `json:"blockNumber"`
+	CreatedAt   time.Time                     `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r DirectRequestRejectionResource) GetName() string {
+	return "directRequestRejections"
+}
+
+// NewDirectRequestRejectionResource returns a new DirectRequestRejectionResource
+func NewDirectRequestRejectionResource(r directrequest.Rejection) *DirectRequestRejectionResource {
+	return &DirectRequestRejectionResource{
+		JAID:        NewJAID(strconv.FormatInt(r.ID, 10)),
+		JobID:       r.JobID,
+		RequestID:   r.RequestID,
+		Requester:   r.Requester,
+		Payment:     r.Payment,
+		Reason:      r.Reason,
+		TxHash:      r.TxHash,
+		BlockNumber: r.BlockNumber,
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
+// NewDirectRequestRejectionResources returns a slice of DirectRequestRejectionResource
+func NewDirectRequestRejectionResources(rs []directrequest.Rejection) []DirectRequestRejectionResource {
+	resources := []DirectRequestRejectionResource{}
+	for _, r := range rs {
+		resources = append(resources, *NewDirectRequestRejectionResource(r))
+	}
+
+	return resources
+}
@@ -0,0 +1,60 @@
+package presenters
+
+import (
+	"strconv"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// DeviationPreviewResource is a JSONAPI resource showing how a flux monitor
+// job judges the answer its pipeline currently returns.
+type DeviationPreviewResource struct {
+	JAID
+	RoundID           uint32           `json:"roundID"`
+	EligibleToSubmit  bool             `json:"eligibleToSubmit"`
+	Hibernating       bool             `json:"hibernating"`
+	PipelineAnswer    *decimal.Decimal `json:"pipelineAnswer"`
+	PipelineError     string           `json:"pipelineError,omitempty"`
+	LatestAnswer      *utils.Big       `json:"latestAnswer"`
+	LatestSubmission  *utils.Big       `json:"latestSubmission"`
+	Threshold         float64          `json:"threshold"`
+	AbsoluteThreshold float64          `json:"absoluteThreshold"`
+	AbsoluteDeviation *decimal.Decimal `json:"absoluteDeviation"`
+	RelativeDeviation *decimal.Decimal `json:"relativeDeviation"`
+	OutsideDeviation  bool             `json:"outsideDeviation"`
+	ValidSubmission   bool             `json:"validSubmission"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r DeviationPreviewResource) GetName() string {
+	return "deviationPreviews"
+}
+
+// NewDeviationPreviewResource returns a new DeviationPreviewResource for the
+// preview of a job.
+func NewDeviationPreviewResource(preview fluxmonitorv2.DeviationPreview) *DeviationPreviewResource {
+	r := &DeviationPreviewResource{
+		JAID:              NewJAID(strconv.FormatInt(int64(preview.JobID), 10)),
+		RoundID:           preview.RoundID,
+		EligibleToSubmit:  preview.EligibleToSubmit,
+		Hibernating:       preview.Hibernating,
+		PipelineAnswer:    preview.PipelineAnswer,
+		PipelineError:     preview.PipelineError,
+		Threshold:         preview.Thresholds.Rel,
+		AbsoluteThreshold: preview.Thresholds.Abs,
+		AbsoluteDeviation: preview.AbsoluteDeviation,
+		RelativeDeviation: preview.RelativeDeviation,
+		OutsideDeviation:  preview.OutsideDeviation,
+		ValidSubmission:   preview.ValidSubmission,
+	}
+	if preview.LatestAnswer != nil {
+		r.LatestAnswer = utils.NewBig(preview.LatestAnswer)
+	}
+	if preview.LatestSubmission != nil {
+		r.LatestSubmission = utils.NewBig(preview.LatestSubmission)
+	}
+	return r
+}
@@ -44,6 +44,7 @@ type DirectRequestSpec struct {
 	MinIncomingConfirmationsEnv bool                     `json:"minIncomingConfirmationsEnv,omitempty"`
 	MinContractPayment          *assets.Link             `json:"minContractPaymentLinkJuels"`
 	Requesters                  models.AddressCollection `json:"requesters"`
+	RequesterLimits             job.RequesterLimits      `json:"requesterLimits"`
 	Initiator                   string                   `json:"initiator"`
 	CreatedAt                   time.Time                `json:"createdAt"`
 	UpdatedAt                   time.Time                `json:"updatedAt"`
@@ -59,6 +60,7 @@ func NewDirectRequestSpec(spec *job.DirectRequestSpec) *DirectRequestSpec {
 		MinIncomingConfirmationsEnv: spec.MinIncomingConfirmationsEnv,
 		MinContractPayment:          spec.MinContractPayment,
 		Requesters:                  spec.Requesters,
+		RequesterLimits:             spec.RequesterLimits,
 		// This is hardcoded to runlog. When we support other initiators, we need
 		// to change this
 		Initiator:  "runlog",
@@ -402,6 +404,7 @@ type JobResource struct {
 	ForwardingAllowed      bool                    `json:"forwardingAllowed"`
 	MaxTaskDuration        models.Interval         `json:"maxTaskDuration"`
 	ExternalJobID          uuid.UUID               `json:"externalJobID"`
+	PausedAt               null.Time               `json:"pausedAt"`
 	DirectRequestSpec      *DirectRequestSpec      `json:"directRequestSpec"`
 	FluxMonitorSpec        *FluxMonitorSpec        `json:"fluxMonitorSpec"`
 	CronSpec               *CronSpec               `json:"cronSpec"`
@@ -428,6 +431,7 @@ func NewJobResource(j job.Job) *JobResource {
 		MaxTaskDuration:   j.MaxTaskDuration,
 		PipelineSpec:      NewPipelineSpec(j.PipelineSpec),
 		ExternalJobID:     j.ExternalJobID,
+		PausedAt:          j.PausedAt,
 	}
 
 	switch j.Type {
@@ -466,3 +470,92 @@ func NewJobResource(j job.Job) *JobResource {
 func (r JobResource) GetName() string {
 	return "jobs"
 }
+
+// JobSpecVersionResource represents a version of a job's spec
+type JobSpecVersionResource struct {
+	JAID
+	JobID          int32       `json:"jobID"`
+	ExternalJobID  uuid.UUID   `json:"externalJobID"`
+	Version        int32       `json:"version"`
+	PipelineSpecID int32       `json:"pipelineSpecID"`
+	TOML           null.String `json:"toml"`
+	CreatedAt      time.Time   `json:"createdAt"`
+}
+
+// NewJobSpecVersionResource initializes a new JSONAPI job spec version resource
+func NewJobSpecVersionResource(v job.SpecVersion) JobSpecVersionResource {
+	return JobSpecVersionResource{
+		JAID:           NewJAIDInt64(v.ID),
+		JobID:          v.JobID,
+		ExternalJobID:  v.ExternalJobID,
+		Version:        v.Version,
+		PipelineSpecID: v.PipelineSpecID,
+		TOML:           v.TOML,
+		CreatedAt:      v.CreatedAt,
+	}
+}
+
+// NewJobSpecVersionResources initializes a slice of JSONAPI job spec version
+// resources
+func NewJobSpecVersionResources(versions []job.SpecVersion) []JobSpecVersionResource {
+	rs := []JobSpecVersionResource{}
+	for _, v := range versions {
+		rs = append(rs, NewJobSpecVersionResource(v))
+	}
+	return rs
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpecVersionResource) GetName() string {
+	return "jobSpecVersions"
+}
+
+// JobBundleResource represents a bundle of exported jobs
+type JobBundleResource struct {
+	JAID
+	job.Bundle
+	// SkippedJobs are the jobs which could not be exported. They are not part
+	// of the bundle.
+	SkippedJobs []JobBundleSkippedJob `json:"skippedJobs"`
+}
+
+// JobBundleSkippedJob is a job which was left out of an exported bundle
+type JobBundleSkippedJob struct {
+	ID     int32  `json:"id"`
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// NewJobBundleResource initializes a new JSONAPI job bundle resource
+func NewJobBundleResource(bundle job.Bundle, skipped []JobBundleSkippedJob) *JobBundleResource {
+	if skipped == nil {
+		skipped = []JobBundleSkippedJob{}
+	}
+	return &JobBundleResource{
+		JAID:        NewJAID("bundle"),
+		Bundle:      bundle,
+		SkippedJobs: skipped,
+	}
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobBundleResource) GetName() string {
+	return "jobBundles"
+}
+
+// JobImportResultResource represents the outcome of importing a job or a
+// bridge of a bundle. The ID is the job ID or the bridge name.
+type JobImportResultResource struct {
+	JAID
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	// IncomingToken is only set for created bridges, as it can not be
+	// retrieved later.
+	IncomingToken string `json:"incomingToken,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobImportResultResource) GetName() string {
+	return "jobImportResults"
+}
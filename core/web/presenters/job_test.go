@@ -86,6 +86,7 @@ func TestJob(t *testing.T) {
 						"type": "directrequest",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "pausedAt":null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\"",
@@ -96,6 +97,7 @@ func TestJob(t *testing.T) {
 							"minIncomingConfirmations": null,
 							"minContractPaymentLinkJuels": null,
 							"requesters": null,
+							"requesterLimits": null,
 							"initiator": "runlog",
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z",
@@ -154,6 +156,7 @@ func TestJob(t *testing.T) {
 						"type": "fluxmonitor",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "pausedAt":null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\"",
@@ -237,6 +240,7 @@ func TestJob(t *testing.T) {
 						"type": "offchainreporting",
 						"maxTaskDuration": "1m0s",
 					  "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					  "pausedAt":null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\"",
@@ -309,6 +313,7 @@ func TestJob(t *testing.T) {
 						"type": "keeper",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "pausedAt":null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "",
@@ -368,6 +373,7 @@ func TestJob(t *testing.T) {
                         "type": "cron",
                         "maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "pausedAt":null,
                         "pipelineSpec": {
                             "id": 1,
                             "dotDagSource": "",
@@ -423,6 +429,7 @@ func TestJob(t *testing.T) {
 						"type": "webhook",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "pausedAt":null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "",
@@ -484,6 +491,7 @@ func TestJob(t *testing.T) {
 						"schemaVersion": 1,
 						"maxTaskDuration": "0s",
 						"externalJobID": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"pausedAt": null,
 						"directRequestSpec": null,
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
@@ -548,6 +556,7 @@ func TestJob(t *testing.T) {
 						"schemaVersion": 1,
 						"maxTaskDuration": "0s",
 						"externalJobID": "0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"pausedAt": null,
 						"directRequestSpec": null,
 						"fluxMonitorSpec": null,
 						"gasLimit": null,
@@ -622,6 +631,7 @@ func TestJob(t *testing.T) {
 						"type": "keeper",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+					    "pausedAt":null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "",
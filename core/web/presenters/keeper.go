@@ -0,0 +1,77 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// UpkeepReportResource is a JSONAPI resource explaining whether the node
+// would perform an upkeep at the latest head.
+type UpkeepReportResource struct {
+	JAID
+	JobID              int32               `json:"jobID"`
+	RegistryAddress    ethkey.EIP55Address `json:"registryAddress"`
+	UpkeepID           string              `json:"upkeepID"`
+	HeadNumber         int64               `json:"headNumber"`
+	KeeperIndex        int32               `json:"keeperIndex"`
+	NumKeepers         int32               `json:"numKeepers"`
+	BlockCountPerTurn  int32               `json:"blockCountPerTurn"`
+	LastRunBlockHeight int64               `json:"lastRunBlockHeight"`
+	LastKeeperIndex    null.Int64          `json:"lastKeeperIndex"`
+	TurnFlagEnabled    bool                `json:"turnFlagEnabled"`
+	TurnBlockNumber    int64               `json:"turnBlockNumber"`
+	TurnBinary         string              `json:"turnBinary"`
+	TurnKeeperIndex    int32               `json:"turnKeeperIndex"`
+	Eligible           bool                `json:"eligible"`
+	GasPrice           *utils.Big          `json:"gasPrice"`
+	GasTipCap          *utils.Big          `json:"gasTipCap"`
+	GasFeeCap          *utils.Big          `json:"gasFeeCap"`
+	Performable        bool                `json:"performable"`
+	PerformData        hexutil.Bytes       `json:"performData"`
+	Error              string              `json:"error"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r UpkeepReportResource) GetName() string {
+	return "upkeep_reports"
+}
+
+// NewUpkeepReportResource returns a new UpkeepReportResource for report.
+func NewUpkeepReportResource(report keeper.UpkeepReport) *UpkeepReportResource {
+	r := &UpkeepReportResource{
+		JAID:               NewJAID(fmt.Sprintf("%s-%s", report.RegistryAddress, report.UpkeepID)),
+		JobID:              report.JobID,
+		RegistryAddress:    report.RegistryAddress,
+		UpkeepID:           report.UpkeepID,
+		HeadNumber:         report.HeadNumber,
+		KeeperIndex:        report.KeeperIndex,
+		NumKeepers:         report.NumKeepers,
+		BlockCountPerTurn:  report.BlockCountPerTurn,
+		LastRunBlockHeight: report.LastRunBlockHeight,
+		LastKeeperIndex:    report.LastKeeperIndex,
+		TurnFlagEnabled:    report.TurnFlagEnabled,
+		TurnBlockNumber:    report.TurnBlockNumber,
+		TurnBinary:         report.TurnBinary,
+		TurnKeeperIndex:    report.TurnKeeperIndex,
+		Eligible:           report.Eligible,
+		Performable:        report.Performable,
+		PerformData:        report.PerformData,
+		Error:              report.Error,
+	}
+	if report.GasPrice != nil {
+		r.GasPrice = utils.NewBig(report.GasPrice)
+	}
+	if report.GasTipCap != nil {
+		r.GasTipCap = utils.NewBig(report.GasTipCap)
+	}
+	if report.GasFeeCap != nil {
+		r.GasFeeCap = utils.NewBig(report.GasFeeCap)
+	}
+	return r
+}
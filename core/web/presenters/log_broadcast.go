@@ -0,0 +1,47 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
+)
+
+// ReorgedLogBroadcastResource is a JSONAPI resource for a log broadcast which
+// was consumed before its log was removed by a reorg.
+type ReorgedLogBroadcastResource struct {
+	JAID
+	BlockHash   common.Hash `json:"blockHash"`
+	BlockNumber int64       `json:"blockNumber"`
+	LogIndex    uint        `json:"logIndex"`
+	JobID       int32       `json:"jobID"`
+	ReorgedAt   time.Time   `json:"reorgedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ReorgedLogBroadcastResource) GetName() string {
+	return "reorged_log_broadcasts"
+}
+
+// NewReorgedLogBroadcastResource returns a new ReorgedLogBroadcastResource for rb.
+func NewReorgedLogBroadcastResource(rb log.ReorgedBroadcast) ReorgedLogBroadcastResource {
+	return ReorgedLogBroadcastResource{
+		JAID:        NewJAID(fmt.Sprintf("%s-%d-%d", rb.BlockHash.Hex(), rb.LogIndex, rb.JobID)),
+		BlockHash:   rb.BlockHash,
+		BlockNumber: rb.BlockNumber,
+		LogIndex:    rb.LogIndex,
+		JobID:       rb.JobID,
+		ReorgedAt:   rb.ReorgedAt,
+	}
+}
+
+// NewReorgedLogBroadcastResources returns a slice of ReorgedLogBroadcastResources.
+func NewReorgedLogBroadcastResources(rbs []log.ReorgedBroadcast) []ReorgedLogBroadcastResource {
+	rs := []ReorgedLogBroadcastResource{}
+	for _, rb := range rbs {
+		rs = append(rs, NewReorgedLogBroadcastResource(rb))
+	}
+	return rs
+}
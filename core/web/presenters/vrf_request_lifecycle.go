@@ -0,0 +1,83 @@
+package presenters
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// VRFRequestLifecycleResource is a JSONAPI resource describing how a VRF job
+// processed a VRF v2 request.
+type VRFRequestLifecycleResource struct {
+	JAID
+	JobID                         int32             `json:"jobID"`
+	RequestID                     string            `json:"requestID"`
+	SubID                         uint64            `json:"subID"`
+	Status                        vrf.RequestStatus `json:"status"`
+	RequestTxHash                 common.Hash       `json:"requestTxHash"`
+	RequestBlockNumber            uint64            `json:"requestBlockNumber"`
+	ConfirmedAtBlock              uint64            `json:"confirmedAtBlock"`
+	LogSeenAt                     time.Time         `json:"logSeenAt"`
+	Attempts                      int32             `json:"attempts"`
+	SubscriptionBalance           *utils.Big        `json:"subscriptionBalance"`
+	SubscriptionCheckedAt         null.Time         `json:"subscriptionCheckedAt"`
+	SkipReason                    null.String       `json:"skipReason"`
+	PipelineRunID                 null.Int          `json:"pipelineRunID"`
+	EthTxID                       null.Int          `json:"ethTxID"`
+	EthTxState                    null.String       `json:"ethTxState"`
+	FulfillmentTxHash             *common.Hash      `json:"fulfillmentTxHash"`
+	FulfillmentReceiptBlockNumber null.Int          `json:"fulfillmentReceiptBlockNumber"`
+	FulfillmentLogTxHash          *common.Hash      `json:"fulfillmentLogTxHash"`
+	FulfillmentLogBlockNumber     null.Int          `json:"fulfillmentLogBlockNumber"`
+	FulfillmentSuccess            null.Bool         `json:"fulfillmentSuccess"`
+	UpdatedAt                     time.Time         `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r VRFRequestLifecycleResource) GetName() string {
+	return "vrf_request_lifecycles"
+}
+
+// NewVRFRequestLifecycleResource returns a new VRFRequestLifecycleResource for
+// lifecycle.
+func NewVRFRequestLifecycleResource(lifecycle vrf.RequestLifecycle) *VRFRequestLifecycleResource {
+	return &VRFRequestLifecycleResource{
+		JAID:                          NewJAID(fmt.Sprintf("%d-%s", lifecycle.JobID, lifecycle.RequestID)),
+		JobID:                         lifecycle.JobID,
+		RequestID:                     lifecycle.RequestID,
+		SubID:                         lifecycle.SubID,
+		Status:                        lifecycle.Status,
+		RequestTxHash:                 lifecycle.RequestTxHash,
+		RequestBlockNumber:            lifecycle.RequestBlockNumber,
+		ConfirmedAtBlock:              lifecycle.ConfirmedAtBlock,
+		LogSeenAt:                     lifecycle.LogSeenAt,
+		Attempts:                      lifecycle.Attempts,
+		SubscriptionBalance:           lifecycle.SubscriptionBalance,
+		SubscriptionCheckedAt:         lifecycle.SubscriptionCheckedAt,
+		SkipReason:                    lifecycle.SkipReason,
+		PipelineRunID:                 lifecycle.PipelineRunID,
+		EthTxID:                       lifecycle.EthTxID,
+		EthTxState:                    lifecycle.EthTxState,
+		FulfillmentTxHash:             lifecycle.FulfillmentTxHash,
+		FulfillmentReceiptBlockNumber: lifecycle.FulfillmentReceiptBlockNumber,
+		FulfillmentLogTxHash:          lifecycle.FulfillmentLogTxHash,
+		FulfillmentLogBlockNumber:     lifecycle.FulfillmentLogBlockNumber,
+		FulfillmentSuccess:            lifecycle.FulfillmentSuccess,
+		UpdatedAt:                     lifecycle.UpdatedAt,
+	}
+}
+
+// NewVRFRequestLifecycleResources returns a slice of
+// VRFRequestLifecycleResources for lifecycles.
+func NewVRFRequestLifecycleResources(lifecycles []vrf.RequestLifecycle) []VRFRequestLifecycleResource {
+	rs := []VRFRequestLifecycleResource{}
+	for _, l := range lifecycles {
+		rs = append(rs, *NewVRFRequestLifecycleResource(l))
+	}
+	return rs
+}
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"math"
 	"net/http"
 	"strconv"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
 type ReplayController struct {
@@ -70,6 +72,64 @@ func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 	jsonAPIResponse(c, &response, "response")
 }
 
+// ReorgedLogBroadcasts lists the log broadcasts in a block range which were
+// consumed by a job, before their log was removed by a reorg.
+// Example:
+//  "<application>/v2/reorged_log_broadcasts?fromBlock=:from&toBlock=:to"
+func (bdc *ReplayController) ReorgedLogBroadcasts(c *gin.Context) {
+	fromBlock, err := blockNumberQuery(c, "fromBlock", 0)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	toBlock, err := blockNumberQuery(c, "toBlock", math.MaxInt64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if fromBlock > toBlock {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("fromBlock %d is after toBlock %d", fromBlock, toBlock))
+		return
+	}
+
+	chain, err := getChain(bdc.App.GetChains().EVM, c.Query("evmChainID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	reorged, err := chain.LogBroadcaster().ReorgedBroadcasts(fromBlock, toBlock)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewReorgedLogBroadcastResources(reorged), "reorged_log_broadcasts")
+}
+
+// blockNumberQuery parses the block number query string parameter name, or
+// returns def if it is not set.
+func blockNumberQuery(c *gin.Context, name string, def int64) (int64, error) {
+	s := c.Query(name)
+	if s == "" {
+		return def, nil
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid '%s' query string param", name)
+	}
+	if n < 0 {
+		return 0, errors.Errorf("block number cannot be negative: %v", n)
+	}
+	return n, nil
+}
+
 type ReplayResponse struct {
 	Message    string     `json:"message"`
 	EVMChainID *utils.Big `json:"evmChainID"`
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	pipelineMocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
@@ -294,6 +296,8 @@ func Test_UpdateBridge(t *testing.T) {
 					Confirmations:          uint32(1),
 					OutgoingToken:          "outgoingToken",
 					MinimumContractPayment: assets.NewLinkFromJuels(1),
+					RateLimit:              1,
+					CacheTTL:               models.Interval(time.Minute),
 					CreatedAt:              f.Timestamp(),
 				}
 
@@ -305,6 +309,8 @@ func Test_UpdateBridge(t *testing.T) {
 					URL:                    models.WebURL(*newBridgeURL),
 					Confirmations:          2,
 					MinimumContractPayment: assets.NewLinkFromJuels(2),
+					RateLimit:              1,
+					CacheTTL:               models.Interval(time.Minute),
 				}
 
 				f.Mocks.bridgeORM.On("UpdateBridgeType", mock.IsType(&bridges.BridgeType{}), btr).
@@ -320,6 +326,9 @@ func Test_UpdateBridge(t *testing.T) {
 						}
 					}).
 					Return(nil)
+				runner := pipelineMocks.NewRunner(f.t)
+				runner.On("EvictBridge", bridges.BridgeName("bridge-updated")).Return()
+				f.App.On("PipelineRunner").Return(runner)
 			},
 			query:     mutation,
 			variables: variables,
@@ -421,6 +430,9 @@ func Test_DeleteBridgeMutation(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobIDsWithBridge", name.String()).Return([]int32{}, nil)
 				f.App.On("JobORM").Return(f.Mocks.jobORM)
 				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				runner := pipelineMocks.NewRunner(f.t)
+				runner.On("EvictBridge", name).Return()
+				f.App.On("PipelineRunner").Return(runner)
 			},
 			query:     mutation,
 			variables: variables,
@@ -293,6 +293,10 @@ func TestResolver_Config(t *testing.T) {
         "key": "LEASE_LOCK_REFRESH_INTERVAL",
         "value": "1s"
       },
+      {
+        "key": "LEASE_LOCK_WARM_STANDBY",
+        "value": "false"
+      },
       {
         "key": "FLAGS_CONTRACT_ADDRESS",
         "value": ""
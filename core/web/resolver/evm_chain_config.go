@@ -55,10 +55,12 @@ func FromChainType(ct ChainType) string {
 type GasEstimatorMode string
 
 const (
-	GasEstimatorModeBlockHistory GasEstimatorMode = "BLOCK_HISTORY"
-	GasEstimatorModeFixedPrice   GasEstimatorMode = "FIXED_PRICE"
-	GasEstimatorModeOptimism2    GasEstimatorMode = "OPTIMISM2"
-	GasEstimatorModeL2Suggested  GasEstimatorMode = "L2_SUGGESTED"
+	GasEstimatorModeBlockHistory  GasEstimatorMode = "BLOCK_HISTORY"
+	GasEstimatorModeFixedPrice    GasEstimatorMode = "FIXED_PRICE"
+	GasEstimatorModeFeeHistory    GasEstimatorMode = "FEE_HISTORY"
+	GasEstimatorModeOptimism2     GasEstimatorMode = "OPTIMISM2"
+	GasEstimatorModeL2Suggested   GasEstimatorMode = "L2_SUGGESTED"
+	GasEstimatorModeOptimismL1Fee GasEstimatorMode = "OPTIMISM_L1_FEE"
 )
 
 func ToGasEstimatorMode(s string) (GasEstimatorMode, error) {
@@ -67,10 +69,14 @@ func ToGasEstimatorMode(s string) (GasEstimatorMode, error) {
 		return GasEstimatorModeBlockHistory, nil
 	case "FixedPrice":
 		return GasEstimatorModeFixedPrice, nil
+	case "FeeHistory":
+		return GasEstimatorModeFeeHistory, nil
 	case "Optimism2":
 		return GasEstimatorModeOptimism2, nil
 	case "L2Suggested":
 		return GasEstimatorModeL2Suggested, nil
+	case "OptimismL1Fee":
+		return GasEstimatorModeOptimismL1Fee, nil
 	default:
 		return "", errors.New("invalid gas estimator mode")
 	}
@@ -82,10 +88,14 @@ func FromGasEstimatorMode(gsm GasEstimatorMode) string {
 		return "BlockHistory"
 	case GasEstimatorModeFixedPrice:
 		return "FixedPrice"
+	case GasEstimatorModeFeeHistory:
+		return "FeeHistory"
 	case GasEstimatorModeOptimism2:
 		return "Optimism2"
 	case GasEstimatorModeL2Suggested:
 		return "L2Suggested"
+	case GasEstimatorModeOptimismL1Fee:
+		return "OptimismL1Fee"
 	default:
 		return strings.ToLower(string(gsm))
 	}
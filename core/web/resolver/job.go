@@ -4,9 +4,12 @@ import (
 	"context"
 
 	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/web/loader"
 )
 
@@ -39,6 +42,14 @@ func (r *JobResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.j.CreatedAt}
 }
 
+// PausedAt resolves the time the job was paused at, if it is paused.
+func (r *JobResolver) PausedAt() *graphql.Time {
+	if !r.j.PausedAt.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.j.PausedAt.Time}
+}
+
 // Errors resolves the job's top level errors.
 func (r *JobResolver) Errors(ctx context.Context) ([]*JobErrorResolver, error) {
 	specErrs, err := loader.GetJobSpecErrorsByJobID(ctx, r.j.ID)
@@ -224,6 +235,70 @@ func (r *CreateJobSuccessResolver) Job() *JobResolver {
 	return NewJob(r.app, *r.j)
 }
 
+// -- SimulateJob Mutation --
+
+type SimulateJobPayloadResolver struct {
+	app       chainlink.Application
+	run       *pipeline.Run
+	inputErrs map[string]string
+}
+
+func NewSimulateJobPayload(app chainlink.Application, run *pipeline.Run, inputErrs map[string]string) *SimulateJobPayloadResolver {
+	return &SimulateJobPayloadResolver{app: app, run: run, inputErrs: inputErrs}
+}
+
+func (r *SimulateJobPayloadResolver) ToSimulateJobSuccess() (*SimulateJobSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+
+	return NewSimulateJobSuccess(r.app, *r.run), true
+}
+
+func (r *SimulateJobPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+
+	for path, message := range r.inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+// SimulateJobSuccessResolver resolves a simulated run, which has no ID or job
+// since nothing is persisted.
+type SimulateJobSuccessResolver struct {
+	run *JobRunResolver
+}
+
+func NewSimulateJobSuccess(app chainlink.Application, run pipeline.Run) *SimulateJobSuccessResolver {
+	return &SimulateJobSuccessResolver{run: NewJobRun(run, app)}
+}
+
+func (r *SimulateJobSuccessResolver) Outputs() []*string {
+	return r.run.Outputs()
+}
+
+func (r *SimulateJobSuccessResolver) AllErrors() []string {
+	return r.run.AllErrors()
+}
+
+func (r *SimulateJobSuccessResolver) FatalErrors() []string {
+	return r.run.FatalErrors()
+}
+
+func (r *SimulateJobSuccessResolver) TaskRuns() []*TaskRunResolver {
+	return r.run.TaskRuns()
+}
+
+func (r *SimulateJobSuccessResolver) Status() JobRunStatus {
+	return r.run.Status()
+}
+
 // -- DeleteJob Mutation --
 
 type DeleteJobPayloadResolver struct {
@@ -258,3 +333,113 @@ func NewDeleteJobSuccess(app chainlink.Application, job *job.Job) *DeleteJobSucc
 func (r *DeleteJobSuccessResolver) Job() *JobResolver {
 	return NewJob(r.app, *r.j)
 }
+
+// -- PauseJob Mutation --
+
+type PauseJobPayloadResolver struct {
+	app chainlink.Application
+	j   *job.Job
+	NotFoundErrorUnionType
+}
+
+func NewPauseJobPayload(app chainlink.Application, j *job.Job, err error) *PauseJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &PauseJobPayloadResolver{app: app, j: j, NotFoundErrorUnionType: e}
+}
+
+func (r *PauseJobPayloadResolver) ToPauseJobSuccess() (*PauseJobSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return NewPauseJobSuccess(r.app, r.j), true
+}
+
+type PauseJobSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func NewPauseJobSuccess(app chainlink.Application, job *job.Job) *PauseJobSuccessResolver {
+	return &PauseJobSuccessResolver{app: app, j: job}
+}
+
+func (r *PauseJobSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
+
+// -- ResumeJob Mutation --
+
+type ResumeJobPayloadResolver struct {
+	app chainlink.Application
+	j   *job.Job
+	NotFoundErrorUnionType
+}
+
+func NewResumeJobPayload(app chainlink.Application, j *job.Job, err error) *ResumeJobPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+
+	return &ResumeJobPayloadResolver{app: app, j: j, NotFoundErrorUnionType: e}
+}
+
+func (r *ResumeJobPayloadResolver) ToResumeJobSuccess() (*ResumeJobSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return NewResumeJobSuccess(r.app, r.j), true
+}
+
+type ResumeJobSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func NewResumeJobSuccess(app chainlink.Application, job *job.Job) *ResumeJobSuccessResolver {
+	return &ResumeJobSuccessResolver{app: app, j: job}
+}
+
+func (r *ResumeJobSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
+
+// -- TriggerFluxMonitorPoll Mutation --
+
+type TriggerFluxMonitorPollPayloadResolver struct {
+	app chainlink.Application
+	j   *job.Job
+	NotFoundErrorUnionType
+}
+
+func NewTriggerFluxMonitorPollPayload(app chainlink.Application, j *job.Job, err error) *TriggerFluxMonitorPollPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "job not found"}
+	if errors.Is(err, fluxmonitorv2.ErrJobNotRunning) {
+		e = NotFoundErrorUnionType{err: err, message: err.Error(), isExpectedErrorFn: func(err error) bool {
+			return errors.Is(err, fluxmonitorv2.ErrJobNotRunning)
+		}}
+	}
+
+	return &TriggerFluxMonitorPollPayloadResolver{app: app, j: j, NotFoundErrorUnionType: e}
+}
+
+func (r *TriggerFluxMonitorPollPayloadResolver) ToTriggerFluxMonitorPollSuccess() (*TriggerFluxMonitorPollSuccessResolver, bool) {
+	if r.j == nil {
+		return nil, false
+	}
+
+	return NewTriggerFluxMonitorPollSuccess(r.app, r.j), true
+}
+
+type TriggerFluxMonitorPollSuccessResolver struct {
+	app chainlink.Application
+	j   *job.Job
+}
+
+func NewTriggerFluxMonitorPollSuccess(app chainlink.Application, job *job.Job) *TriggerFluxMonitorPollSuccessResolver {
+	return &TriggerFluxMonitorPollSuccessResolver{app: app, j: job}
+}
+
+func (r *TriggerFluxMonitorPollSuccessResolver) Job() *JobResolver {
+	return NewJob(r.app, *r.j)
+}
@@ -3,6 +3,7 @@ package resolver
 import (
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 
 	clnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -291,6 +293,7 @@ func TestResolver_CreateJob(t *testing.T) {
 	}
 	jb, err := directrequest.ValidatedDirectRequestSpec(testspecs.DirectRequestSpec)
 	assert.NoError(t, err)
+	jb.TOML = testspecs.DirectRequestSpec
 
 	d, err := json.Marshal(map[string]interface{}{
 		"createJob": map[string]interface{}{
@@ -362,6 +365,424 @@ func TestResolver_CreateJob(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestResolver_SimulateJob(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation SimulateJob($input: SimulateJobInput!) {
+			simulateJob(input: $input) {
+				... on SimulateJobSuccess {
+					outputs
+					allErrors
+					fatalErrors
+					status
+					taskRuns {
+						dotID
+						output
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"TOML":         testspecs.DirectRequestSpec,
+			"vars":         `{"jobRun": {"meta": {}}}`,
+			"ethTxOutputs": `{"submit": "0x01"}`,
+		},
+	}
+	invalidVars := map[string]interface{}{
+		"input": map[string]interface{}{
+			"TOML": testspecs.DirectRequestSpec,
+			"vars": "not json",
+		},
+	}
+
+	outputs := []interface{}{"0x01"}
+	run := pipeline.Run{
+		State:       pipeline.RunStatusCompleted,
+		Outputs:     pipeline.JSONSerializable{Val: outputs, Valid: true},
+		FatalErrors: []null.String{{}},
+		PipelineTaskRuns: []pipeline.TaskRun{{
+			DotID:  "submit",
+			Output: pipeline.JSONSerializable{Val: "0x01", Valid: true},
+		}},
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "simulateJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("SimulateJobV2", mock.Anything, testspecs.DirectRequestSpec,
+					map[string]interface{}{"jobRun": map[string]interface{}{"meta": map[string]interface{}{}}},
+					map[string]interface{}{"submit": "0x01"},
+				).Return(run, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"simulateJob": {
+						"outputs": ["0x01"],
+						"allErrors": [],
+						"fatalErrors": [],
+						"status": "COMPLETED",
+						"taskRuns": [{
+							"dotID": "submit",
+							"output": "\"0x01\""
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "invalid vars",
+			authenticated: true,
+			query:         mutation,
+			variables:     invalidVars,
+			result: `
+				{
+					"simulateJob": {
+						"errors": [{
+							"code": "INVALID_INPUT",
+							"message": "invalid JSON",
+							"path": "input/vars"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "invalid spec",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("SimulateJobV2", mock.Anything, testspecs.DirectRequestSpec, mock.Anything, mock.Anything).
+					Return(pipeline.Run{}, errors.New("failed to parse TOML: invalid job type"))
+			},
+			query: mutation,
+			variables: map[string]interface{}{
+				"input": map[string]interface{}{
+					"TOML": testspecs.DirectRequestSpec,
+				},
+			},
+			result: `
+				{
+					"simulateJob": {
+						"errors": [{
+							"code": "INVALID_INPUT",
+							"message": "failed to parse TOML: invalid job type",
+							"path": "TOML spec"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_PauseJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	extJID := uuid.NewV4()
+	mutation := `
+		mutation PauseJob($id: ID!) {
+			pauseJob(id: $id) {
+				... on PauseJobSuccess {
+					job {
+						id
+						externalJobID
+						pausedAt
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "pauseJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("PauseJob", mock.Anything, id).Return(nil)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
+					ID:            id,
+					ExternalJobID: extJID,
+					PausedAt:      null.TimeFrom(f.Timestamp()),
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: fmt.Sprintf(`
+				{
+					"pauseJob": {
+						"job": {
+							"id": "123",
+							"externalJobID": "%s",
+							"pausedAt": "2021-01-01T00:00:00Z"
+						}
+					}
+				}`, extJID),
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("PauseJob", mock.Anything, id).Return(errors.Wrap(sql.ErrNoRows, "PauseJob failed"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"pauseJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}`,
+		},
+		{
+			name:          "generic error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("PauseJob", mock.Anything, id).Return(gError)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"pauseJob"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_ResumeJob(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	extJID := uuid.NewV4()
+	mutation := `
+		mutation ResumeJob($id: ID!) {
+			resumeJob(id: $id) {
+				... on ResumeJobSuccess {
+					job {
+						id
+						externalJobID
+						pausedAt
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "resumeJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("ResumeJob", mock.Anything, id).Return(nil)
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
+					ID:            id,
+					ExternalJobID: extJID,
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: fmt.Sprintf(`
+				{
+					"resumeJob": {
+						"job": {
+							"id": "123",
+							"externalJobID": "%s",
+							"pausedAt": null
+						}
+					}
+				}`, extJID),
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("ResumeJob", mock.Anything, id).Return(errors.Wrap(sql.ErrNoRows, "ResumeJob failed"))
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"resumeJob": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}`,
+		},
+		{
+			name:          "generic error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("ResumeJob", mock.Anything, id).Return(gError)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"resumeJob"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_TriggerFluxMonitorPoll(t *testing.T) {
+	t.Parallel()
+
+	id := int32(123)
+	extJID := uuid.NewV4()
+	mutation := `
+		mutation TriggerFluxMonitorPoll($id: ID!) {
+			triggerFluxMonitorPoll(id: $id) {
+				... on TriggerFluxMonitorPollSuccess {
+					job {
+						id
+						externalJobID
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"id": "123",
+	}
+
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "triggerFluxMonitorPoll"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{
+					ID:            id,
+					ExternalJobID: extJID,
+				}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("RequestFluxMonitorPoll", mock.Anything, id).Return(nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: fmt.Sprintf(`
+				{
+					"triggerFluxMonitorPoll": {
+						"job": {
+							"id": "123",
+							"externalJobID": "%s"
+						}
+					}
+				}`, extJID),
+		},
+		{
+			name:          "not found",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{}, sql.ErrNoRows)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"triggerFluxMonitorPoll": {
+						"code": "NOT_FOUND",
+						"message": "job not found"
+					}
+				}`,
+		},
+		{
+			name:          "not running",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{ID: id}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("RequestFluxMonitorPoll", mock.Anything, id).Return(fluxmonitorv2.ErrJobNotRunning)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"triggerFluxMonitorPoll": {
+						"code": "NOT_FOUND",
+						"message": "flux monitor job is not running"
+					}
+				}`,
+		},
+		{
+			name:          "generic error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.jobORM.On("FindJobWithoutSpecErrors", id).Return(job.Job{ID: id}, nil)
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.App.On("RequestFluxMonitorPoll", mock.Anything, id).Return(gError)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"triggerFluxMonitorPoll"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_DeleteJob(t *testing.T) {
 	t.Parallel()
 
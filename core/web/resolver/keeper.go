@@ -0,0 +1,156 @@
+package resolver
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
+)
+
+// UpkeepReportResolver resolves the UpkeepReport type.
+type UpkeepReportResolver struct {
+	report keeper.UpkeepReport
+}
+
+func NewUpkeepReport(report keeper.UpkeepReport) *UpkeepReportResolver {
+	return &UpkeepReportResolver{report: report}
+}
+
+// JobID resolves the ID of the keeper job of the registry.
+func (r *UpkeepReportResolver) JobID() graphql.ID {
+	return int32GQLID(r.report.JobID)
+}
+
+// RegistryAddress resolves the registry's contract address.
+func (r *UpkeepReportResolver) RegistryAddress() string {
+	return r.report.RegistryAddress.String()
+}
+
+// UpkeepID resolves the upkeep's ID.
+func (r *UpkeepReportResolver) UpkeepID() string {
+	return r.report.UpkeepID
+}
+
+// HeadNumber resolves the number of the head the upkeep was checked at.
+func (r *UpkeepReportResolver) HeadNumber() string {
+	return stringutils.FromInt64(r.report.HeadNumber)
+}
+
+// KeeperIndex resolves the index of this node in the registry.
+func (r *UpkeepReportResolver) KeeperIndex() int32 {
+	return r.report.KeeperIndex
+}
+
+// NumKeepers resolves the number of keepers of the registry.
+func (r *UpkeepReportResolver) NumKeepers() int32 {
+	return r.report.NumKeepers
+}
+
+// BlockCountPerTurn resolves the registry's block count per turn.
+func (r *UpkeepReportResolver) BlockCountPerTurn() int32 {
+	return r.report.BlockCountPerTurn
+}
+
+// LastRunBlockHeight resolves the block height the upkeep was last performed at.
+func (r *UpkeepReportResolver) LastRunBlockHeight() string {
+	return stringutils.FromInt64(r.report.LastRunBlockHeight)
+}
+
+// LastKeeperIndex resolves the index of the keeper which last performed the upkeep.
+func (r *UpkeepReportResolver) LastKeeperIndex() *int32 {
+	if !r.report.LastKeeperIndex.Valid {
+		return nil
+	}
+	idx := int32(r.report.LastKeeperIndex.Int64)
+	return &idx
+}
+
+// TurnFlagEnabled resolves whether the block hash based turn taking is used.
+func (r *UpkeepReportResolver) TurnFlagEnabled() bool {
+	return r.report.TurnFlagEnabled
+}
+
+// TurnBlockNumber resolves the block whose hash decides the turn.
+func (r *UpkeepReportResolver) TurnBlockNumber() *string {
+	if !r.report.TurnFlagEnabled {
+		return nil
+	}
+	num := stringutils.FromInt64(r.report.TurnBlockNumber)
+	return &num
+}
+
+// TurnKeeperIndex resolves the index of the keeper whose turn it is.
+func (r *UpkeepReportResolver) TurnKeeperIndex() int32 {
+	return r.report.TurnKeeperIndex
+}
+
+// Eligible resolves whether this node checks the upkeep at the head.
+func (r *UpkeepReportResolver) Eligible() bool {
+	return r.report.Eligible
+}
+
+// GasPrice resolves the gas price of the checkUpkeep call.
+func (r *UpkeepReportResolver) GasPrice() *string {
+	return bigIntString(r.report.GasPrice)
+}
+
+// GasTipCap resolves the gas tip cap of the checkUpkeep call.
+func (r *UpkeepReportResolver) GasTipCap() *string {
+	return bigIntString(r.report.GasTipCap)
+}
+
+// GasFeeCap resolves the gas fee cap of the checkUpkeep call.
+func (r *UpkeepReportResolver) GasFeeCap() *string {
+	return bigIntString(r.report.GasFeeCap)
+}
+
+// Performable resolves whether performUpkeep would be sent.
+func (r *UpkeepReportResolver) Performable() bool {
+	return r.report.Performable
+}
+
+// PerformData resolves the perform data returned by checkUpkeep.
+func (r *UpkeepReportResolver) PerformData() hexutil.Bytes {
+	return r.report.PerformData
+}
+
+// Error resolves the reason the upkeep is not performable.
+func (r *UpkeepReportResolver) Error() *string {
+	if r.report.Error == "" {
+		return nil
+	}
+	return &r.report.Error
+}
+
+func bigIntString(i *big.Int) *string {
+	if i == nil {
+		return nil
+	}
+	s := i.String()
+	return &s
+}
+
+// -- UpkeepReport Query --
+
+type UpkeepReportPayloadResolver struct {
+	report keeper.UpkeepReport
+	NotFoundErrorUnionType
+}
+
+func NewUpkeepReportPayload(report keeper.UpkeepReport, err error) *UpkeepReportPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "registry or upkeep not found"}
+
+	return &UpkeepReportPayloadResolver{report: report, NotFoundErrorUnionType: e}
+}
+
+// ToUpkeepReport implements the UpkeepReportPayload union type
+func (r *UpkeepReportPayloadResolver) ToUpkeepReport() (*UpkeepReportResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return NewUpkeepReport(r.report), true
+}
@@ -0,0 +1,148 @@
+package resolver
+
+import (
+	"database/sql"
+	"errors"
+	"math/big"
+	"testing"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+)
+
+func TestResolver_UpkeepReport(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetUpkeepReport($registryAddress: String!, $upkeepID: String!) {
+			upkeepReport(registryAddress: $registryAddress, upkeepID: $upkeepID) {
+				... on UpkeepReport {
+					jobID
+					registryAddress
+					upkeepID
+					headNumber
+					keeperIndex
+					numKeepers
+					blockCountPerTurn
+					lastRunBlockHeight
+					lastKeeperIndex
+					turnFlagEnabled
+					turnBlockNumber
+					turnKeeperIndex
+					eligible
+					gasPrice
+					gasTipCap
+					gasFeeCap
+					performable
+					performData
+					error
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"registryAddress": "0x5431F5F973781809D18643b87B44921b11355d81",
+		"upkeepID":        "0x10",
+	}
+	registryAddress := ethkey.EIP55Address("0x5431F5F973781809D18643b87B44921b11355d81")
+	upkeepID := big.NewInt(16)
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "upkeepReport"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("ReportUpkeep", mock.Anything, registryAddress, upkeepID).Return(keeper.UpkeepReport{
+					JobID:              1,
+					RegistryAddress:    registryAddress,
+					UpkeepID:           "UPx0000000000000000000000000000000000000000000000000000000000000010",
+					HeadNumber:         20,
+					KeeperIndex:        1,
+					NumKeepers:         2,
+					BlockCountPerTurn:  20,
+					LastRunBlockHeight: 10,
+					LastKeeperIndex:    null.Int64From(0),
+					TurnFlagEnabled:    true,
+					TurnBlockNumber:    20,
+					TurnBinary:         "1",
+					TurnKeeperIndex:    1,
+					Eligible:           true,
+					GasPrice:           big.NewInt(100),
+					Performable:        true,
+					PerformData:        []byte{0x12, 0x34},
+				}, nil)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"upkeepReport": {
+						"jobID": "1",
+						"registryAddress": "0x5431F5F973781809D18643b87B44921b11355d81",
+						"upkeepID": "UPx0000000000000000000000000000000000000000000000000000000000000010",
+						"headNumber": "20",
+						"keeperIndex": 1,
+						"numKeepers": 2,
+						"blockCountPerTurn": 20,
+						"lastRunBlockHeight": "10",
+						"lastKeeperIndex": 0,
+						"turnFlagEnabled": true,
+						"turnBlockNumber": "20",
+						"turnKeeperIndex": 1,
+						"eligible": true,
+						"gasPrice": "100",
+						"gasTipCap": null,
+						"gasFeeCap": null,
+						"performable": true,
+						"performData": "0x1234",
+						"error": null
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("ReportUpkeep", mock.Anything, registryAddress, upkeepID).Return(keeper.UpkeepReport{}, sql.ErrNoRows)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"upkeepReport": {
+						"code": "NOT_FOUND",
+						"message": "registry or upkeep not found"
+					}
+				}`,
+		},
+		{
+			name:          "generic error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.App.On("ReportUpkeep", mock.Anything, registryAddress, upkeepID).Return(keeper.UpkeepReport{}, gError)
+			},
+			query:     query,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"upkeepReport"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -3,6 +3,7 @@ package resolver
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"time"
@@ -436,6 +437,13 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		return nil, err
 	}
 
+	// Limits can not be set through GraphQL yet, so keep the current ones.
+	btr.RateLimit = bridge.RateLimit
+	btr.RateLimitBurst = bridge.RateLimitBurst
+	btr.CircuitBreakerThreshold = bridge.CircuitBreakerThreshold
+	btr.CircuitBreakerCooldown = bridge.CircuitBreakerCooldown
+	btr.CacheTTL = bridge.CacheTTL
+
 	// Update the bridge
 	if err := ValidateBridgeType(btr); err != nil {
 		return nil, err
@@ -444,6 +452,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	if err := orm.UpdateBridgeType(&bridge, btr); err != nil {
 		return nil, err
 	}
+	r.App.PipelineRunner().EvictBridge(bridge.Name)
 
 	return NewUpdateBridgePayload(&bridge, nil), nil
 }
@@ -616,6 +625,7 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	if err = orm.DeleteBridgeType(&bt); err != nil {
 		return nil, err
 	}
+	r.App.PipelineRunner().EvictBridge(bt.Name)
 
 	return NewDeleteBridgePayload(&bt, nil), nil
 }
@@ -1114,6 +1124,7 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	if err != nil {
 		return nil, err
 	}
+	jb.TOML = args.Input.TOML
 
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -1126,6 +1137,43 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 	return NewCreateJobPayload(r.App, &jb, nil), nil
 }
 
+func (r *Resolver) SimulateJob(ctx context.Context, args struct {
+	Input struct {
+		TOML         string
+		Vars         *string
+		EthTxOutputs *string
+	}
+}) (*SimulateJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	inputErrs := map[string]string{}
+	var vars, ethTxOutputs map[string]interface{}
+	if args.Input.Vars != nil {
+		if err := json.Unmarshal([]byte(*args.Input.Vars), &vars); err != nil {
+			inputErrs["input/vars"] = "invalid JSON"
+		}
+	}
+	if args.Input.EthTxOutputs != nil {
+		if err := json.Unmarshal([]byte(*args.Input.EthTxOutputs), &ethTxOutputs); err != nil {
+			inputErrs["input/ethTxOutputs"] = "invalid JSON"
+		}
+	}
+	if len(inputErrs) > 0 {
+		return NewSimulateJobPayload(r.App, nil, inputErrs), nil
+	}
+
+	run, err := r.App.SimulateJobV2(ctx, args.Input.TOML, vars, ethTxOutputs)
+	if err != nil {
+		return NewSimulateJobPayload(r.App, nil, map[string]string{
+			"TOML spec": err.Error(),
+		}), nil
+	}
+
+	return NewSimulateJobPayload(r.App, &run, nil), nil
+}
+
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteJobPayloadResolver, error) {
@@ -1159,6 +1207,99 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	return NewDeleteJobPayload(r.App, &j, nil), nil
 }
 
+func (r *Resolver) PauseJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*PauseJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.App.PauseJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewPauseJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPauseJobPayload(r.App, &j, nil), nil
+}
+
+func (r *Resolver) ResumeJob(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*ResumeJobPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.App.ResumeJob(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewResumeJobPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResumeJobPayload(r.App, &j, nil), nil
+}
+
+// TriggerFluxMonitorPoll makes a running flux monitor job poll and submit an
+// answer, regardless of deviation.
+func (r *Resolver) TriggerFluxMonitorPoll(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*TriggerFluxMonitorPollPayloadResolver, error) {
+	if err := authenticateUserIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := stringutils.ToInt32(string(args.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewTriggerFluxMonitorPollPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	err = r.App.RequestFluxMonitorPoll(ctx, id)
+	if err != nil {
+		if errors.Is(err, fluxmonitorv2.ErrJobNotRunning) {
+			return NewTriggerFluxMonitorPollPayload(r.App, nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewTriggerFluxMonitorPollPayload(r.App, &j, nil), nil
+}
+
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
@@ -13,7 +13,9 @@ import (
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
@@ -266,6 +268,28 @@ func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error)
 	return NewVRFKeysPayloadResolver(keys), nil
 }
 
+// VRFV2RequestLifecycles fetches the lifecycle of a VRF v2 request, for each
+// VRF job which saw it. The request ID may be decimal or hex.
+func (r *Resolver) VRFV2RequestLifecycles(ctx context.Context, args struct {
+	RequestID string
+}) (*VRFV2RequestLifecyclesPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var requestID utils.Big
+	if err := requestID.UnmarshalText([]byte(args.RequestID)); err != nil {
+		return nil, err
+	}
+
+	lifecycles, err := r.App.VRFORM().FindRequestLifecycles(requestID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return NewVRFV2RequestLifecyclesPayload(lifecycles), nil
+}
+
 // VRFKey fetches the VRF key with the given ID.
 func (r *Resolver) VRFKey(ctx context.Context, args struct {
 	ID graphql.ID
@@ -540,3 +564,34 @@ func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadRe
 
 	return NewOCR2KeyBundlesPayload(ekbs), nil
 }
+
+// UpkeepReport checks an upkeep of a keeper registry at the latest head
+// without performing it.
+func (r *Resolver) UpkeepReport(ctx context.Context, args struct {
+	RegistryAddress string
+	UpkeepID        string
+}) (*UpkeepReportPayloadResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	registryAddress, err := ethkey.NewEIP55Address(args.RegistryAddress)
+	if err != nil {
+		return nil, err
+	}
+	var upkeepID utils.Big
+	if err = upkeepID.UnmarshalText([]byte(args.UpkeepID)); err != nil {
+		return nil, err
+	}
+
+	report, err := r.App.ReportUpkeep(ctx, registryAddress, upkeepID.ToInt())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewUpkeepReportPayload(keeper.UpkeepReport{}, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewUpkeepReportPayload(report, nil), nil
+}
@@ -21,6 +21,7 @@ import (
 	jobORMMocks "github.com/smartcontractkit/chainlink/core/services/job/mocks"
 	keystoreMocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	pipelineMocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	vrfORMMocks "github.com/smartcontractkit/chainlink/core/services/vrf/mocks"
 	webhookmocks "github.com/smartcontractkit/chainlink/core/services/webhook/mocks"
 	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 	sessionsMocks "github.com/smartcontractkit/chainlink/core/sessions/mocks"
@@ -52,6 +53,7 @@ type mocks struct {
 	eIMgr       *webhookmocks.ExternalInitiatorManager
 	balM        *evmORMMocks.BalanceMonitor
 	txmORM      *txmgrMocks.ORM
+	vrfORM      *vrfORMMocks.ORM
 }
 
 // gqlTestFramework is a framework wrapper containing the objects needed to run
@@ -109,6 +111,7 @@ func setupFramework(t *testing.T) *gqlTestFramework {
 		eIMgr:       webhookmocks.NewExternalInitiatorManager(t),
 		balM:        evmORMMocks.NewBalanceMonitor(t),
 		txmORM:      txmgrMocks.NewORM(t),
+		vrfORM:      vrfORMMocks.NewORM(t),
 	}
 
 	f := &gqlTestFramework{
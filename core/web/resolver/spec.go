@@ -167,6 +167,41 @@ func (r *DirectRequestSpecResolver) Requesters() *[]string {
 	return &requesters
 }
 
+// RequesterLimits resolves the spec's requester limits.
+func (r *DirectRequestSpecResolver) RequesterLimits() []*DirectRequestRequesterLimitResolver {
+	resolvers := []*DirectRequestRequesterLimitResolver{}
+	for _, limit := range r.spec.RequesterLimits {
+		resolvers = append(resolvers, &DirectRequestRequesterLimitResolver{limit: limit})
+	}
+
+	return resolvers
+}
+
+type DirectRequestRequesterLimitResolver struct {
+	limit job.RequesterLimit
+}
+
+// Address resolves the requester's address.
+func (r *DirectRequestRequesterLimitResolver) Address() string {
+	return r.limit.Address.String()
+}
+
+// MinContractPaymentLinkJuels resolves the requester's min contract payment.
+func (r *DirectRequestRequesterLimitResolver) MinContractPaymentLinkJuels() *string {
+	if r.limit.MinContractPayment == nil {
+		return nil
+	}
+
+	payment := r.limit.MinContractPayment.String()
+
+	return &payment
+}
+
+// MaxRequestsPerHour resolves the requester's max requests per hour.
+func (r *DirectRequestRequesterLimitResolver) MaxRequestsPerHour() int32 {
+	return int32(r.limit.MaxRequestsPerHour)
+}
+
 type FluxMonitorSpecResolver struct {
 	spec job.FluxMonitorSpec
 }
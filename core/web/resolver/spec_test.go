@@ -97,6 +97,9 @@ func TestResolver_DirectRequestSpec(t *testing.T) {
 						MinIncomingConfirmationsEnv: true,
 						MinContractPayment:          assets.NewLinkFromJuels(1000),
 						Requesters:                  models.AddressCollection{requesterAddress},
+						RequesterLimits: job.RequesterLimits{
+							{Address: contractAddress, MinContractPayment: assets.NewLinkFromJuels(2000), MaxRequestsPerHour: 10},
+						},
 					},
 				}, nil)
 			},
@@ -114,6 +117,11 @@ func TestResolver_DirectRequestSpec(t *testing.T) {
 									minIncomingConfirmationsEnv
 									minContractPaymentLinkJuels
 									requesters
+									requesterLimits {
+										address
+										minContractPaymentLinkJuels
+										maxRequestsPerHour
+									}
 								}
 							}
 						}
@@ -131,7 +139,12 @@ func TestResolver_DirectRequestSpec(t *testing.T) {
 							"minIncomingConfirmations": 1,
 							"minIncomingConfirmationsEnv": true,
 							"minContractPaymentLinkJuels": "1000",
-							"requesters": ["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"]
+							"requesters": ["0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"],
+							"requesterLimits": [{
+								"address": "0x613a38AC1659769640aaE063C651F48E0250454C",
+								"minContractPaymentLinkJuels": "2000",
+								"maxRequestsPerHour": 10
+							}]
 						}
 					}
 				}
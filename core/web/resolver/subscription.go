@@ -0,0 +1,193 @@
+package resolver
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
+)
+
+// SubscriptionBufferSize is the number of events buffered per subscription.
+// Events for subscribers which fall further behind are dropped.
+const SubscriptionBufferSize = 100
+
+// JobRunFinished streams pipeline runs as they finish, optionally only the runs
+// of a single job.
+func (r *Resolver) JobRunFinished(ctx context.Context, args struct {
+	JobID *graphql.ID
+}) (<-chan *JobRunResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	var pipelineSpecID *int32
+	if args.JobID != nil {
+		id, err := stringutils.ToInt32(string(*args.JobID))
+		if err != nil {
+			return nil, err
+		}
+		jb, err := r.App.JobORM().FindJob(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		pipelineSpecID = &jb.PipelineSpecID
+	}
+
+	lggr := r.App.GetLogger().Named("JobRunFinishedSubscription")
+	ch := make(chan *JobRunResolver, SubscriptionBufferSize)
+	unregister := r.App.PipelineRunner().OnRunFinished(func(run *pipeline.Run) {
+		if !run.State.Finished() {
+			return
+		}
+		if pipelineSpecID != nil && run.PipelineSpecID != *pipelineSpecID {
+			return
+		}
+		select {
+		case ch <- NewJobRun(*run, r.App):
+		default:
+			lggr.Warnw("Subscriber is not keeping up, dropping run", "runID", run.ID)
+		}
+	})
+
+	go func() {
+		<-ctx.Done()
+		// No callbacks are in flight once unregister returns.
+		unregister()
+		close(ch)
+	}()
+
+	return ch, nil
+}
+
+// EthTransactionStateChanged streams eth transactions as they are created or
+// change state.
+func (r *Resolver) EthTransactionStateChanged(ctx context.Context) (<-chan *EthTransactionResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	sub, err := r.App.GetEventBroadcaster().Subscribe(pg.ChannelEthTxStateChanged, "")
+	if err != nil {
+		return nil, err
+	}
+
+	lggr := r.App.GetLogger().Named("EthTransactionStateChangedSubscription")
+	ch := make(chan *EthTransactionResolver)
+	go func() {
+		defer close(ch)
+		defer sub.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev, ok := <-sub.Events():
+				if !ok {
+					return
+				}
+				id, err := strconv.ParseInt(ev.Payload, 10, 64)
+				if err != nil {
+					lggr.Errorw("Invalid eth tx id in notification", "payload", ev.Payload, "err", err)
+					continue
+				}
+				etx, err := r.App.TxmORM().FindEthTxWithAttempts(id)
+				if err != nil {
+					lggr.Errorw("Failed to load eth tx", "id", id, "err", err)
+					continue
+				}
+				select {
+				case ch <- NewEthTransaction(etx):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// NodeStateChanged streams EVM nodes as their state changes.
+func (r *Resolver) NodeStateChanged(ctx context.Context) (<-chan *NodeResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	lggr := r.App.GetLogger().Named("NodeStateChangedSubscription")
+	type stateChange struct {
+		nodeID int32
+		state  string
+	}
+	changes := make(chan stateChange, SubscriptionBufferSize)
+	var unregisters []func()
+	for _, chain := range r.App.GetChains().EVM.Chains() {
+		unregisters = append(unregisters, chain.Client().OnNodeStateChange(func(nodeID int32, state string) {
+			select {
+			case changes <- stateChange{nodeID, state}:
+			default:
+				lggr.Warnw("Subscriber is not keeping up, dropping node state change", "nodeID", nodeID, "state", state)
+			}
+		}))
+	}
+
+	ch := make(chan *NodeResolver)
+	go func() {
+		defer close(ch)
+		defer func() {
+			for _, unregister := range unregisters {
+				unregister()
+			}
+		}()
+		nodes := make(map[int32]types.Node)
+		for {
+			var change stateChange
+			select {
+			case <-ctx.Done():
+				return
+			case change = <-changes:
+			}
+
+			n, ok := nodes[change.nodeID]
+			if !ok {
+				all, err := r.allNodes(ctx)
+				if err != nil {
+					lggr.Errorw("Failed to load nodes", "err", err)
+					continue
+				}
+				for _, n := range all {
+					nodes[n.ID] = n
+				}
+				if n, ok = nodes[change.nodeID]; !ok {
+					lggr.Warnw("Unknown node changed state", "nodeID", change.nodeID)
+					continue
+				}
+			}
+			n.State = change.state
+			select {
+			case ch <- NewNode(n):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// allNodes pages through all EVM nodes.
+func (r *Resolver) allNodes(ctx context.Context) (nodes []types.Node, err error) {
+	for {
+		page, count, err := r.App.GetChains().EVM.GetNodes(ctx, len(nodes), PageDefaultLimit)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, page...)
+		if len(page) == 0 || len(nodes) >= count {
+			return nodes, nil
+		}
+	}
+}
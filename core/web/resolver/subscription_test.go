@@ -0,0 +1,179 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	pgmocks "github.com/smartcontractkit/chainlink/core/services/pg/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	pipelineMocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// subscribe starts the subscription and returns a func receiving its next
+// response.
+func subscribe(t *testing.T, f *gqlTestFramework, query string, vars map[string]interface{}) func() *graphql.Response {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(f.Ctx)
+	t.Cleanup(cancel)
+	ch, err := f.RootSchema.Subscribe(ctx, query, "", vars)
+	require.NoError(t, err)
+
+	return func() *graphql.Response {
+		select {
+		case resp := <-ch:
+			return resp.(*graphql.Response)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for response")
+			return nil
+		}
+	}
+}
+
+func TestResolver_JobRunFinished(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		subscription JobRunFinished($jobID: ID) {
+			jobRunFinished(jobID: $jobID) {
+				id
+				status
+			}
+		}`
+
+	t.Run("not authorized", func(t *testing.T) {
+		f := setupFramework(t)
+
+		next := subscribe(t, f, query, nil)
+		resp := next()
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "Unauthorized", resp.Errors[0].Message)
+	})
+
+	t.Run("streams finished runs of the job", func(t *testing.T) {
+		f := setupFramework(t)
+		f.injectAuthenticatedUser()
+
+		runner := pipelineMocks.NewRunner(t)
+		registered := make(chan func(*pipeline.Run), 1)
+		unregistered := make(chan struct{})
+		runner.On("OnRunFinished", mock.Anything).Run(func(args mock.Arguments) {
+			registered <- args.Get(0).(func(*pipeline.Run))
+		}).Return(func() { close(unregistered) })
+		f.App.On("PipelineRunner").Return(runner)
+		f.App.On("GetLogger").Return(logger.TestLogger(t))
+		f.App.On("JobORM").Return(f.Mocks.jobORM)
+		f.Mocks.jobORM.On("FindJob", mock.Anything, int32(1)).Return(job.Job{ID: 1, PipelineSpecID: 5}, nil)
+
+		ctx, cancel := context.WithCancel(f.Ctx)
+		ch, err := f.RootSchema.Subscribe(ctx, query, "", map[string]interface{}{"jobID": "1"})
+		require.NoError(t, err)
+
+		onRunFinished := <-registered
+
+		onRunFinished(&pipeline.Run{ID: 1, PipelineSpecID: 5, State: pipeline.RunStatusRunning})
+		onRunFinished(&pipeline.Run{ID: 2, PipelineSpecID: 6, State: pipeline.RunStatusCompleted})
+		onRunFinished(&pipeline.Run{ID: 3, PipelineSpecID: 5, State: pipeline.RunStatusErrored})
+
+		resp := (<-ch).(*graphql.Response)
+		require.Empty(t, resp.Errors)
+		assert.JSONEq(t, `{"jobRunFinished": {"id": "3", "status": "ERRORED"}}`, string(resp.Data))
+
+		cancel()
+		<-unregistered
+	})
+}
+
+func TestResolver_EthTransactionStateChanged(t *testing.T) {
+	t.Parallel()
+
+	f := setupFramework(t)
+	f.injectAuthenticatedUser()
+
+	events := make(chan pg.Event)
+	sub := pgmocks.NewSubscription(t)
+	sub.On("Events").Return((<-chan pg.Event)(events))
+	closed := make(chan struct{})
+	sub.On("Close").Run(func(mock.Arguments) { close(closed) }).Return()
+	eb := pgmocks.NewEventBroadcaster(t)
+	eb.On("Subscribe", pg.ChannelEthTxStateChanged, "").Return(sub, nil)
+	f.App.On("GetEventBroadcaster").Return(eb)
+	f.App.On("GetLogger").Return(logger.TestLogger(t))
+	f.App.On("TxmORM").Return(f.Mocks.txmORM)
+	f.Mocks.txmORM.On("FindEthTxWithAttempts", int64(7)).Return(txmgr.EthTx{
+		ID:         7,
+		State:      txmgr.EthTxConfirmed,
+		EVMChainID: *utils.NewBigI(42),
+	}, nil)
+
+	ctx, cancel := context.WithCancel(f.Ctx)
+	ch, err := f.RootSchema.Subscribe(ctx, `subscription { ethTransactionStateChanged { state evmChainID } }`, "", nil)
+	require.NoError(t, err)
+
+	events <- pg.Event{Channel: pg.ChannelEthTxStateChanged, Payload: "7"}
+
+	resp := (<-ch).(*graphql.Response)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"ethTransactionStateChanged": {"state": "confirmed", "evmChainID": "42"}}`, string(resp.Data))
+
+	cancel()
+	<-closed
+}
+
+func TestResolver_NodeStateChanged(t *testing.T) {
+	t.Parallel()
+
+	f := setupFramework(t)
+	f.injectAuthenticatedUser()
+
+	client := evmmocks.NewClient(t)
+	registered := make(chan func(int32, string), 1)
+	unregistered := make(chan struct{})
+	client.On("OnNodeStateChange", mock.Anything).Run(func(args mock.Arguments) {
+		registered <- args.Get(0).(func(int32, string))
+	}).Return(func() { close(unregistered) })
+	chain := evmmocks.NewChain(t)
+	chain.On("Client").Return(client)
+	f.Mocks.chainSet.On("Chains").Return([]evm.Chain{chain})
+	f.App.On("GetChains").Return(chainlink.Chains{EVM: f.Mocks.chainSet})
+	f.App.On("GetLogger").Return(logger.TestLogger(t))
+	f.Mocks.chainSet.On("GetNodes", mock.Anything, 0, PageDefaultLimit).Return([]types.Node{
+		{ID: 1, Name: "node-a", EVMChainID: *utils.NewBigI(1), State: "Alive"},
+		{ID: 2, Name: "node-b", EVMChainID: *utils.NewBigI(1), State: "Alive"},
+	}, 2, nil).Once()
+
+	ctx, cancel := context.WithCancel(f.Ctx)
+	ch, err := f.RootSchema.Subscribe(ctx, `subscription { nodeStateChanged { name state } }`, "", nil)
+	require.NoError(t, err)
+
+	onNodeStateChange := <-registered
+
+	onNodeStateChange(2, "Unreachable")
+	resp := (<-ch).(*graphql.Response)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"nodeStateChanged": {"name": "node-b", "state": "Unreachable"}}`, string(resp.Data))
+
+	// Known nodes are not reloaded.
+	onNodeStateChange(1, "OutOfSync")
+	resp = (<-ch).(*graphql.Response)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"nodeStateChanged": {"name": "node-a", "state": "OutOfSync"}}`, string(resp.Data))
+
+	cancel()
+	<-unregistered
+}
@@ -1,11 +1,17 @@
 package resolver
 
 import (
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 )
 
 type VRFKeyResolver struct {
@@ -154,3 +160,173 @@ func (r *DeleteVRFKeyPayloadResolver) ToDeleteVRFKeySuccess() (*DeleteVRFKeySucc
 	}
 	return nil, false
 }
+
+// VRFV2RequestLifecycleResolver resolves the VRFV2RequestLifecycle type.
+type VRFV2RequestLifecycleResolver struct {
+	lifecycle vrf.RequestLifecycle
+}
+
+func NewVRFV2RequestLifecycle(lifecycle vrf.RequestLifecycle) *VRFV2RequestLifecycleResolver {
+	return &VRFV2RequestLifecycleResolver{lifecycle: lifecycle}
+}
+
+// JobID resolves the ID of the VRF job which saw the request.
+func (r *VRFV2RequestLifecycleResolver) JobID() graphql.ID {
+	return int32GQLID(r.lifecycle.JobID)
+}
+
+// RequestID resolves the request's ID, in decimal.
+func (r *VRFV2RequestLifecycleResolver) RequestID() string {
+	return r.lifecycle.RequestID
+}
+
+// SubID resolves the ID of the request's subscription.
+func (r *VRFV2RequestLifecycleResolver) SubID() string {
+	return strconv.FormatUint(r.lifecycle.SubID, 10)
+}
+
+// Status resolves the stage the request has reached.
+func (r *VRFV2RequestLifecycleResolver) Status() string {
+	return string(r.lifecycle.Status)
+}
+
+// RequestTxHash resolves the hash of the transaction which made the request.
+func (r *VRFV2RequestLifecycleResolver) RequestTxHash() string {
+	return r.lifecycle.RequestTxHash.Hex()
+}
+
+// RequestBlockNumber resolves the block of the RandomWordsRequested log.
+func (r *VRFV2RequestLifecycleResolver) RequestBlockNumber() string {
+	return strconv.FormatUint(r.lifecycle.RequestBlockNumber, 10)
+}
+
+// ConfirmedAtBlock resolves the block the request waits for before it is
+// processed.
+func (r *VRFV2RequestLifecycleResolver) ConfirmedAtBlock() string {
+	return strconv.FormatUint(r.lifecycle.ConfirmedAtBlock, 10)
+}
+
+// LogSeenAt resolves when the request's log was first seen.
+func (r *VRFV2RequestLifecycleResolver) LogSeenAt() graphql.Time {
+	return graphql.Time{Time: r.lifecycle.LogSeenAt}
+}
+
+// Attempts resolves the number of times the request was processed.
+func (r *VRFV2RequestLifecycleResolver) Attempts() int32 {
+	return r.lifecycle.Attempts
+}
+
+// SubscriptionBalance resolves the subscription balance, in juels, the
+// request was last checked against.
+func (r *VRFV2RequestLifecycleResolver) SubscriptionBalance() *string {
+	if r.lifecycle.SubscriptionBalance == nil {
+		return nil
+	}
+	balance := r.lifecycle.SubscriptionBalance.String()
+	return &balance
+}
+
+// SubscriptionCheckedAt resolves when the subscription balance was last
+// checked and found changed.
+func (r *VRFV2RequestLifecycleResolver) SubscriptionCheckedAt() *graphql.Time {
+	if !r.lifecycle.SubscriptionCheckedAt.Valid {
+		return nil
+	}
+	return &graphql.Time{Time: r.lifecycle.SubscriptionCheckedAt.Time}
+}
+
+// SkipReason resolves the reason the last attempt did not fulfill the request.
+func (r *VRFV2RequestLifecycleResolver) SkipReason() *string {
+	return r.lifecycle.SkipReason.Ptr()
+}
+
+// PipelineRunID resolves the ID of the pipeline run of the fulfillment.
+func (r *VRFV2RequestLifecycleResolver) PipelineRunID() *graphql.ID {
+	return nullInt64GQLID(r.lifecycle.PipelineRunID)
+}
+
+// EthTxID resolves the ID of the fulfillment transaction.
+func (r *VRFV2RequestLifecycleResolver) EthTxID() *graphql.ID {
+	return nullInt64GQLID(r.lifecycle.EthTxID)
+}
+
+// EthTxState resolves the state of the fulfillment transaction.
+func (r *VRFV2RequestLifecycleResolver) EthTxState() *string {
+	return r.lifecycle.EthTxState.Ptr()
+}
+
+// FulfillmentTxHash resolves the hash of the broadcast fulfillment
+// transaction, preferring the one which was mined.
+func (r *VRFV2RequestLifecycleResolver) FulfillmentTxHash() *string {
+	return hashString(r.lifecycle.FulfillmentTxHash)
+}
+
+// FulfillmentReceiptBlockNumber resolves the block the fulfillment
+// transaction was mined in.
+func (r *VRFV2RequestLifecycleResolver) FulfillmentReceiptBlockNumber() *string {
+	return nullInt64String(r.lifecycle.FulfillmentReceiptBlockNumber)
+}
+
+// FulfillmentLogTxHash resolves the hash of the transaction of the
+// RandomWordsFulfilled log, which may have been sent by another node.
+func (r *VRFV2RequestLifecycleResolver) FulfillmentLogTxHash() *string {
+	return hashString(r.lifecycle.FulfillmentLogTxHash)
+}
+
+// FulfillmentLogBlockNumber resolves the block of the RandomWordsFulfilled log.
+func (r *VRFV2RequestLifecycleResolver) FulfillmentLogBlockNumber() *string {
+	return nullInt64String(r.lifecycle.FulfillmentLogBlockNumber)
+}
+
+// FulfillmentSuccess resolves whether the consumer's callback succeeded.
+func (r *VRFV2RequestLifecycleResolver) FulfillmentSuccess() *bool {
+	return r.lifecycle.FulfillmentSuccess.Ptr()
+}
+
+// UpdatedAt resolves when the lifecycle was last updated.
+func (r *VRFV2RequestLifecycleResolver) UpdatedAt() graphql.Time {
+	return graphql.Time{Time: r.lifecycle.UpdatedAt}
+}
+
+func nullInt64GQLID(i null.Int) *graphql.ID {
+	if !i.Valid {
+		return nil
+	}
+	id := int64GQLID(i.Int64)
+	return &id
+}
+
+func nullInt64String(i null.Int) *string {
+	if !i.Valid {
+		return nil
+	}
+	s := stringutils.FromInt64(i.Int64)
+	return &s
+}
+
+func hashString(h *common.Hash) *string {
+	if h == nil {
+		return nil
+	}
+	s := h.Hex()
+	return &s
+}
+
+// -- VRFV2RequestLifecycles Query --
+
+type VRFV2RequestLifecyclesPayloadResolver struct {
+	lifecycles []vrf.RequestLifecycle
+}
+
+func NewVRFV2RequestLifecyclesPayload(lifecycles []vrf.RequestLifecycle) *VRFV2RequestLifecyclesPayloadResolver {
+	return &VRFV2RequestLifecyclesPayloadResolver{lifecycles: lifecycles}
+}
+
+// Results returns the lifecycles of the request.
+func (r *VRFV2RequestLifecyclesPayloadResolver) Results() []*VRFV2RequestLifecycleResolver {
+	results := []*VRFV2RequestLifecycleResolver{}
+	for _, l := range r.lifecycles {
+		results = append(results, NewVRFV2RequestLifecycle(l))
+	}
+	return results
+}
@@ -6,11 +6,16 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/vrfkey"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 func TestResolver_GetVRFKey(t *testing.T) {
@@ -296,3 +301,176 @@ func TestResolver_DeleteVRFKey(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_VRFV2RequestLifecycles(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetVRFV2RequestLifecycles($requestID: String!) {
+			vrfV2RequestLifecycles(requestID: $requestID) {
+				results {
+					jobID
+					requestID
+					subID
+					status
+					requestTxHash
+					requestBlockNumber
+					confirmedAtBlock
+					logSeenAt
+					attempts
+					subscriptionBalance
+					subscriptionCheckedAt
+					skipReason
+					pipelineRunID
+					ethTxID
+					ethTxState
+					fulfillmentTxHash
+					fulfillmentReceiptBlockNumber
+					fulfillmentLogTxHash
+					fulfillmentLogBlockNumber
+					fulfillmentSuccess
+					updatedAt
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"requestID": "0x10",
+	}
+	requestTxHash := common.HexToHash("0x1")
+	fulfillmentTxHash := common.HexToHash("0x2")
+	gError := errors.New("error")
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "vrfV2RequestLifecycles"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.vrfORM.On("FindRequestLifecycles", "16").Return([]vrf.RequestLifecycle{
+					{
+						JobID:                         1,
+						RequestID:                     "16",
+						SubID:                         2,
+						Status:                        vrf.RequestStatusEnqueued,
+						RequestTxHash:                 requestTxHash,
+						RequestBlockNumber:            100,
+						ConfirmedAtBlock:              103,
+						LogSeenAt:                     f.Timestamp(),
+						Attempts:                      2,
+						SubscriptionBalance:           utils.NewBigI(1000),
+						SubscriptionCheckedAt:         null.TimeFrom(f.Timestamp()),
+						PipelineRunID:                 null.IntFrom(3),
+						EthTxID:                       null.IntFrom(4),
+						EthTxState:                    null.StringFrom("confirmed"),
+						FulfillmentTxHash:             &fulfillmentTxHash,
+						FulfillmentReceiptBlockNumber: null.IntFrom(105),
+						UpdatedAt:                     f.Timestamp(),
+					},
+					{
+						JobID:              2,
+						RequestID:          "16",
+						SubID:              2,
+						Status:             vrf.RequestStatusRetrying,
+						RequestTxHash:      requestTxHash,
+						RequestBlockNumber: 100,
+						ConfirmedAtBlock:   110,
+						LogSeenAt:          f.Timestamp(),
+						Attempts:           1,
+						SkipReason:         null.StringFrom("insufficient subscription balance"),
+						UpdatedAt:          f.Timestamp(),
+					},
+				}, nil)
+				f.App.On("VRFORM").Return(f.Mocks.vrfORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"vrfV2RequestLifecycles": {
+						"results": [{
+							"jobID": "1",
+							"requestID": "16",
+							"subID": "2",
+							"status": "enqueued",
+							"requestTxHash": "0x0000000000000000000000000000000000000000000000000000000000000001",
+							"requestBlockNumber": "100",
+							"confirmedAtBlock": "103",
+							"logSeenAt": "2021-01-01T00:00:00Z",
+							"attempts": 2,
+							"subscriptionBalance": "1000",
+							"subscriptionCheckedAt": "2021-01-01T00:00:00Z",
+							"skipReason": null,
+							"pipelineRunID": "3",
+							"ethTxID": "4",
+							"ethTxState": "confirmed",
+							"fulfillmentTxHash": "0x0000000000000000000000000000000000000000000000000000000000000002",
+							"fulfillmentReceiptBlockNumber": "105",
+							"fulfillmentLogTxHash": null,
+							"fulfillmentLogBlockNumber": null,
+							"fulfillmentSuccess": null,
+							"updatedAt": "2021-01-01T00:00:00Z"
+						}, {
+							"jobID": "2",
+							"requestID": "16",
+							"subID": "2",
+							"status": "retrying",
+							"requestTxHash": "0x0000000000000000000000000000000000000000000000000000000000000001",
+							"requestBlockNumber": "100",
+							"confirmedAtBlock": "110",
+							"logSeenAt": "2021-01-01T00:00:00Z",
+							"attempts": 1,
+							"subscriptionBalance": null,
+							"subscriptionCheckedAt": null,
+							"skipReason": "insufficient subscription balance",
+							"pipelineRunID": null,
+							"ethTxID": null,
+							"ethTxState": null,
+							"fulfillmentTxHash": null,
+							"fulfillmentReceiptBlockNumber": null,
+							"fulfillmentLogTxHash": null,
+							"fulfillmentLogBlockNumber": null,
+							"fulfillmentSuccess": null,
+							"updatedAt": "2021-01-01T00:00:00Z"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "no lifecycles",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.vrfORM.On("FindRequestLifecycles", "16").Return(nil, nil)
+				f.App.On("VRFORM").Return(f.Mocks.vrfORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"vrfV2RequestLifecycles": {
+						"results": []
+					}
+				}`,
+		},
+		{
+			name:          "generic error",
+			authenticated: true,
+			before: func(f *gqlTestFramework) {
+				f.Mocks.vrfORM.On("FindRequestLifecycles", "16").Return(nil, gError)
+				f.App.On("VRFORM").Return(f.Mocks.vrfORM)
+			},
+			query:     query,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"vrfV2RequestLifecycles"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -82,17 +82,22 @@ func Router(app chainlink.Application, prometheus *ginprom.Prometheus) *gin.Engi
 
 	guiAssetRoutes(engine, config, app.GetLogger())
 
+	gqlSchema := graphqlSchema(app)
 	api.POST("/query",
 		auth.AuthenticateGQL(app.SessionORM(), app.GetLogger().Named("GQLHandler")),
 		loader.Middleware(app),
-		graphqlHandler(app),
+		graphqlHandler(gqlSchema),
+	)
+	api.GET("/query",
+		auth.AuthenticateGQL(app.SessionORM(), app.GetLogger().Named("GQLHandler")),
+		graphqlWSHandler(app, gqlSchema, config.AllowOrigins()),
 	)
 
 	return engine
 }
 
-// Defining the Graphql handler
-func graphqlHandler(app chainlink.Application) gin.HandlerFunc {
+// graphqlSchema parses the GraphQL schema with the root resolver
+func graphqlSchema(app chainlink.Application) *graphql.Schema {
 	rootSchema := schema.MustGetRootSchema()
 
 	// Disable introspection and set a max query depth in production.
@@ -103,13 +108,16 @@ func graphqlHandler(app chainlink.Application) gin.HandlerFunc {
 		)
 	}
 
-	schema := graphql.MustParseSchema(rootSchema,
+	return graphql.MustParseSchema(rootSchema,
 		&resolver.Resolver{
 			App: app,
 		},
 		schemaOpts...,
 	)
+}
 
+// Defining the Graphql handler
+func graphqlHandler(schema *graphql.Schema) gin.HandlerFunc {
 	h := relay.Handler{Schema: schema}
 
 	return func(c *gin.Context) {
@@ -277,11 +285,16 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		txs := TransactionsController{app}
 		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/evm/:TxHash", txs.Show)
+		authv2.POST("/transactions/evm/:TxHash/cancel", auth.RequiresEditRole(txs.Cancel))
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
 
 		rc := ReplayController{app}
 		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
+		authv2.GET("/reorged_log_broadcasts", rc.ReorgedLogBroadcasts)
+
+		kc := KeeperController{app}
+		authv2.GET("/keeper_registries/:address/upkeeps/:upkeepID/report", kc.UpkeepReport)
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
@@ -351,11 +364,26 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/keys/vrf/import", auth.RequiresAdminRole(vrfkc.Import))
 		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresAdminRole(vrfkc.Export))
 
+		vrc := VRFRequestsController{app}
+		authv2.GET("/vrf/requests/:requestID/lifecycle", vrc.Lifecycle)
+
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
+		authv2.GET("/jobs/export", jc.Export)
 		authv2.GET("/jobs/:ID", jc.Show)
 		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/simulate", auth.RequiresEditRole(jc.Simulate))
+		authv2.POST("/jobs/import", auth.RequiresEditRole(jc.Import))
+		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
 		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.GET("/jobs/:ID/versions", jc.Versions)
+		authv2.POST("/jobs/:ID/rollback", auth.RequiresEditRole(jc.Rollback))
+		authv2.POST("/jobs/:ID/pause", auth.RequiresEditRole(jc.Pause))
+		authv2.POST("/jobs/:ID/resume", auth.RequiresEditRole(jc.Resume))
+		authv2.POST("/jobs/:ID/flux_monitor/poll", auth.RequiresAdminRole(jc.Poll))
+		authv2.GET("/jobs/:ID/flux_monitor/deviation", auth.RequiresRunRole(jc.Deviation))
+		authv2.GET("/jobs/:ID/direct_request/rejections", paginatedRequest(jc.Rejections))
+		authv2.POST("/jobs/:ID/blockhash_store/backfill", auth.RequiresAdminRole(jc.Backfill))
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
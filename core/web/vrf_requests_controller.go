@@ -0,0 +1,42 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// VRFRequestsController inspects the VRF v2 requests seen by VRF jobs.
+type VRFRequestsController struct {
+	App chainlink.Application
+}
+
+// Lifecycle reports how each VRF job which saw a request processed it, from
+// its log to its fulfillment. The request ID may be decimal or 0x prefixed
+// hex.
+// Example:
+//  "<application>/v2/vrf/requests/:requestID/lifecycle"
+func (vrc *VRFRequestsController) Lifecycle(c *gin.Context) {
+	var requestID utils.Big
+	if err := requestID.UnmarshalText([]byte(c.Param("requestID"))); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "invalid request ID"))
+		return
+	}
+
+	lifecycles, err := vrc.App.VRFORM().FindRequestLifecycles(requestID.String())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(lifecycles) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.New("request not seen by any VRF job"))
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewVRFRequestLifecycleResources(lifecycles), "vrf_request_lifecycles")
+}
@@ -0,0 +1,34 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+)
+
+func TestVRFRequestsController_Lifecycle_Errors(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(cltest.APIEmailAdmin)
+
+	tests := []struct {
+		name   string
+		path   string
+		status int
+	}{
+		{"invalid request ID", "/v2/vrf/requests/one/lifecycle", http.StatusUnprocessableEntity},
+		{"unknown request", "/v2/vrf/requests/0x10/lifecycle", http.StatusNotFound},
+	}
+	for _, tc := range tests {
+		resp, cleanup := client.Get(tc.path)
+		t.Cleanup(cleanup)
+		assert.Equal(t, tc.status, resp.StatusCode, tc.name)
+	}
+}